@@ -16,6 +16,8 @@ package api
 
 import (
 	"context"
+
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
 )
 
 type PolicyType string
@@ -24,6 +26,7 @@ const (
 	RoundRobin = PolicyType("round_robin")
 	Manually   = PolicyType("manually")
 	Weight     = PolicyType("weight")
+	KeyHash    = PolicyType("key_hash")
 	ReadOnly   = PolicyType("readonly")
 	ReadWrite  = PolicyType("readwrite")
 )
@@ -40,6 +43,14 @@ type WritePolicy interface {
 	NextLog(ctx context.Context) (Eventlog, error)
 }
 
+// EventWritePolicy is a WritePolicy which picks the eventlog by the content of each event,
+// the events of a batch may be dispatched to different eventlogs.
+type EventWritePolicy interface {
+	WritePolicy
+
+	NextLogForEvent(ctx context.Context, event *cloudevents.CloudEvent) (Eventlog, error)
+}
+
 type ReadPolicy interface {
 	WritePolicy
 
@@ -455,6 +455,10 @@ func (w *busWriter) Append(ctx context.Context, events *cloudevents.CloudEventBa
 		}
 	}
 
+	if p, ok := writeOpts.Policy.(api.EventWritePolicy); ok {
+		return w.appendByEvent(_ctx, p, events)
+	}
+
 	// 1. pick a writer of eventlog
 	lw, err := w.pickWritableLog(_ctx, writeOpts)
 	if err != nil {
@@ -483,6 +487,57 @@ func (w *busWriter) Append(ctx context.Context, events *cloudevents.CloudEventBa
 	return eventIDs, nil
 }
 
+// appendByEvent dispatches events to eventlogs picked by the policy for each event, the order of events
+// which are dispatched to the same eventlog is kept.
+func (w *busWriter) appendByEvent(
+	ctx context.Context, p api.EventWritePolicy, events *cloudevents.CloudEventBatch,
+) ([]string, error) {
+	writers := make([]eventlog.LogWriter, 0, 1)
+	groups := make(map[uint64][]int, 1)
+	for idx, e := range events.Events {
+		lw, err := w.pickWritableLogForEvent(ctx, p, e)
+		if err != nil {
+			log.Error(context.Background(), "pick writable log failed", map[string]interface{}{
+				log.KeyError:  err,
+				"eventbus_id": w.ebus.ID(),
+			})
+			return nil, err
+		}
+		logID := lw.Log().ID()
+		if _, ok := groups[logID]; !ok {
+			writers = append(writers, lw)
+		}
+		groups[logID] = append(groups[logID], idx)
+	}
+
+	eventIDs := make([]string, len(events.Events))
+	for _, lw := range writers {
+		logID := lw.Log().ID()
+		indexes := groups[logID]
+		batch := events
+		if len(writers) > 1 {
+			batch = &cloudevents.CloudEventBatch{Events: make([]*cloudevents.CloudEvent, len(indexes))}
+			for i, idx := range indexes {
+				batch.Events[i] = events.Events[idx]
+			}
+		}
+
+		offsets, err := lw.Append(ctx, batch)
+		if err != nil {
+			log.Error(context.Background(), "logwriter append failed", map[string]interface{}{
+				log.KeyError:  err,
+				"eventbus_id": w.ebus.ID(),
+				"eventlog_id": logID,
+			})
+			return nil, err
+		}
+		for i, idx := range indexes {
+			eventIDs[idx] = genEventID(logID, offsets[i])
+		}
+	}
+	return eventIDs, nil
+}
+
 func (w *busWriter) Bus() api.Eventbus {
 	return w.ebus
 }
@@ -504,6 +559,25 @@ func (w *busWriter) pickWritableLog(ctx context.Context, opts *api.WriteOptions)
 	return lw.Writer(), nil
 }
 
+func (w *busWriter) pickWritableLogForEvent(
+	ctx context.Context, p api.EventWritePolicy, event *cloudevents.CloudEvent,
+) (eventlog.LogWriter, error) {
+	_ctx, span := w.tracer.Start(ctx, "pickWritableLogForEvent")
+	defer span.End()
+
+	l, err := p.NextLogForEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	lw := w.ebus.getWritableLog(_ctx, l.ID())
+	if lw == nil {
+		return nil, stderrors.New("can not pick writable log")
+	}
+
+	return lw.Writer(), nil
+}
+
 func genEventID(logID uint64, off int64) string {
 	var buf [16]byte
 	binary.BigEndian.PutUint64(buf[0:8], logID)
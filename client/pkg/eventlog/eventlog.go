@@ -18,6 +18,7 @@ package eventlog
 import (
 	// standard libraries.
 	"context"
+	"encoding/binary"
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
@@ -31,6 +32,15 @@ const (
 	XVanusLogOffset = segpb.XVanusLogOffset
 )
 
+// EventOffset returns the offset of event in eventlog, which is set by LogReader.
+func EventOffset(event *cloudevents.CloudEvent) (int64, bool) {
+	buf := event.GetAttributes()[XVanusLogOffset].GetCeBytes()
+	if len(buf) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(buf)), true
+}
+
 type ReaderConfig struct {
 	PollingTimeout int64
 }
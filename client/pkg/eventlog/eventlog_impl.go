@@ -456,7 +456,14 @@ func (r *logReader) Read(ctx context.Context, size int16) (*cloudevents.CloudEve
 		return nil, err
 	}
 
-	r.pos += int64(len(events.Events))
+	// Offsets of compacted events are skipped, so forward to the offset next to the last event.
+	if n := len(events.Events); n != 0 {
+		if off, ok := EventOffset(events.Events[n-1]); ok {
+			r.pos = off + 1
+		} else {
+			r.pos += int64(n)
+		}
+	}
 	if r.pos == r.cur.EndOffset() {
 		r.switchSegment(ctx)
 	}
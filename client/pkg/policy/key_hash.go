@@ -0,0 +1,140 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/vanus-labs/vanus/client/pkg/api"
+)
+
+// DefaultPartitionKeyAttribute is the attribute defined by the CloudEvents Partitioning extension.
+const DefaultPartitionKeyAttribute = "partitionkey"
+
+var _ api.EventWritePolicy = (*keyHashWritePolicy)(nil)
+
+// NewKeyHashWritePolicy returns a policy which routes events with the same value of the attribute
+// to the same eventlog, events without the attribute are routed in round-robin way.
+func NewKeyHashWritePolicy(eb api.Eventbus, attribute string) api.WritePolicy {
+	if attribute == "" {
+		attribute = DefaultPartitionKeyAttribute
+	}
+	return &keyHashWritePolicy{
+		attribute:  strings.ToLower(attribute),
+		roundRobin: roundRobinWritePolicy{bus: eb},
+	}
+}
+
+type keyHashWritePolicy struct {
+	attribute  string
+	roundRobin roundRobinWritePolicy
+}
+
+func (w *keyHashWritePolicy) Type() api.PolicyType {
+	return api.KeyHash
+}
+
+func (w *keyHashWritePolicy) NextLog(ctx context.Context) (api.Eventlog, error) {
+	return w.roundRobin.NextLog(ctx)
+}
+
+func (w *keyHashWritePolicy) NextLogForEvent(ctx context.Context, event *cloudevents.CloudEvent) (api.Eventlog, error) {
+	key, ok := PartitionKey(event, w.attribute)
+	if !ok {
+		return w.NextLog(ctx)
+	}
+
+	for {
+		logs, err := w.roundRobin.bus.ListLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		return pickLogByKey(logs, key), nil
+	}
+}
+
+// pickLogByKey uses rendezvous hashing, so the mapping only depends on the set of eventlogs rather
+// than their order, and only keys owned by the new eventlog are moved when the eventlog is added.
+func pickLogByKey(logs []api.Eventlog, key string) api.Eventlog {
+	var (
+		target api.Eventlog
+		max    uint64
+		buf    [8]byte
+	)
+	for _, l := range logs {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		binary.BigEndian.PutUint64(buf[:], l.ID())
+		_, _ = h.Write(buf[:])
+		if score := h.Sum64(); target == nil || score > max || (score == max && l.ID() < target.ID()) {
+			target, max = l, score
+		}
+	}
+	return target
+}
+
+// PartitionKey returns the string form of the attribute of the event.
+func PartitionKey(event *cloudevents.CloudEvent, attribute string) (string, bool) {
+	if event == nil {
+		return "", false
+	}
+	switch attribute {
+	case "id":
+		return event.Id, event.Id != ""
+	case "source":
+		return event.Source, event.Source != ""
+	case "type":
+		return event.Type, event.Type != ""
+	case "specversion":
+		return event.SpecVersion, event.SpecVersion != ""
+	}
+
+	attr, ok := event.Attributes[attribute]
+	if !ok || attr == nil {
+		return "", false
+	}
+	var key string
+	switch val := attr.Attr.(type) {
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeString:
+		key = val.CeString
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeUri:
+		key = val.CeUri
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeUriRef:
+		key = val.CeUriRef
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeInteger:
+		key = strconv.FormatInt(int64(val.CeInteger), 10)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeBoolean:
+		key = strconv.FormatBool(val.CeBoolean)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeBytes:
+		key = base64.StdEncoding.EncodeToString(val.CeBytes)
+	case *cloudevents.CloudEvent_CloudEventAttributeValue_CeTimestamp:
+		key = val.CeTimestamp.AsTime().Format(time.RFC3339Nano)
+	}
+	return key, key != ""
+}
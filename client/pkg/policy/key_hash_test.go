@@ -0,0 +1,117 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"testing"
+
+	// third-party libraries.
+	"github.com/golang/mock/gomock"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/vanus-labs/vanus/client/pkg/api"
+)
+
+func newEventWithKey(key string) *cloudevents.CloudEvent {
+	return &cloudevents.CloudEvent{
+		Id: "id",
+		Attributes: map[string]*cloudevents.CloudEvent_CloudEventAttributeValue{
+			DefaultPartitionKeyAttribute: {
+				Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: key},
+			},
+		},
+	}
+}
+
+func TestKeyHashWritePolicy_NextLogForEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logs := make([]api.Eventlog, 4)
+	for i := range logs {
+		l := api.NewMockEventlog(ctrl)
+		l.EXPECT().ID().AnyTimes().Return(uint64(i + 1))
+		logs[i] = l
+	}
+	bus := api.NewMockEventbus(ctrl)
+	current := logs[:3]
+	bus.EXPECT().ListLog(gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, opts ...api.LogOption) ([]api.Eventlog, error) {
+			return current, nil
+		})
+	p, _ := NewKeyHashWritePolicy(bus, "").(api.EventWritePolicy)
+	if p.Type() != api.KeyHash {
+		t.Errorf("p.Type() != api.KeyHash")
+	}
+
+	mapping := make(map[string]uint64)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key-%d", i)
+		l, err := p.NextLogForEvent(context.Background(), newEventWithKey(key))
+		if err != nil {
+			t.Fatalf("NextLogForEvent() failed: %s", err)
+		}
+		mapping[key] = l.ID()
+	}
+
+	// the order of eventlogs changes after refreshing.
+	current = []api.Eventlog{logs[2], logs[0], logs[1]}
+	for key, id := range mapping {
+		l, _ := p.NextLogForEvent(context.Background(), newEventWithKey(key))
+		if l.ID() != id {
+			t.Errorf("key %s is routed to eventlog %d, expect %d", key, l.ID(), id)
+		}
+	}
+
+	// only keys moved to the new eventlog are remapped.
+	current = logs
+	for key, id := range mapping {
+		l, _ := p.NextLogForEvent(context.Background(), newEventWithKey(key))
+		if l.ID() != id && l.ID() != logs[3].ID() {
+			t.Errorf("key %s is routed to eventlog %d, expect %d", key, l.ID(), id)
+		}
+	}
+
+	// event without key is routed in round-robin way.
+	l, err := p.NextLogForEvent(context.Background(), &cloudevents.CloudEvent{Id: "id"})
+	if err != nil || l == nil {
+		t.Errorf("NextLogForEvent() failed: %v", err)
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	e := newEventWithKey("key")
+	e.Attributes["subject"] = &cloudevents.CloudEvent_CloudEventAttributeValue{
+		Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeInteger{CeInteger: 10},
+	}
+	if key, ok := PartitionKey(e, DefaultPartitionKeyAttribute); !ok || key != "key" {
+		t.Errorf("PartitionKey(partitionkey) = %s, %v", key, ok)
+	}
+	if key, ok := PartitionKey(e, "subject"); !ok || key != "10" {
+		t.Errorf("PartitionKey(subject) = %s, %v", key, ok)
+	}
+	if key, ok := PartitionKey(e, "id"); !ok || key != "id" {
+		t.Errorf("PartitionKey(id) = %s, %v", key, ok)
+	}
+	if _, ok := PartitionKey(e, "source"); ok {
+		t.Errorf("PartitionKey(source) should not exist")
+	}
+}
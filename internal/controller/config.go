@@ -15,6 +15,8 @@
 package controller
 
 import (
+	"time"
+
 	"github.com/vanus-labs/vanus/observability"
 
 	"github.com/vanus-labs/vanus/internal/controller/eventbus"
@@ -25,17 +27,29 @@ import (
 )
 
 type Config struct {
-	NodeID               uint16               `yaml:"node_id"`
-	Name                 string               `yaml:"name"`
-	IP                   string               `yaml:"ip"`
-	Port                 int                  `yaml:"port"`
-	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
-	MetadataConfig       MetadataConfig       `yaml:"metadata"`
-	Replicas             uint                 `yaml:"replicas"`
-	SecretEncryptionSalt string               `yaml:"secret_encryption_salt"`
-	SegmentCapacity      int64                `yaml:"segment_capacity"`
-	Observability        observability.Config `yaml:"observability"`
-	ClusterConfig        member.Config        `yaml:"cluster"`
+	NodeID               uint16         `yaml:"node_id"`
+	Name                 string         `yaml:"name"`
+	IP                   string         `yaml:"ip"`
+	Port                 int            `yaml:"port"`
+	GRPCReflectionEnable bool           `yaml:"grpc_reflection_enable"`
+	MetadataConfig       MetadataConfig `yaml:"metadata"`
+	Replicas             uint           `yaml:"replicas"`
+	SecretEncryptionSalt string         `yaml:"secret_encryption_salt"`
+	SegmentCapacity      int64          `yaml:"segment_capacity"`
+	// SegmentOffloadAfter is how long the full segments are kept in store nodes before they are offloaded to
+	// object storage, offloading is disabled if it's zero.
+	SegmentOffloadAfter time.Duration `yaml:"segment_offload_after"`
+	// ReplicaLostTimeout is how long a store node is inactive before the replicas in it are re-replicated.
+	ReplicaLostTimeout time.Duration `yaml:"replica_lost_timeout"`
+	// LeaderImbalanceThreshold is the max difference between the leader numbers of store nodes before the leaders
+	// are rebalanced, rebalancing is disabled if it's negative.
+	LeaderImbalanceThreshold int `yaml:"leader_imbalance_threshold"`
+	// VolumeHighWatermark is the max ratio of used capacity of volume before new blocks are refused.
+	VolumeHighWatermark float64 `yaml:"volume_high_watermark"`
+	// ReplicaAntiAffinityLabels are the keys of volume labels to spread the replicas of a segment.
+	ReplicaAntiAffinityLabels []string             `yaml:"replica_anti_affinity_labels"`
+	Observability             observability.Config `yaml:"observability"`
+	ClusterConfig             member.Config        `yaml:"cluster"`
 }
 
 func (c *Config) GetClusterConfig() member.Config {
@@ -52,6 +66,16 @@ func (c *Config) GetEventbusCtrlConfig() eventbus.Config {
 		Replicas:         c.Replicas,
 		Topology:         c.ClusterConfig.Topology,
 		SegmentCapacity:  c.SegmentCapacity,
+
+		SegmentOffloadAfter: c.SegmentOffloadAfter,
+		ReplicaLostTimeout:  c.ReplicaLostTimeout,
+
+		LeaderImbalanceThreshold:  c.LeaderImbalanceThreshold,
+		VolumeHighWatermark:       c.VolumeHighWatermark,
+		ReplicaAntiAffinityLabels: c.ReplicaAntiAffinityLabels,
+
+		SecretEncryptionSalt: c.SecretEncryptionSalt,
+		ControllerAddr:       c.GetControllerAddrs(),
 	}
 }
 
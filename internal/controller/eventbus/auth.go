@@ -0,0 +1,143 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/vanus-labs/vanus/pkg/errors"
+	"github.com/vanus-labs/vanus/pkg/util/crypto"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func (ctrl *controller) SetEventbusAuth(
+	ctx context.Context, req *ctrlpb.SetEventbusAuthRequest,
+) (*metapb.Eventbus, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	id := vanus.NewIDFromUint64(req.EventbusId)
+	eb, exist := ctrl.eventbusMap[id]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	auth, err := ctrl.encryptEventbusAuth(req.Auth)
+	if err != nil {
+		return nil, err
+	}
+	updated := *eb
+	updated.Auth = auth
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err = ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Namespace, eb.Name), data); err != nil {
+		return nil, err
+	}
+	ctrl.eventbusMap[id] = &updated
+	return ctrl.getEventbus(id)
+}
+
+func (ctrl *controller) GetEventbusAuth(
+	_ context.Context, req *wrapperspb.UInt64Value,
+) (*metapb.EventbusAuth, error) {
+	eb, exist := ctrl.eventbusMap[vanus.NewIDFromUint64(req.GetValue())]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	return ctrl.decryptEventbusAuth(eb.Auth)
+}
+
+func validateEventbusAuth(auth *metapb.EventbusAuth) error {
+	switch auth.GetType() {
+	case metapb.EventbusAuth_NONE:
+	case metapb.EventbusAuth_TOKEN:
+		if len(auth.Tokens) == 0 {
+			return errors.ErrInvalidRequest.WithMessage("eventbus auth type is token, tokens can not be empty")
+		}
+		for _, token := range auth.Tokens {
+			if token == "" || token == primitive.SecretsMask {
+				return errors.ErrInvalidRequest.WithMessage("eventbus auth token is invalid")
+			}
+		}
+	case metapb.EventbusAuth_GITHUB, metapb.EventbusAuth_STRIPE:
+		if auth.Secret == "" || auth.Secret == primitive.SecretsMask {
+			return errors.ErrInvalidRequest.WithMessage("eventbus auth type is signature, secret is invalid")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("eventbus auth type is invalid")
+	}
+	return nil
+}
+
+// encryptEventbusAuth returns the auth to store, nil means no auth.
+func (ctrl *controller) encryptEventbusAuth(auth *metapb.EventbusAuth) (*metadata.EventbusAuth, error) {
+	if err := validateEventbusAuth(auth); err != nil {
+		return nil, err
+	}
+	if auth.GetType() == metapb.EventbusAuth_NONE {
+		return nil, nil
+	}
+	to := &metadata.EventbusAuth{
+		Type:      auth.Type,
+		Tolerance: auth.Tolerance,
+	}
+	for _, token := range auth.Tokens {
+		v, err := crypto.AESEncrypt(token, ctrl.cfg.SecretEncryptionSalt)
+		if err != nil {
+			return nil, errors.ErrAESEncrypt.Wrap(err)
+		}
+		to.Tokens = append(to.Tokens, v)
+	}
+	if auth.Secret != "" {
+		v, err := crypto.AESEncrypt(auth.Secret, ctrl.cfg.SecretEncryptionSalt)
+		if err != nil {
+			return nil, errors.ErrAESEncrypt.Wrap(err)
+		}
+		to.Secret = v
+	}
+	return to, nil
+}
+
+func (ctrl *controller) decryptEventbusAuth(auth *metadata.EventbusAuth) (*metapb.EventbusAuth, error) {
+	if auth == nil {
+		return &metapb.EventbusAuth{Type: metapb.EventbusAuth_NONE}, nil
+	}
+	to := &metapb.EventbusAuth{
+		Type:      auth.Type,
+		Tolerance: auth.Tolerance,
+	}
+	for _, token := range auth.Tokens {
+		v, err := crypto.AESDecrypt(token, ctrl.cfg.SecretEncryptionSalt)
+		if err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		to.Tokens = append(to.Tokens, v)
+	}
+	if auth.Secret != "" {
+		v, err := crypto.AESDecrypt(auth.Secret, ctrl.cfg.SecretEncryptionSalt)
+		if err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		to.Secret = v
+	}
+	return to, nil
+}
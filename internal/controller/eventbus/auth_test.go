@@ -0,0 +1,99 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/kv"
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestController_EventbusAuth(t *testing.T) {
+	Convey("test eventbus auth", t, func() {
+		ctrl := NewController(Config{SecretEncryptionSalt: "salt"}, nil)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		id := vanus.NewTestID()
+		ctrl.eventbusMap[id] = &metadata.Eventbus{ID: id, Name: "test", Namespace: primitive.DefaultNamespace}
+
+		Convey("eventbus not found", func() {
+			_, err := ctrl.SetEventbusAuth(ctx, &ctrlpb.SetEventbusAuthRequest{EventbusId: vanus.NewTestID().Uint64()})
+			So(err, ShouldNotBeNil)
+			_, err = ctrl.GetEventbusAuth(ctx, wrapperspb.UInt64(vanus.NewTestID().Uint64()))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("invalid auth", func() {
+			_, err := ctrl.SetEventbusAuth(ctx, &ctrlpb.SetEventbusAuthRequest{
+				EventbusId: id.Uint64(),
+				Auth:       &metapb.EventbusAuth{Type: metapb.EventbusAuth_TOKEN},
+			})
+			So(err, ShouldNotBeNil)
+			_, err = ctrl.SetEventbusAuth(ctx, &ctrlpb.SetEventbusAuthRequest{
+				EventbusId: id.Uint64(),
+				Auth:       &metapb.EventbusAuth{Type: metapb.EventbusAuth_GITHUB, Secret: primitive.SecretsMask},
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("set and get auth", func() {
+			auth, err := ctrl.GetEventbusAuth(ctx, wrapperspb.UInt64(id.Uint64()))
+			So(err, ShouldBeNil)
+			So(auth.Type, ShouldEqual, metapb.EventbusAuth_NONE)
+
+			var stored []byte
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"), gomock.Any()).
+				DoAndReturn(func(_ stdCtx.Context, _ string, data []byte) error {
+					stored = data
+					return nil
+				})
+			eb, err := ctrl.SetEventbusAuth(ctx, &ctrlpb.SetEventbusAuthRequest{
+				EventbusId: id.Uint64(),
+				Auth: &metapb.EventbusAuth{
+					Type:   metapb.EventbusAuth_TOKEN,
+					Tokens: []string{"t1", "t2"},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(eb.Auth.Type, ShouldEqual, metapb.EventbusAuth_TOKEN)
+			So(eb.Auth.Tokens, ShouldResemble, []string{primitive.SecretsMask, primitive.SecretsMask})
+
+			// the tokens are encrypted in kv
+			md := &metadata.Eventbus{}
+			So(json.Unmarshal(stored, md), ShouldBeNil)
+			So(md.Auth.Tokens, ShouldHaveLength, 2)
+			So(md.Auth.Tokens[0], ShouldNotEqual, "t1")
+
+			auth, err = ctrl.GetEventbusAuth(ctx, wrapperspb.UInt64(id.Uint64()))
+			So(err, ShouldBeNil)
+			So(auth.Tokens, ShouldResemble, []string{"t1", "t2"})
+		})
+	})
+}
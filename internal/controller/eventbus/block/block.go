@@ -43,6 +43,8 @@ type Allocator interface {
 	Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error
 	Pick(ctx context.Context, num int) ([]*metadata.Block, error)
 	PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error)
+	// PickExcluding picks a block in the volume which isn't in excluded.
+	PickExcluding(ctx context.Context, excluded []vanus.ID) (*metadata.Block, error)
 	Stop()
 }
 
@@ -74,7 +76,7 @@ type allocator struct {
 func (al *allocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
 	instances := make([]server.Instance, len(volumes))
 	for idx := range volumes {
-		i := al.selector.SelectByID(volumes[idx])
+		i := al.selector.SelectByID(volumes[idx], al.blockCapacity)
 		if i == nil {
 			return nil, errors.ErrVolumeInstanceNoServer
 		}
@@ -83,6 +85,20 @@ func (al *allocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*
 	return al.pick(ctx, instances)
 }
 
+func (al *allocator) PickExcluding(ctx context.Context, excluded []vanus.ID) (*metadata.Block, error) {
+	al.mutex.Lock()
+	defer al.mutex.Unlock()
+	ins := al.selector.SelectExcluding(excluded, al.blockCapacity)
+	if ins == nil {
+		return nil, errors.ErrVolumeInstanceNotFound
+	}
+	blocks, err := al.pick(ctx, []server.Instance{ins})
+	if err != nil {
+		return nil, err
+	}
+	return blocks[0], nil
+}
+
 func (al *allocator) Run(ctx context.Context, kvCli kv.Client, startDynamicAllocate bool) error {
 	al.kvClient = kvCli
 	pairs, err := al.kvClient.List(ctx, metadata.BlockKeyPrefixInKVStore)
@@ -0,0 +1,259 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vanus-labs/vanus/observability/log"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/server"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	defaultHighWatermark = 0.9
+)
+
+// DefaultAntiAffinityLabels are the volume labels used for replica anti-affinity if none is configured.
+var DefaultAntiAffinityLabels = []string{"zone", "rack"}
+
+// PlacementPolicy decides which volumes the blocks of new segments are placed in.
+type PlacementPolicy struct {
+	// HighWatermark is the max ratio of used capacity of volume, no block is placed in the volume if it would be
+	// exceeded. The default is 0.9.
+	HighWatermark float64
+	// AntiAffinityLabels are the keys of volume labels, such as zone and rack. The replicas of a segment are
+	// spread across volumes with different values of them as far as possible, the former key takes precedence.
+	AntiAffinityLabels []string
+}
+
+// NewVolumeCapacityAware an implementation which selects volumes by their statistics reported by heartbeats.
+// The volumes above the high watermark are refused, and the replicas of a segment are spread across volumes
+// with different labels, then the least loaded volumes are preferred. The new blocks are counted in the statistics
+// by server.Instance once they are created, until they are reported by heartbeats.
+func NewVolumeCapacityAware(f func() []server.Instance, policy PlacementPolicy) VolumeSelector {
+	if policy.HighWatermark <= 0 {
+		policy.HighWatermark = defaultHighWatermark
+	}
+	if policy.AntiAffinityLabels == nil {
+		policy.AntiAffinityLabels = DefaultAntiAffinityLabels
+	}
+	return &volumeCapacitySelector{
+		policy:     policy,
+		getVolumes: f,
+	}
+}
+
+type volumeCapacitySelector struct {
+	policy     PlacementPolicy
+	getVolumes func() []server.Instance
+	mutex      sync.Mutex
+}
+
+type volumeCandidate struct {
+	ins  server.Instance
+	id   vanus.ID
+	stat server.Statistics
+}
+
+// Select get #{num} instances, the same instance is placed in different indexes of returned array only if there
+// are not enough volumes below the high watermark.
+func (s *volumeCapacitySelector) Select(num int, size int64) []server.Instance {
+	instances := make([]server.Instance, 0)
+	if num == 0 || size == 0 {
+		return instances
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	candidates := s.candidates(size)
+	if len(candidates) == 0 {
+		log.Warning(context.TODO(), "no volume is available for new blocks", map[string]interface{}{
+			"high_watermark": s.policy.HighWatermark,
+		})
+		return instances
+	}
+	picked := make([]volumeCandidate, 0, num)
+	for len(picked) < num {
+		pool := excludeCandidates(candidates, picked)
+		if len(pool) == 0 {
+			pool = candidates
+		}
+		c := s.best(pool, picked, candidates)
+		picked = append(picked, c)
+		instances = append(instances, c.ins)
+	}
+	return instances
+}
+
+// SelectByID returns the instance of volume id, nil is returned if it's inactive or would be above the high
+// watermark after a block of size is placed in.
+func (s *volumeCapacitySelector) SelectByID(id vanus.ID, size int64) server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, c := range s.candidates(size) {
+		if c.id == id {
+			return c.ins
+		}
+	}
+	return nil
+}
+
+// SelectExcluding returns the best instance which isn't in excluded, the volumes in excluded are taken as the
+// placed replicas for anti-affinity.
+func (s *volumeCapacitySelector) SelectExcluding(excluded []vanus.ID, size int64) server.Instance {
+	if size == 0 {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	all := s.newCandidates()
+	placed := make([]volumeCandidate, 0, len(excluded))
+	for _, c := range all {
+		if containsVolume(excluded, c.id) {
+			placed = append(placed, c)
+		}
+	}
+	pool := excludeCandidates(s.candidates(size), placed)
+	if len(pool) == 0 {
+		return nil
+	}
+	return s.best(pool, placed, all).ins
+}
+
+// GetAllVolume get all volumes except the draining ones, so no block is pre-allocated in them.
+func (s *volumeCapacitySelector) GetAllVolume() []server.Instance {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	volumes := s.getVolumes()
+	result := make([]server.Instance, 0, len(volumes))
+	for _, ins := range volumes {
+		if !ins.IsDraining() {
+			result = append(result, ins)
+		}
+	}
+	return result
+}
+
+// candidates returns the volumes which are not draining and still below the high watermark after a block of
+// size is placed in, sorted by id. The volumes whose capacities are unknown are always candidates.
+func (s *volumeCapacitySelector) candidates(size int64) []volumeCandidate {
+	result := make([]volumeCandidate, 0)
+	for _, c := range s.newCandidates() {
+		if c.ins.IsDraining() {
+			continue
+		}
+		if c.stat.Capacity == 0 ||
+			float64(c.stat.Used+uint64(size)) <= float64(c.stat.Capacity)*s.policy.HighWatermark {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// best returns the candidate in pool which conflicts with the placed replicas least, and the least loaded one if
+// there are several. The shares of blocks and leaders are computed in all volumes.
+func (s *volumeCapacitySelector) best(pool, placed, all []volumeCandidate) volumeCandidate {
+	var totalBlocks, totalLeaders int
+	for _, c := range all {
+		totalBlocks += c.stat.BlockNum
+		totalLeaders += c.stat.LeaderNum
+	}
+	best, bestScore := pool[0], loadScore(pool[0].stat, totalBlocks, totalLeaders)
+	for _, c := range pool[1:] {
+		score := loadScore(c.stat, totalBlocks, totalLeaders)
+		if cmp := s.compareConflicts(c, best, placed); cmp < 0 || (cmp == 0 && score < bestScore) {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
+
+// compareConflicts compares the numbers of placed replicas which have the same label values as a and b, label by
+// label in order of precedence.
+func (s *volumeCapacitySelector) compareConflicts(a, b volumeCandidate, placed []volumeCandidate) int {
+	for _, key := range s.policy.AntiAffinityLabels {
+		ca, cb := labelConflicts(a, placed, key), labelConflicts(b, placed, key)
+		if ca != cb {
+			return ca - cb
+		}
+	}
+	return 0
+}
+
+func labelConflicts(c volumeCandidate, placed []volumeCandidate, key string) int {
+	value := c.stat.Labels[key]
+	if value == "" {
+		return 0
+	}
+	n := 0
+	for _, p := range placed {
+		if p.stat.Labels[key] == value {
+			n++
+		}
+	}
+	return n
+}
+
+// loadScore is the sum of the ratio of used capacity, and the shares of blocks and leaders in all candidates,
+// the lower the better.
+func loadScore(stat server.Statistics, totalBlocks, totalLeaders int) float64 {
+	score := 0.0
+	if stat.Capacity != 0 {
+		score += float64(stat.Used) / float64(stat.Capacity)
+	}
+	if totalBlocks != 0 {
+		score += float64(stat.BlockNum) / float64(totalBlocks)
+	}
+	if totalLeaders != 0 {
+		score += float64(stat.LeaderNum) / float64(totalLeaders)
+	}
+	return score
+}
+
+// newCandidates returns all volumes sorted by id.
+func (s *volumeCapacitySelector) newCandidates() []volumeCandidate {
+	volumes := s.getVolumes()
+	result := make([]volumeCandidate, 0, len(volumes))
+	for _, ins := range volumes {
+		result = append(result, volumeCandidate{ins: ins, id: ins.GetMeta().ID, stat: ins.GetStatistics()})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].id < result[j].id
+	})
+	return result
+}
+
+func excludeCandidates(candidates, excluded []volumeCandidate) []volumeCandidate {
+	result := make([]volumeCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		found := false
+		for _, e := range excluded {
+			if e.id == c.id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/server"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func newTestVolume(ctrl *gomock.Controller, id uint64, stat server.Statistics) server.Instance {
+	ins := server.NewMockInstance(ctrl)
+	ins.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: vanus.NewIDFromUint64(id)})
+	ins.EXPECT().GetStatistics().AnyTimes().Return(stat)
+	ins.EXPECT().IsDraining().AnyTimes().Return(false)
+	return ins
+}
+
+func volumeIDs(instances []server.Instance) []uint64 {
+	ids := make([]uint64, len(instances))
+	for idx, ins := range instances {
+		ids[idx] = ins.GetMeta().ID.Uint64()
+	}
+	return ids
+}
+
+func TestNewVolumeCapacityAware(t *testing.T) {
+	Convey("test capacity-aware selector", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		vol1 := newTestVolume(ctrl, 1, server.Statistics{
+			Capacity: 1000, Used: 850, BlockNum: 8, Labels: map[string]string{"zone": "b"},
+		})
+		vol2 := newTestVolume(ctrl, 2, server.Statistics{
+			Capacity: 1000, Used: 100, BlockNum: 1, Labels: map[string]string{"zone": "a"},
+		})
+		vol3 := newTestVolume(ctrl, 3, server.Statistics{
+			Capacity: 1000, Used: 0, Labels: map[string]string{"zone": "a"},
+		})
+		vol4 := newTestVolume(ctrl, 4, server.Statistics{
+			Capacity: 1000, Used: 500, BlockNum: 5, LeaderNum: 2, Labels: map[string]string{"zone": "b"},
+		})
+		srvs := []server.Instance{vol1, vol2, vol3, vol4}
+		selector := NewVolumeCapacityAware(func() []server.Instance {
+			return srvs
+		}, PlacementPolicy{})
+
+		Convey("test select", func() {
+			// vol1 is above the high watermark after placing, and vol4 is in another zone than vol3.
+			So(volumeIDs(selector.Select(2, 100)), ShouldResemble, []uint64{3, 4})
+			So(volumeIDs(selector.Select(3, 100)), ShouldHaveLength, 3)
+			So(volumeIDs(selector.Select(3, 100)), ShouldNotContain, uint64(1))
+
+			So(selector.Select(0, 100), ShouldHaveLength, 0)
+			So(selector.Select(3, 0), ShouldHaveLength, 0)
+		})
+
+		Convey("test select with duplicated volumes", func() {
+			srvs = []server.Instance{vol1, vol3}
+			So(volumeIDs(selector.Select(3, 100)), ShouldResemble, []uint64{3, 3, 3})
+			srvs = []server.Instance{vol1}
+			So(selector.Select(3, 100), ShouldHaveLength, 0)
+		})
+
+		Convey("test select by id", func() {
+			So(selector.SelectByID(vanus.NewIDFromUint64(2), 100), ShouldEqual, vol2)
+			So(selector.SelectByID(vanus.NewIDFromUint64(5), 100), ShouldBeNil)
+
+			// vol1 is above the high watermark only if the block is large enough.
+			So(selector.SelectByID(vanus.NewIDFromUint64(1), 50), ShouldEqual, vol1)
+			So(selector.SelectByID(vanus.NewIDFromUint64(1), 100), ShouldBeNil)
+
+			full := newTestVolume(ctrl, 5, server.Statistics{Capacity: 1000, Used: 950})
+			srvs = append(srvs, full)
+			So(selector.SelectByID(vanus.NewIDFromUint64(5), 100), ShouldBeNil)
+		})
+
+		Convey("test select excluding", func() {
+			ins := selector.SelectExcluding([]vanus.ID{vanus.NewIDFromUint64(3)}, 100)
+			So(ins, ShouldEqual, vol4)
+			ins = selector.SelectExcluding([]vanus.ID{vanus.NewIDFromUint64(4)}, 100)
+			So(ins, ShouldEqual, vol3)
+			ins = selector.SelectExcluding([]vanus.ID{
+				vanus.NewIDFromUint64(2), vanus.NewIDFromUint64(3), vanus.NewIDFromUint64(4),
+			}, 100)
+			So(ins, ShouldBeNil)
+			So(selector.SelectExcluding(nil, 0), ShouldBeNil)
+		})
+
+		Convey("test draining volume", func() {
+			draining := server.NewMockInstance(ctrl)
+			draining.EXPECT().GetMeta().AnyTimes().Return(&metadata.VolumeMetadata{ID: vanus.NewIDFromUint64(5)})
+			draining.EXPECT().GetStatistics().AnyTimes().Return(server.Statistics{})
+			draining.EXPECT().IsDraining().AnyTimes().Return(true)
+			srvs = append(srvs, draining)
+			So(selector.SelectByID(vanus.NewIDFromUint64(5), 100), ShouldBeNil)
+			So(volumeIDs(selector.Select(3, 100)), ShouldNotContain, uint64(5))
+			So(volumeIDs(selector.GetAllVolume()), ShouldResemble, []uint64{1, 2, 3, 4})
+		})
+
+		Convey("test anti-affinity labels", func() {
+			selector = NewVolumeCapacityAware(func() []server.Instance {
+				return srvs
+			}, PlacementPolicy{HighWatermark: 1, AntiAffinityLabels: []string{"rack"}})
+			// the zone is ignored, so the least loaded volumes are selected.
+			So(volumeIDs(selector.Select(2, 100)), ShouldResemble, []uint64{3, 2})
+		})
+	})
+}
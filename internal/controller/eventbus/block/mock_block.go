@@ -67,6 +67,21 @@ func (mr *MockAllocatorMockRecorder) PickByVolumes(ctx, volumes interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickByVolumes", reflect.TypeOf((*MockAllocator)(nil).PickByVolumes), ctx, volumes)
 }
 
+// PickExcluding mocks base method.
+func (m *MockAllocator) PickExcluding(ctx context.Context, excluded []vanus.ID) (*metadata.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickExcluding", ctx, excluded)
+	ret0, _ := ret[0].(*metadata.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickExcluding indicates an expected call of PickExcluding.
+func (mr *MockAllocatorMockRecorder) PickExcluding(ctx, excluded interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickExcluding", reflect.TypeOf((*MockAllocator)(nil).PickExcluding), ctx, excluded)
+}
+
 // Run mocks base method.
 func (m *MockAllocator) Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error {
 	m.ctrl.T.Helper()
@@ -34,8 +34,12 @@ type VolumeSelector interface {
 	// in order to make sure that length of returned array equals with #{num}
 	Select(num int, size int64) []server.Instance
 
-	// SelectByID return a specified server.Instance with ServerID
-	SelectByID(id vanus.ID) server.Instance
+	// SelectByID return a specified server.Instance with ServerID, which a block of size is placed in
+	SelectByID(id vanus.ID, size int64) server.Instance
+
+	// SelectExcluding return a server.Instance which isn't in #{excluded}, it's used to place a new replica
+	// of an existing Segment. nil is returned if there is no candidate.
+	SelectExcluding(excluded []vanus.ID, size int64) server.Instance
 
 	// GetAllVolume get all volumes
 	GetAllVolume() []server.Instance
@@ -94,7 +98,7 @@ func (s *volumeRoundRobinSelector) Select(num int, size int64) []server.Instance
 	return instances
 }
 
-func (s *volumeRoundRobinSelector) SelectByID(id vanus.ID) server.Instance {
+func (s *volumeRoundRobinSelector) SelectByID(id vanus.ID, _ int64) server.Instance {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -107,6 +111,37 @@ func (s *volumeRoundRobinSelector) SelectByID(id vanus.ID) server.Instance {
 	return nil
 }
 
+// SelectExcluding get the first instance which isn't excluded, starting from the current position of round-robin.
+func (s *volumeRoundRobinSelector) SelectExcluding(excluded []vanus.ID, size int64) server.Instance {
+	if size == 0 {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.getVolumes()
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].GetMeta().ID.Key() < volumes[j].GetMeta().ID.Key()
+	})
+	for idx := range volumes {
+		ins := volumes[(s.count+int64(idx))%int64(len(volumes))]
+		if !containsVolume(excluded, ins.GetMeta().ID) {
+			s.count++
+			return ins
+		}
+	}
+	return nil
+}
+
+func containsVolume(volumes []vanus.ID, id vanus.ID) bool {
+	for _, v := range volumes {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *volumeRoundRobinSelector) GetAllVolume() []server.Instance {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -68,15 +68,15 @@ func TestNewVolumeRoundRobin(t *testing.T) {
 		srv1.EXPECT().ID().Return(vanus.NewIDFromUint64(1)).AnyTimes()
 		srv2.EXPECT().ID().Return(vanus.NewIDFromUint64(2)).AnyTimes()
 		Convey("test select instance by id", func() {
-			ins := selector.SelectByID(vanus.NewIDFromUint64(1))
+			ins := selector.SelectByID(vanus.NewIDFromUint64(1), 64*1024*1024)
 			So(ins, ShouldNotBeNil)
 			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(1))
 
-			ins = selector.SelectByID(vanus.NewIDFromUint64(2))
+			ins = selector.SelectByID(vanus.NewIDFromUint64(2), 64*1024*1024)
 			So(ins, ShouldNotBeNil)
 			So(ins.GetMeta().ID.Uint64(), ShouldEqual, uint64(2))
 
-			ins = selector.SelectByID(vanus.NewIDFromUint64(3))
+			ins = selector.SelectByID(vanus.NewIDFromUint64(3), 64*1024*1024)
 			So(ins, ShouldBeNil)
 		})
 
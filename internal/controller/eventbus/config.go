@@ -14,6 +14,8 @@
 
 package eventbus
 
+import "time"
+
 type Config struct {
 	IP               string            `yaml:"ip"`
 	Port             int               `yaml:"port"`
@@ -22,4 +24,24 @@ type Config struct {
 	Replicas         uint              `yaml:"replicas"`
 	Topology         map[string]string `yaml:"topology"`
 	SegmentCapacity  int64             `yaml:"segment_capacity"`
+	// SegmentOffloadAfter is how long the full segments are kept in store nodes before they are offloaded to
+	// object storage, offloading is disabled if it's zero.
+	SegmentOffloadAfter time.Duration `yaml:"segment_offload_after"`
+	// ReplicaLostTimeout is how long a store node is inactive before the replicas in it are considered lost and
+	// re-replicated to other nodes, the default is 5 minutes.
+	ReplicaLostTimeout time.Duration `yaml:"replica_lost_timeout"`
+	// LeaderImbalanceThreshold is the max difference between the numbers of working segment leaders in store
+	// nodes, the leaders are rebalanced if it's exceeded. The default is 2, and rebalancing is disabled if it's
+	// negative.
+	LeaderImbalanceThreshold int `yaml:"leader_imbalance_threshold"`
+	// VolumeHighWatermark is the max ratio of used capacity of volume, no block of new segment is placed in the
+	// volume above it. The default is 0.9.
+	VolumeHighWatermark float64 `yaml:"volume_high_watermark"`
+	// ReplicaAntiAffinityLabels are the keys of volume labels, the replicas of a segment are spread across volumes
+	// with different values of them. The default is zone and rack.
+	ReplicaAntiAffinityLabels []string `yaml:"replica_anti_affinity_labels"`
+	// SecretEncryptionSalt is the key to encrypt the secrets of eventbus auth.
+	SecretEncryptionSalt string `yaml:"secret_encryption_salt"`
+	// ControllerAddr is used to query the subscriptions from trigger controller.
+	ControllerAddr []string `yaml:"-"`
 }
@@ -27,17 +27,20 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/vanus-labs/vanus/observability/log"
 	"github.com/vanus-labs/vanus/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/cluster"
 	"github.com/vanus-labs/vanus/pkg/errors"
 	"github.com/vanus-labs/vanus/pkg/util"
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/block"
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/server"
@@ -62,16 +65,23 @@ const (
 
 func NewController(cfg Config, mem member.Member) *controller {
 	c := &controller{
-		cfg:         &cfg,
-		ssMgr:       server.NewServerManager(),
-		eventbusMap: map[vanus.ID]*metadata.Eventbus{},
-		member:      mem,
-		isLeader:    false,
-		readyNotify: make(chan error, 1),
-		stopNotify:  make(chan error, 1),
+		cfg:          &cfg,
+		ssMgr:        server.NewServerManager(),
+		eventbusMap:  map[vanus.ID]*metadata.Eventbus{},
+		namespaceMap: map[string]*metadata.Namespace{},
+		member:       mem,
+		isLeader:     false,
+		readyNotify:  make(chan error, 1),
+		stopNotify:   make(chan error, 1),
+		triggerCtrl: cluster.NewClusterController(cfg.ControllerAddr, insecure.NewCredentials()).
+			TriggerService().RawClient(),
 	}
 	c.volumeMgr = volume.NewVolumeManager(c.ssMgr)
-	c.eventlogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity)
+	c.eventlogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity, cfg.SegmentOffloadAfter,
+		cfg.ReplicaLostTimeout, cfg.LeaderImbalanceThreshold, block.PlacementPolicy{
+			HighWatermark:      cfg.VolumeHighWatermark,
+			AntiAffinityLabels: cfg.ReplicaAntiAffinityLabels,
+		}, c.getEventbusRetention, c.getEventbusCompaction, c.getEventbusCompression)
 	return c
 }
 
@@ -81,7 +91,9 @@ type controller struct {
 	volumeMgr            volume.Manager
 	eventlogMgr          eventlog.Manager
 	ssMgr                server.Manager
+	triggerCtrl          ctrlpb.TriggerControllerClient
 	eventbusMap          map[vanus.ID]*metadata.Eventbus
+	namespaceMap         map[string]*metadata.Namespace
 	member               member.Member
 	cancelCtx            context.Context
 	cancelFunc           context.CancelFunc
@@ -92,12 +104,19 @@ type controller struct {
 	mutex                sync.Mutex
 	eventbusUpdatedCount int64
 	eventbusDeletedCount int64
+	// compressions caches the compression of eventbuses, it's read without holding mutex, because the segments are
+	// created when the eventlogs are acquired with mutex held.
+	compressions sync.Map
 }
 
 func (ctrl *controller) GetEventbusWithHumanFriendly(ctx context.Context,
 	request *ctrlpb.GetEventbusWithHumanFriendlyRequest) (*metapb.Eventbus, error) {
+	namespace := request.Namespace
+	if namespace == "" {
+		namespace = primitive.DefaultNamespace
+	}
 	for id, eventbus := range ctrl.eventbusMap {
-		if eventbus.Name == request.EventbusName {
+		if eventbus.Namespace == namespace && eventbus.Name == request.EventbusName {
 			return ctrl.getEventbus(id)
 		}
 	}
@@ -134,6 +153,18 @@ func (ctrl *controller) CreateEventbus(
 	if err := isValidEventbusName(req.Name); err != nil {
 		return nil, err
 	}
+	if req.Namespace == primitive.SystemNamespace {
+		return nil, errors.ErrInvalidRequest.WithMessage("the system namespace is reserved for system eventbus")
+	}
+	if err := validateRetentionPolicy(req.Retention); err != nil {
+		return nil, err
+	}
+	if err := validateCompactionPolicy(req.Compaction); err != nil {
+		return nil, err
+	}
+	if err := validateCompression(req.Compression); err != nil {
+		return nil, err
+	}
 	eb, err := ctrl.createEventbus(ctx, req)
 	if err != nil {
 		return nil, err
@@ -144,6 +175,7 @@ func (ctrl *controller) CreateEventbus(
 		Name:        primitive.GetDeadLetterEventbusName(vanus.NewIDFromUint64(eb.Id)),
 		LogNumber:   1,
 		Description: "System DeadLetter Eventbus For " + req.Name,
+		Namespace:   primitive.SystemNamespace,
 	})
 	if err != nil {
 		log.Error(context.Background(), "create dead letter eventbus error", map[string]interface{}{
@@ -181,6 +213,7 @@ func (ctrl *controller) CreateSystemEventbus(
 	if !strings.HasPrefix(req.Name, primitive.SystemEventbusNamePrefix) {
 		return nil, errors.ErrInvalidRequest.WithMessage("system eventbus must start with __")
 	}
+	req.Namespace = primitive.SystemNamespace
 	return ctrl.createEventbus(ctx, req)
 }
 
@@ -193,6 +226,13 @@ func (ctrl *controller) createEventbus(
 		return nil, errors.ErrResourceCanNotOp.WithMessage(
 			"the cluster isn't ready for create eventbus")
 	}
+	namespace, err := ctrl.getNamespace(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctrl.checkEventbusQuota(namespace); err != nil {
+		return nil, err
+	}
 	logNum := req.LogNumber
 	if logNum == 0 {
 		logNum = 1
@@ -202,6 +242,11 @@ func (ctrl *controller) createEventbus(
 			" maximum is %d", maximumEventlogNum))
 	}
 
+	auth, err := ctrl.encryptEventbusAuth(req.Auth)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := vanus.NewID()
 	if err != nil {
 		log.Warning(ctx, "failed to create eventbus ID", map[string]interface{}{
@@ -212,19 +257,27 @@ func (ctrl *controller) createEventbus(
 	eb := &metadata.Eventbus{
 		ID:          id,
 		Name:        req.Name,
+		Namespace:   namespace,
 		LogNumber:   int(logNum),
 		Eventlogs:   make([]*metadata.Eventlog, int(logNum)),
 		Description: req.Description,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Auth:        auth,
+		Retention:   metadata.ConvertFromProtoRetentionPolicy(req.Retention),
+		Compaction:  metadata.ConvertFromProtoCompactionPolicy(req.Compaction),
+		Compression: req.Compression,
 	}
-	exist, err := ctrl.kvStore.Exists(ctx, metadata.GetEventbusMetadataKey(eb.Name))
+	exist, err := ctrl.kvStore.Exists(ctx, metadata.GetEventbusMetadataKey(eb.Namespace, eb.Name))
 	if err != nil {
 		return nil, err
 	}
 	if exist {
 		return nil, errors.ErrResourceAlreadyExist.WithMessage("the eventbus already exist")
 	}
+	if eb.Compression != metapb.CompressAlgorithm_NONE {
+		ctrl.compressions.Store(eb.ID, eb.Compression)
+	}
 	for idx := 0; idx < eb.LogNumber; idx++ {
 		el, err := ctrl.eventlogMgr.AcquireEventlog(ctx, eb.ID, eb.Name)
 		if err != nil {
@@ -236,7 +289,7 @@ func (ctrl *controller) createEventbus(
 
 	{
 		data, _ := json.Marshal(eb)
-		if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Name), data); err != nil {
+		if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Namespace, eb.Name), data); err != nil {
 			return nil, err
 		}
 	}
@@ -280,13 +333,14 @@ func (ctrl *controller) deleteEventbus(ctx context.Context, id vanus.ID) error {
 	if !exist {
 		return errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
 	}
-	err := ctrl.kvStore.Delete(ctx, metadata.GetEventbusMetadataKey(bus.Name))
+	err := ctrl.kvStore.Delete(ctx, metadata.GetEventbusMetadataKey(bus.Namespace, bus.Name))
 	if err != nil {
 		return errors.ErrInternal.WithMessage("delete eventbus metadata in kv failed").Wrap(err)
 	}
 
 	// TODO(wenfeng.wang) notify gateway to cut flow
 	delete(ctrl.eventbusMap, id)
+	ctrl.compressions.Delete(id)
 	wg := sync.WaitGroup{}
 
 	for _, v := range bus.Eventlogs {
@@ -324,11 +378,16 @@ func (ctrl *controller) getEventbus(id vanus.ID) (*metapb.Eventbus, error) {
 }
 
 func (ctrl *controller) ListEventbus(ctx context.Context,
-	_ *ctrlpb.ListEventbusRequest,
+	req *ctrlpb.ListEventbusRequest,
 ) (*ctrlpb.ListEventbusResponse, error) {
 	eventbusList := make([]*metapb.Eventbus, 0)
 	for _, v := range ctrl.eventbusMap {
-		if strings.HasPrefix(v.Name, primitive.SystemEventbusNamePrefix) {
+		if req.Namespace != "" && v.Namespace != req.Namespace {
+			continue
+		}
+		// system eventbuses are only listed when the system namespace is specified
+		if req.Namespace != primitive.SystemNamespace &&
+			strings.HasPrefix(v.Name, primitive.SystemEventbusNamePrefix) {
 			continue
 		}
 		ebMD := metadata.Convert2ProtoEventbus(v)[0]
@@ -341,7 +400,157 @@ func (ctrl *controller) UpdateEventbus(
 	ctx context.Context, req *ctrlpb.UpdateEventbusRequest,
 ) (*metapb.Eventbus, error) {
 	atomic.AddInt64(&ctrl.eventbusUpdatedCount, 1)
-	return &metapb.Eventbus{}, nil
+	if err := validateRetentionPolicy(req.Retention); err != nil {
+		return nil, err
+	}
+	if err := validateCompactionPolicy(req.Compaction); err != nil {
+		return nil, err
+	}
+	if err := validateCompression(req.GetCompression()); err != nil {
+		return nil, err
+	}
+	if req.LogNumber < 0 || req.LogNumber > maximumEventlogNum {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the number of eventlog must be in [1, %d]",
+			maximumEventlogNum))
+	}
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if !ctrl.isReady(ctx) {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the cluster isn't ready for update eventbus")
+	}
+	id := vanus.NewIDFromUint64(req.Id)
+	eb, exist := ctrl.eventbusMap[id]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	if req.LogNumber > 0 && int(req.LogNumber) < eb.LogNumber {
+		return nil, errors.ErrInvalidRequest.WithMessage("the number of eventlog can't be decreased")
+	}
+	if int(req.LogNumber) > eb.LogNumber && !req.Force {
+		if err := ctrl.checkOrderedSubscription(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	updated := *eb
+	if req.Description != nil {
+		updated.Description = req.GetDescription()
+	}
+	if req.Retention != nil {
+		updated.Retention = metadata.ConvertFromProtoRetentionPolicy(req.Retention)
+	}
+	if req.Compaction != nil {
+		updated.Compaction = metadata.ConvertFromProtoCompactionPolicy(req.Compaction)
+	}
+	if req.Compression != nil {
+		updated.Compression = req.GetCompression()
+		ctrl.compressions.Store(id, updated.Compression)
+	}
+	// the clients pick up the new eventlogs when they refresh the writable and readable eventlogs.
+	var acquired []*metadata.Eventlog
+	if num := int(req.LogNumber); num > eb.LogNumber {
+		updated.Eventlogs = make([]*metadata.Eventlog, eb.LogNumber, num)
+		copy(updated.Eventlogs, eb.Eventlogs)
+		for len(updated.Eventlogs) < num {
+			el, err := ctrl.eventlogMgr.AcquireEventlog(ctx, eb.ID, eb.Name)
+			if err != nil {
+				ctrl.releaseEventlogs(ctx, acquired)
+				return nil, err
+			}
+			acquired = append(acquired, el)
+			updated.Eventlogs = append(updated.Eventlogs, el)
+		}
+		updated.LogNumber = num
+	}
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Namespace, eb.Name), data); err != nil {
+		ctrl.releaseEventlogs(ctx, acquired)
+		return nil, err
+	}
+	ctrl.eventbusMap[id] = &updated
+	if len(acquired) > 0 {
+		log.Info(ctx, "the eventlogs of eventbus increased", map[string]interface{}{
+			log.KeyEventbusID: eb.ID,
+			"from":            eb.LogNumber,
+			"to":              updated.LogNumber,
+		})
+	}
+	return ctrl.getEventbus(id)
+}
+
+// checkOrderedSubscription refuses to increase the eventlogs of eventbus which has ordered subscriptions, since some
+// keys are routed to the new eventlogs, and the order of events with these keys isn't kept across the change.
+func (ctrl *controller) checkOrderedSubscription(ctx context.Context, id vanus.ID) error {
+	subs, err := ctrl.triggerCtrl.ListSubscription(ctx, &ctrlpb.ListSubscriptionRequest{EventbusId: id.Uint64()})
+	if err != nil {
+		return errors.ErrInternal.WithMessage("list subscriptions of eventbus failed").Wrap(err)
+	}
+	for _, sub := range subs.GetSubscription() {
+		if sub.GetConfig().GetOrderedEvent() {
+			return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf("the subscription %s is ordered, the order "+
+				"of events by key isn't kept after the eventlogs are increased, set force to increase anyway",
+				vanus.NewIDFromUint64(sub.Id)))
+		}
+	}
+	return nil
+}
+
+// releaseEventlogs deletes the eventlogs acquired by a failed update.
+func (ctrl *controller) releaseEventlogs(ctx context.Context, eventlogs []*metadata.Eventlog) {
+	for _, el := range eventlogs {
+		ctrl.eventlogMgr.DeleteEventlog(ctx, el.ID)
+	}
+}
+
+func validateRetentionPolicy(policy *metapb.RetentionPolicy) error {
+	if policy.GetMaxAgeSeconds() < 0 || policy.GetMaxBytes() < 0 || policy.GetMaxEvents() < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the limits of retention can't be negative")
+	}
+	return nil
+}
+
+func validateCompactionPolicy(policy *metapb.CompactionPolicy) error {
+	if policy.GetTombstoneGraceSeconds() < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the grace period of tombstone can't be negative")
+	}
+	return nil
+}
+
+func validateCompression(compression metapb.CompressAlgorithm) error {
+	if _, ok := metapb.CompressAlgorithm_name[int32(compression)]; !ok {
+		return errors.ErrInvalidRequest.WithMessage("the compression algorithm is unknown")
+	}
+	return nil
+}
+
+// getEventbusRetention returns the retention policy of eventbus, it's used by the expiry task of eventlogs.
+func (ctrl *controller) getEventbusRetention(id vanus.ID) *metadata.RetentionPolicy {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if eb, exist := ctrl.eventbusMap[id]; exist {
+		return eb.Retention
+	}
+	return nil
+}
+
+// getEventbusCompaction returns the compaction policy of eventbus, it's used by the compaction task of eventlogs.
+func (ctrl *controller) getEventbusCompaction(id vanus.ID) *metadata.CompactionPolicy {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if eb, exist := ctrl.eventbusMap[id]; exist {
+		return eb.Compaction
+	}
+	return nil
+}
+
+// getEventbusCompression returns the compression of eventbus, it's used when the segments of eventlogs are created.
+func (ctrl *controller) getEventbusCompression(id vanus.ID) metapb.CompressAlgorithm {
+	if v, ok := ctrl.compressions.Load(id); ok {
+		c, _ := v.(metapb.CompressAlgorithm)
+		return c
+	}
+	return metapb.CompressAlgorithm_NONE
 }
 
 func (ctrl *controller) ListSegment(
@@ -358,6 +567,24 @@ func (ctrl *controller) ListSegment(
 	}, nil
 }
 
+func (ctrl *controller) ListSegmentReplication(
+	_ context.Context, _ *emptypb.Empty,
+) (*ctrlpb.ListSegmentReplicationResponse, error) {
+	return &ctrlpb.ListSegmentReplicationResponse{
+		Replications: eventlog.Convert2ProtoReplication(ctrl.eventlogMgr.ListSegmentReplication()...),
+	}, nil
+}
+
+func (ctrl *controller) RebalanceLeaders(
+	ctx context.Context, req *ctrlpb.RebalanceLeadersRequest,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	if req.Threshold < 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the threshold can not be negative")
+	}
+	res := ctrl.eventlogMgr.RebalanceLeaders(ctx, req.DryRun, int(req.Threshold))
+	return eventlog.Convert2ProtoLeaderRebalance(res), nil
+}
+
 func (ctrl *controller) RegisterSegmentServer(
 	ctx context.Context, req *ctrlpb.RegisterSegmentServerRequest,
 ) (*ctrlpb.RegisterSegmentServerResponse, error) {
@@ -375,8 +602,9 @@ func (ctrl *controller) RegisterSegmentServer(
 	volInstance = ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId))
 	if volInstance == nil {
 		volMD := &metadata.VolumeMetadata{
-			ID:     vanus.NewIDFromUint64(req.VolumeId),
-			Blocks: map[uint64]*metadata.Block{},
+			ID:       vanus.NewIDFromUint64(req.VolumeId),
+			Capacity: int64(req.Capacity),
+			Blocks:   map[uint64]*metadata.Block{},
 		}
 		_volInstance, err := ctrl.volumeMgr.RegisterVolume(ctx, volMD)
 		if err != nil {
@@ -423,6 +651,15 @@ func (ctrl *controller) UnregisterSegmentServer(ctx context.Context,
 		return nil, errors.ErrResourceNotFound.WithMessage("block server not found")
 	}
 
+	// The replicas in the draining volume may be the only copies of segments until they are moved away.
+	if ins := ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId)); ins != nil &&
+		ins.IsDraining() && !req.Force {
+		if replicas, _ := ctrl.eventlogMgr.CountVolumeReplicas(ins.ID()); replicas > 0 {
+			return nil, errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+				"the volume is draining, %d replicas are left in it, use force to unregister", replicas))
+		}
+	}
+
 	if err := ctrl.ssMgr.RemoveServer(ctx, srv); err != nil {
 		log.Warning(ctx, "remove server from segmentServerManager error", map[string]interface{}{
 			log.KeyError: err,
@@ -436,6 +673,55 @@ func (ctrl *controller) UnregisterSegmentServer(ctx context.Context,
 	return &ctrlpb.UnregisterSegmentServerResponse{}, nil
 }
 
+func (ctrl *controller) DecommissionVolume(
+	ctx context.Context, req *ctrlpb.DecommissionVolumeRequest,
+) (*ctrlpb.DecommissionVolumeResponse, error) {
+	var ins server.Instance
+	if req.VolumeId != 0 {
+		ins = ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId))
+	} else if req.Address != "" {
+		for _, v := range ctrl.volumeMgr.GetAllActiveVolumes() {
+			if v.Address() == req.Address {
+				ins = v
+				break
+			}
+		}
+	}
+	if ins == nil {
+		return nil, errors.ErrVolumeInstanceNotFound
+	}
+
+	if !ins.IsDraining() {
+		// The replicas in the volume are moved to others, so there must be enough volumes left.
+		available := 0
+		for _, v := range ctrl.volumeMgr.GetAllActiveVolumes() {
+			if v.ID() != ins.ID() && !v.IsDraining() {
+				available++
+			}
+		}
+		if available < int(ctrl.cfg.Replicas) {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("only %d store nodes are left, %d are required", available, ctrl.cfg.Replicas))
+		}
+		if err := ctrl.volumeMgr.Decommission(ctx, ins); err != nil {
+			return nil, err
+		}
+	}
+
+	replicas, leaders := ctrl.eventlogMgr.CountVolumeReplicas(ins.ID())
+	state := primitive.VolumeStateDraining
+	if replicas == 0 {
+		state = primitive.VolumeStateDrained
+	}
+	return &ctrlpb.DecommissionVolumeResponse{
+		VolumeId: ins.ID().Uint64(),
+		Address:  ins.Address(),
+		State:    state,
+		Replicas: int32(replicas),
+		Leaders:  int32(leaders),
+	}, nil
+}
+
 func (ctrl *controller) QuerySegmentRouteInfo(ctx context.Context,
 	req *ctrlpb.QuerySegmentRouteInfoRequest,
 ) (*ctrlpb.QuerySegmentRouteInfoResponse, error) {
@@ -506,6 +792,15 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 	} else {
 		srv.Polish()
 	}
+	if ins := ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId)); ins != nil {
+		ins.UpdateStatistics(server.Statistics{
+			Capacity:  req.Capacity,
+			Used:      req.Used,
+			BlockNum:  int(req.BlockNumber),
+			LeaderNum: int(req.LeaderNumber),
+			Labels:    req.Labels,
+		})
+	}
 	segments := make(map[string][]eventlog.Segment)
 	for _, info := range req.HealthInfo {
 		blockID := vanus.NewIDFromUint64(info.Id)
@@ -625,6 +920,7 @@ func (ctrl *controller) recordMetrics() {
 				atomic.LoadInt64(&ctrl.eventbusUpdatedCount)))
 			metrics.EventbusDeletedGauge.Set(float64(
 				atomic.LoadInt64(&ctrl.eventbusDeletedCount)))
+			ctrl.recordNamespaceMetrics()
 			ctrl.mutex.Unlock()
 		case <-ctrl.cancelCtx.Done():
 			log.Info(ctrl.cancelCtx, "record leadership exiting...", nil)
@@ -643,6 +939,10 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event me
 			return nil
 		}
 		ctrl.isLeader = true
+		if err := ctrl.loadNamespace(ctx); err != nil {
+			ctrl.stop(ctx, err)
+			return err
+		}
 		if err := ctrl.loadEventbus(ctx); err != nil {
 			ctrl.stop(ctx, err)
 			return err
@@ -686,7 +986,15 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if busInfo.Namespace == "" {
+			if err = ctrl.migrateEventbus(ctx, busInfo); err != nil {
+				return err
+			}
+		}
 		ctrl.eventbusMap[busInfo.ID] = busInfo
+		if busInfo.Compression != metapb.CompressAlgorithm_NONE {
+			ctrl.compressions.Store(busInfo.ID, busInfo.Compression)
+		}
 	}
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
@@ -26,11 +27,15 @@ import (
 
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/server"
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/vanus-labs/vanus/internal/controller/member"
 	"github.com/vanus-labs/vanus/internal/kv"
+	"github.com/vanus-labs/vanus/internal/primitive"
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 	"github.com/vanus-labs/vanus/pkg/errors"
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 )
 
 func TestController_CreateEventbus(t *testing.T) {
@@ -50,10 +55,12 @@ func TestController_CreateEventbus(t *testing.T) {
 		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
 		mockMember.EXPECT().IsReady().AnyTimes().Return(true)
 		mockMember.EXPECT().GetLeaderAddr().AnyTimes().Return("test")
+		ctrl.namespaceMap[primitive.DefaultNamespace] = &metadata.Namespace{Name: primitive.DefaultNamespace}
+		ctrl.namespaceMap[primitive.SystemNamespace] = &metadata.Namespace{Name: primitive.SystemNamespace}
 
 		Convey("test create a eventbus two times", func() {
-			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).Return(false, nil)
-			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey("test-1"), gomock.Any()).
+			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test-1")).Times(1).Return(false, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test-1"), gomock.Any()).
 				Times(1).Return(nil)
 			kvCli.EXPECT().Exists(ctx, gomock.Any()).Times(1).Return(false, nil)
 			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).
@@ -90,7 +97,7 @@ func TestController_CreateEventbus(t *testing.T) {
 		})
 
 		Convey("test create a eventbus but exist", func() {
-			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).Return(true, nil)
+			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test-1")).Times(1).Return(true, nil)
 
 			res, err := ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
 				Name:      "test-1",
@@ -133,6 +140,7 @@ func TestController_DeleteEventbus(t *testing.T) {
 		md := &metadata.Eventbus{
 			ID:        vanus.NewTestID(),
 			Name:      "test-1",
+			Namespace: primitive.DefaultNamespace,
 			LogNumber: 2,
 			Eventlogs: []*metadata.Eventlog{
 				{
@@ -145,7 +153,7 @@ func TestController_DeleteEventbus(t *testing.T) {
 		}
 
 		Convey("deleting an existed eventbus, but kv error", func() {
-			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).
+			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test-1")).Times(1).
 				Return(fmt.Errorf("test"))
 
 			ctrl.eventbusMap[md.ID] = md
@@ -160,7 +168,7 @@ func TestController_DeleteEventbus(t *testing.T) {
 		})
 
 		Convey("deleting an existed eventbus success", func() {
-			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).
+			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test-1")).Times(1).
 				Return(nil)
 
 			elMgr.EXPECT().DeleteEventlog(ctx, md.Eventlogs[0].ID).Times(1)
@@ -175,3 +183,273 @@ func TestController_DeleteEventbus(t *testing.T) {
 		})
 	})
 }
+
+func TestController_UpdateEventbus(t *testing.T) {
+	Convey("test update eventbus", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		mockMember := member.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+		mockMember.EXPECT().IsReady().AnyTimes().Return(true)
+		mockMember.EXPECT().GetLeaderAddr().AnyTimes().Return("test")
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		ctx := stdCtx.Background()
+		id := vanus.NewTestID()
+		el := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: id, EventbusName: "test"}
+		ctrl.eventbusMap[id] = &metadata.Eventbus{
+			ID:          id,
+			Name:        "test",
+			Namespace:   primitive.DefaultNamespace,
+			LogNumber:   1,
+			Eventlogs:   []*metadata.Eventlog{el},
+			Description: "desc",
+		}
+		elMgr.EXPECT().GetEventlogSegmentList(gomock.Any()).AnyTimes().Return(nil)
+
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(mockCtrl)
+		ctrl.triggerCtrl = triggerCtrl
+
+		Convey("update description and log number", func() {
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64(), LogNumber: 65})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			triggerCtrl.EXPECT().ListSubscription(ctx, &ctrlpb.ListSubscriptionRequest{EventbusId: id.Uint64()}).
+				AnyTimes().Return(&ctrlpb.ListSubscriptionResponse{Subscription: []*metapb.Subscription{
+				{Id: vanus.NewTestID().Uint64(), Config: &metapb.SubscriptionConfig{}},
+			}}, nil)
+
+			el2 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: id, EventbusName: "test"}
+			el3 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: id, EventbusName: "test"}
+			elMgr.EXPECT().AcquireEventlog(ctx, id, "test").Times(1).Return(el2, nil)
+			elMgr.EXPECT().AcquireEventlog(ctx, id, "test").Times(1).Return(el3, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(1).Return(nil)
+			desc := "new desc"
+			eb, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:          id.Uint64(),
+				Description: &desc,
+				LogNumber:   3,
+			})
+			So(err, ShouldBeNil)
+			So(eb.Description, ShouldEqual, desc)
+			So(eb.LogNumber, ShouldEqual, 3)
+			So(eb.Logs, ShouldHaveLength, 3)
+			So(eb.Logs[0].EventlogId, ShouldEqual, el.ID.Uint64())
+			So(eb.Logs[2].EventlogId, ShouldEqual, el3.ID.Uint64())
+
+			_, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64(), LogNumber: 2})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			// the acquired eventlogs are released if the metadata isn't saved
+			el4 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: id, EventbusName: "test"}
+			elMgr.EXPECT().AcquireEventlog(ctx, id, "test").Times(1).Return(el4, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(1).Return(kv.ErrUnknown)
+			elMgr.EXPECT().DeleteEventlog(ctx, el4.ID).Times(1)
+			_, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64(), LogNumber: 4})
+			So(err, ShouldNotBeNil)
+			So(ctrl.eventbusMap[id].LogNumber, ShouldEqual, 3)
+			So(ctrl.eventbusMap[id].Description, ShouldEqual, desc)
+		})
+
+		Convey("increase log number with ordered subscription", func() {
+			triggerCtrl.EXPECT().ListSubscription(ctx, &ctrlpb.ListSubscriptionRequest{EventbusId: id.Uint64()}).
+				Times(1).Return(&ctrlpb.ListSubscriptionResponse{Subscription: []*metapb.Subscription{
+				{Id: vanus.NewTestID().Uint64(), Config: &metapb.SubscriptionConfig{OrderedEvent: true}},
+			}}, nil)
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64(), LogNumber: 2})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			So(ctrl.eventbusMap[id].LogNumber, ShouldEqual, 1)
+
+			// the subscriptions aren't checked if it's forced.
+			el2 := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: id, EventbusName: "test"}
+			elMgr.EXPECT().AcquireEventlog(ctx, id, "test").Times(1).Return(el2, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(1).Return(nil)
+			eb, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id: id.Uint64(), LogNumber: 2, Force: true,
+			})
+			So(err, ShouldBeNil)
+			So(eb.LogNumber, ShouldEqual, 2)
+		})
+
+		Convey("update retention", func() {
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: vanus.NewTestID().Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			_, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:        id.Uint64(),
+				Retention: &metapb.RetentionPolicy{MaxBytes: -1},
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(2).Return(nil)
+			eb, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:        id.Uint64(),
+				Retention: &metapb.RetentionPolicy{MaxAgeSeconds: 3600, MaxEvents: 100},
+			})
+			So(err, ShouldBeNil)
+			So(eb.Retention.MaxAgeSeconds, ShouldEqual, 3600)
+			So(eb.Retention.MaxEvents, ShouldEqual, 100)
+			So(ctrl.getEventbusRetention(id).MaxAge, ShouldEqual, time.Hour)
+
+			// the retention isn't changed if it's not set
+			eb, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64()})
+			So(err, ShouldBeNil)
+			So(eb.Retention.MaxEvents, ShouldEqual, 100)
+			So(ctrl.getEventbusRetention(vanus.NewTestID()), ShouldBeNil)
+		})
+
+		Convey("update compaction", func() {
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:         id.Uint64(),
+				Compaction: &metapb.CompactionPolicy{KeyAttribute: "subject", TombstoneGraceSeconds: -1},
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(2).Return(nil)
+			eb, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:         id.Uint64(),
+				Compaction: &metapb.CompactionPolicy{KeyAttribute: "subject", TombstoneGraceSeconds: 60},
+			})
+			So(err, ShouldBeNil)
+			So(eb.Compaction.KeyAttribute, ShouldEqual, "subject")
+			So(ctrl.getEventbusCompaction(id).TombstoneGrace, ShouldEqual, time.Minute)
+
+			// the compaction is disabled if the key attribute is empty
+			eb, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:         id.Uint64(),
+				Compaction: &metapb.CompactionPolicy{},
+			})
+			So(err, ShouldBeNil)
+			So(eb.Compaction, ShouldBeNil)
+			So(ctrl.getEventbusCompaction(id).IsEnabled(), ShouldBeFalse)
+		})
+
+		Convey("update compression", func() {
+			unknown := metapb.CompressAlgorithm(100)
+			_, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:          id.Uint64(),
+				Compression: &unknown,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "test"),
+				gomock.Any()).Times(2).Return(nil)
+			zstd := metapb.CompressAlgorithm_ZSTD
+			eb, err := ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{
+				Id:          id.Uint64(),
+				Compression: &zstd,
+			})
+			So(err, ShouldBeNil)
+			So(eb.Compression, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
+			So(ctrl.getEventbusCompression(id), ShouldEqual, metapb.CompressAlgorithm_ZSTD)
+
+			// the compression isn't changed if it's null
+			eb, err = ctrl.UpdateEventbus(ctx, &ctrlpb.UpdateEventbusRequest{Id: id.Uint64()})
+			So(err, ShouldBeNil)
+			So(eb.Compression, ShouldEqual, metapb.CompressAlgorithm_ZSTD)
+			So(ctrl.getEventbusCompression(vanus.NewTestID()), ShouldEqual, metapb.CompressAlgorithm_NONE)
+		})
+	})
+}
+
+func TestController_DecommissionVolume(t *testing.T) {
+	Convey("test decommission volume", t, func() {
+		ctrl := NewController(Config{Replicas: 2}, nil)
+		mockCtrl := gomock.NewController(t)
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		ctx := stdCtx.Background()
+
+		volumes := make([]server.Instance, 3)
+		draining := make([]bool, 3)
+		for idx := range volumes {
+			i := idx
+			ins := server.NewMockInstance(mockCtrl)
+			ins.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(i + 1)))
+			ins.EXPECT().Address().AnyTimes().Return(fmt.Sprintf("127.0.0.1:1181%d", i))
+			ins.EXPECT().IsDraining().AnyTimes().DoAndReturn(func() bool {
+				return draining[i]
+			})
+			volMgr.EXPECT().GetVolumeInstanceByID(vanus.NewIDFromUint64(uint64(i + 1))).AnyTimes().Return(ins)
+			volumes[idx] = ins
+		}
+		volMgr.EXPECT().GetVolumeInstanceByID(gomock.Any()).AnyTimes().Return(nil)
+		volMgr.EXPECT().GetAllActiveVolumes().AnyTimes().Return(volumes)
+
+		_, err := ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 4})
+		So(errors.Is(err, errors.ErrVolumeInstanceNotFound), ShouldBeTrue)
+
+		volMgr.EXPECT().Decommission(ctx, volumes[0]).Times(1).DoAndReturn(
+			func(_ stdCtx.Context, _ server.Instance) error {
+				draining[0] = true
+				return nil
+			})
+		elMgr.EXPECT().CountVolumeReplicas(vanus.NewIDFromUint64(1)).Times(1).Return(3, 1)
+		res, err := ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 1})
+		So(err, ShouldBeNil)
+		So(res.State, ShouldEqual, primitive.VolumeStateDraining)
+		So(res.Address, ShouldEqual, "127.0.0.1:11810")
+		So(res.Replicas, ShouldEqual, 3)
+		So(res.Leaders, ShouldEqual, 1)
+
+		// the volume is found by address, and it isn't marked again.
+		elMgr.EXPECT().CountVolumeReplicas(vanus.NewIDFromUint64(1)).Times(1).Return(0, 0)
+		res, err = ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{Address: "127.0.0.1:11810"})
+		So(err, ShouldBeNil)
+		So(res.VolumeId, ShouldEqual, 1)
+		So(res.State, ShouldEqual, primitive.VolumeStateDrained)
+
+		// only one volume is left if another is decommissioned.
+		_, err = ctrl.DecommissionVolume(ctx, &ctrlpb.DecommissionVolumeRequest{VolumeId: 2})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+	})
+}
+
+func TestController_UnregisterSegmentServer(t *testing.T) {
+	Convey("test unregister segment server of draining volume", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventlogMgr = elMgr
+		ssMgr := server.NewMockManager(mockCtrl)
+		ctrl.ssMgr = ssMgr
+		ctx := stdCtx.Background()
+
+		srv := server.NewMockServer(mockCtrl)
+		ssMgr.EXPECT().GetServerByAddress("127.0.0.1:11811").AnyTimes().Return(srv)
+		ins := server.NewMockInstance(mockCtrl)
+		ins.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(1))
+		ins.EXPECT().IsDraining().AnyTimes().Return(true)
+		volMgr.EXPECT().GetVolumeInstanceByID(vanus.NewIDFromUint64(1)).AnyTimes().Return(ins)
+		req := &ctrlpb.UnregisterSegmentServerRequest{Address: "127.0.0.1:11811", VolumeId: 1}
+
+		elMgr.EXPECT().CountVolumeReplicas(vanus.NewIDFromUint64(1)).Times(1).Return(2, 0)
+		_, err := ctrl.UnregisterSegmentServer(ctx, req)
+		So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+		req.Force = true
+		ssMgr.EXPECT().RemoveServer(ctx, srv).Times(1).Return(nil)
+		volMgr.EXPECT().UpdateRouting(ctx, ins, nil).Times(1)
+		_, err = ctrl.UnregisterSegmentServer(ctx, req)
+		So(err, ShouldBeNil)
+
+		req.Force = false
+		elMgr.EXPECT().CountVolumeReplicas(vanus.NewIDFromUint64(1)).Times(1).Return(0, 0)
+		ssMgr.EXPECT().RemoveServer(ctx, srv).Times(1).Return(nil)
+		volMgr.EXPECT().UpdateRouting(ctx, ins, nil).Times(1)
+		_, err = ctrl.UnregisterSegmentServer(ctx, req)
+		So(err, ShouldBeNil)
+	})
+}
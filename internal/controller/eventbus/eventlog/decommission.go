@@ -0,0 +1,100 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+
+	"github.com/vanus-labs/vanus/observability/log"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+// CountVolumeReplicas returns the numbers of segment replicas and leaders located in the volume, the volume can
+// be removed once there is no replica in it.
+func (mgr *eventlogManager) CountVolumeReplicas(volumeID vanus.ID) (int, int) {
+	replicas, leaders := 0, 0
+	mgr.eventlogMap.Range(func(_, value interface{}) bool {
+		elog, _ := value.(*eventlog)
+		for _, seg := range elog.getAllSegments() {
+			if seg.Replicas == nil {
+				continue
+			}
+			for _, blk := range seg.Replicas.Peers {
+				if blk.VolumeID == volumeID {
+					replicas++
+				}
+			}
+			if leader := seg.GetLeaderBlock(); leader != nil && leader.VolumeID == volumeID {
+				leaders++
+			}
+		}
+		return true
+	})
+	return replicas, leaders
+}
+
+// drainingVolumeChecker returns a function to check whether a volume is being decommissioned, the result of each
+// volume is cached.
+func (mgr *eventlogManager) drainingVolumeChecker() func(id vanus.ID) bool {
+	checked := make(map[vanus.ID]bool)
+	return func(id vanus.ID) bool {
+		if draining, ok := checked[id]; ok {
+			return draining
+		}
+		ins := mgr.volMgr.GetVolumeInstanceByID(id)
+		draining := ins != nil && ins.IsDraining()
+		checked[id] = draining
+		return draining
+	}
+}
+
+// transferLeaderAway transfers the leadership of segment from the block in draining volume to another replica.
+// It returns false if there is no replica to take over, and the error if the transfer fails.
+func (mgr *eventlogManager) transferLeaderAway(
+	ctx context.Context, seg *Segment, leader *metadata.Block, isGone func(vanus.ID) bool,
+) (bool, error) {
+	var to *metadata.Block
+	for _, blk := range seg.Replicas.Peers {
+		if blk.ID == leader.ID || isGone(blk.VolumeID) {
+			continue
+		}
+		if to == nil || blk.ID < to.ID {
+			to = blk
+		}
+	}
+	if to == nil {
+		return false, nil
+	}
+
+	t := &LeaderTransfer{SegmentID: seg.ID, EventlogID: seg.EventlogID, From: leader, To: to}
+	if err := mgr.transferLeader(ctx, t); err != nil {
+		log.Warning(ctx, "transfer leader away from draining volume failed", map[string]interface{}{
+			log.KeyError:     err,
+			log.KeySegmentID: seg.ID,
+			"from_block_id":  leader.ID,
+			"to_block_id":    to.ID,
+		})
+		return false, err
+	}
+	log.Info(ctx, "the leader is transferred away from draining volume", map[string]interface{}{
+		log.KeySegmentID: seg.ID,
+		"from_block_id":  leader.ID,
+		"to_block_id":    to.ID,
+		"volume_id":      leader.VolumeID,
+	})
+	return true, nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/vanus-labs/vanus/observability/log"
 	"github.com/vanus-labs/vanus/observability/metrics"
 	"github.com/vanus-labs/vanus/pkg/errors"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 	"github.com/vanus-labs/vanus/proto/pkg/segment"
 
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/block"
@@ -46,6 +47,8 @@ const (
 	defaultScaleInterval               = time.Second
 	defaultCleanInterval               = time.Second
 	defaultCheckExpiredSegmentInterval = time.Minute
+	defaultCompactSegmentInterval      = 10 * time.Minute
+	defaultOffloadSegmentInterval      = time.Minute
 )
 
 type Manager interface {
@@ -60,6 +63,9 @@ type Manager interface {
 	GetAppendableSegment(ctx context.Context, eli *metadata.Eventlog, num int) ([]Segment, error)
 	UpdateSegment(ctx context.Context, m map[string][]Segment)
 	GetSegmentByBlockID(block *metadata.Block) (Segment, error)
+	ListSegmentReplication() []Replication
+	RebalanceLeaders(ctx context.Context, dryRun bool, threshold int) LeaderRebalance
+	CountVolumeReplicas(volumeID vanus.ID) (int, int)
 }
 
 var mgr = &eventlogManager{
@@ -67,7 +73,11 @@ var mgr = &eventlogManager{
 	scaleInterval:               defaultScaleInterval,
 	cleanInterval:               defaultCleanInterval,
 	checkSegmentExpiredInterval: defaultCheckExpiredSegmentInterval,
+	compactSegmentInterval:      defaultCompactSegmentInterval,
+	offloadSegmentInterval:      defaultOffloadSegmentInterval,
+	replicateSegmentInterval:    defaultReplicateSegmentInterval,
 	segmentExpiredTime:          defaultSegmentExpiredTime,
+	replicaLostTimeout:          defaultReplicaLostTimeout,
 }
 
 type eventlogManager struct {
@@ -92,20 +102,61 @@ type eventlogManager struct {
 	scaleInterval               time.Duration
 	cleanInterval               time.Duration
 	checkSegmentExpiredInterval time.Duration
+	compactSegmentInterval      time.Duration
+	offloadSegmentInterval      time.Duration
+	replicateSegmentInterval    time.Duration
+	rebalanceLeaderInterval     time.Duration
 	segmentExpiredTime          time.Duration
-	createSegmentMutex          sync.Mutex
+	// offloadAfter is how long the full segments are kept in store nodes, offloading is disabled if it's zero.
+	offloadAfter time.Duration
+	// replicaLostTimeout is how long a volume is inactive before its replicas are considered lost.
+	replicaLostTimeout time.Duration
+	// leaderImbalanceThreshold is the max difference between the leader numbers of volumes, the task of leader
+	// rebalancing is disabled if it's negative.
+	leaderImbalanceThreshold int
+	retention                RetentionGetter
+	compaction               CompactionGetter
+	compression              CompressionGetter
+	createSegmentMutex       sync.Mutex
+	// segmentID, Replication
+	replications sync.Map
+	// blockID, *metadata.Block
+	removedBlocks sync.Map
+	// volumeID, time.Time. It's only accessed by the task of replicate-segments.
+	inactiveVolumes map[vanus.ID]time.Time
 }
 
+// RetentionGetter returns the retention policy of eventbus, nil means the default policy.
+type RetentionGetter func(eventbusID vanus.ID) *metadata.RetentionPolicy
+
+// CompactionGetter returns the compaction policy of eventbus, nil means the compaction is disabled.
+type CompactionGetter func(eventbusID vanus.ID) *metadata.CompactionPolicy
+
+// CompressionGetter returns the algorithm used to compress the events of eventbus.
+type CompressionGetter func(eventbusID vanus.ID) metapb.CompressAlgorithm
+
 // Make sure eventlogManager implements Manager.
 var _ Manager = (*eventlogManager)(nil)
 
-func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64) Manager {
+func NewManager(
+	volMgr volume.Manager, replicaNum uint, defaultBlockSize int64, offloadAfter, replicaLostTimeout time.Duration,
+	leaderImbalanceThreshold int, placement block.PlacementPolicy, retention RetentionGetter,
+	compaction CompactionGetter, compression CompressionGetter,
+) Manager {
 	mgr.volMgr = volMgr
+	mgr.offloadAfter = offloadAfter
+	if replicaLostTimeout > 0 {
+		mgr.replicaLostTimeout = replicaLostTimeout
+	}
+	mgr.leaderImbalanceThreshold = leaderImbalanceThreshold
+	mgr.retention = retention
+	mgr.compaction = compaction
+	mgr.compression = compression
 	if replicaNum > 0 {
 		mgr.segmentReplicaNum = replicaNum
 	}
 	mgr.allocator = block.NewAllocator(defaultBlockSize,
-		block.NewVolumeRoundRobin(mgr.volMgr.GetAllActiveVolumes))
+		block.NewVolumeCapacityAware(mgr.volMgr.GetAllActiveVolumes, placement))
 	return mgr
 }
 
@@ -119,6 +170,22 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 	if mgr.cleanInterval == 0 {
 		mgr.cleanInterval = defaultCleanInterval
 	}
+	if mgr.compactSegmentInterval == 0 {
+		mgr.compactSegmentInterval = defaultCompactSegmentInterval
+	}
+	if mgr.offloadSegmentInterval == 0 {
+		mgr.offloadSegmentInterval = defaultOffloadSegmentInterval
+	}
+	if mgr.replicateSegmentInterval == 0 {
+		mgr.replicateSegmentInterval = defaultReplicateSegmentInterval
+	}
+	if mgr.replicaLostTimeout == 0 {
+		mgr.replicaLostTimeout = defaultReplicaLostTimeout
+	}
+	if mgr.rebalanceLeaderInterval == 0 {
+		mgr.rebalanceLeaderInterval = defaultRebalanceLeaderInterval
+	}
+	mgr.inactiveVolumes = make(map[vanus.ID]time.Time)
 	mgr.kvClient = kvClient
 	if err := mgr.allocator.Run(ctx, mgr.kvClient, true); err != nil {
 		return err
@@ -147,6 +214,9 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 			}
 		}
 	}
+	if err = mgr.loadRemovedBlocks(ctx); err != nil {
+		return err
+	}
 
 	cancelCtx, cancel := context.WithCancel(ctx)
 	mgr.cancel = cancel
@@ -154,6 +224,14 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 		go mgr.dynamicScaleUpEventlog(cancelCtx)
 		go mgr.cleanAbnormalSegment(cancelCtx)
 		go mgr.checkSegmentExpired(cancelCtx)
+		go mgr.compactSegments(cancelCtx)
+		if mgr.offloadAfter > 0 {
+			go mgr.offloadSegments(cancelCtx)
+		}
+		go mgr.replicateSegments(cancelCtx)
+		if mgr.leaderImbalanceThreshold >= 0 {
+			go mgr.rebalanceLeaders(cancelCtx)
+		}
 	}
 	go mgr.recordMetrics(cancelCtx)
 	return nil
@@ -549,7 +627,8 @@ func (mgr *eventlogManager) cleanAbnormalSegment(ctx context.Context) {
 						"start_offset": v.StartOffsetInLog,
 						"block_id":     blk.ID,
 					}
-					err := ins.DeleteBlock(ctx, blk.ID)
+					// The offloaded object is shared by the replicas, it's deleted with the segment only.
+					err := ins.DeleteBlock(ctx, blk.ID, v.isOffloaded())
 					if err != nil {
 						infos[log.KeyError] = err
 						log.Warning(ctx, "delete block failed", infos)
@@ -603,57 +682,7 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 			executionID := uuid.NewString()
 			mgr.eventlogMap.Range(func(key, value interface{}) bool {
 				elog, _ := value.(*eventlog)
-				head := elog.head()
-				checkCtx := context.Background()
-				for head != nil {
-					switch {
-					case head.LastEventBornTime.Second() == 0:
-						// TODO(wenfeng.wang) fix if set
-						head.LastEventBornTime = time.Now().Add(mgr.segmentExpiredTime)
-						elog.lock()
-						if err := elog.updateSegment(checkCtx, head); err != nil {
-							log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
-								log.KeyError: err,
-								"segment":    head.String(),
-								"eventlog":   elog.md.ID.String(),
-							})
-							head.LastEventBornTime = time.Time{}
-						}
-						elog.unlock()
-						return true
-					case !head.isFull():
-						return true
-					case time.Since(head.LastEventBornTime.Add(mgr.segmentExpiredTime)) > 0:
-						err := elog.deleteHead(ctx)
-						if err != nil {
-							log.Warning(ctx, "delete segment error", map[string]interface{}{
-								log.KeyError:       err,
-								"execution_id":     executionID,
-								"last_event_time":  head.LastEventBornTime,
-								"first_event_time": head.FirstEventBornTime,
-								"time":             time.Now(),
-							})
-							return true
-						}
-						count++
-						log.Info(ctx, "delete segment success", map[string]interface{}{
-							"execution_id":     executionID,
-							"log_id":           elog.md.ID.String(),
-							"last_event_time":  head.LastEventBornTime,
-							"first_event_time": head.FirstEventBornTime,
-							"time":             time.Now(),
-							"number":           head.Number,
-						})
-						_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
-						if !ok {
-							metrics.SegmentDeletedCounterVec.WithLabelValues(
-								metrics.LabelSegmentDeletedBecauseExpired).Inc()
-						}
-					default:
-						return true
-					}
-					head = elog.head()
-				}
+				count += mgr.applyRetention(ctx, executionID, elog)
 				return true
 			})
 			log.Info(ctx, "check-segment-expired completed", map[string]interface{}{
@@ -664,6 +693,309 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 	}
 }
 
+// getRetention returns the retention policy of eventbus, the default max age is used if no limit is set.
+func (mgr *eventlogManager) getRetention(eventbusID vanus.ID) *metadata.RetentionPolicy {
+	var policy *metadata.RetentionPolicy
+	if mgr.retention != nil {
+		policy = mgr.retention(eventbusID)
+	}
+	if policy.IsDefault() {
+		return &metadata.RetentionPolicy{MaxAge: mgr.segmentExpiredTime}
+	}
+	return policy
+}
+
+// applyRetention deletes the full head segments of eventlog which exceed the retention policy, and returns
+// the number of deleted segments.
+func (mgr *eventlogManager) applyRetention(ctx context.Context, executionID string, elog *eventlog) int {
+	policy := mgr.getRetention(elog.md.EventbusID)
+	if policy.KeepForever {
+		return 0
+	}
+	var size, number int64
+	for _, seg := range elog.getAllSegments() {
+		size += seg.Size
+		number += int64(seg.Number)
+	}
+	count := 0
+	checkCtx := context.Background()
+	for head := elog.head(); head != nil; head = elog.head() {
+		if head.LastEventBornTime.Second() == 0 {
+			// TODO(wenfeng.wang) fix if set
+			// the born time is unknown, take now as it, so the segment expires by the retention of eventbus alone.
+			head.LastEventBornTime = time.Now()
+			elog.lock()
+			if err := elog.updateSegment(checkCtx, head); err != nil {
+				log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
+					log.KeyError: err,
+					"segment":    head.String(),
+					"eventlog":   elog.md.ID.String(),
+				})
+				head.LastEventBornTime = time.Time{}
+			}
+			elog.unlock()
+			return count
+		}
+		if !head.isFull() {
+			return count
+		}
+		reason := exceededRetention(policy, head, size, number)
+		if reason == "" {
+			return count
+		}
+		if err := elog.deleteHead(ctx); err != nil {
+			log.Warning(ctx, "delete segment error", map[string]interface{}{
+				log.KeyError:       err,
+				"execution_id":     executionID,
+				"last_event_time":  head.LastEventBornTime,
+				"first_event_time": head.FirstEventBornTime,
+				"time":             time.Now(),
+			})
+			return count
+		}
+		count++
+		size -= head.Size
+		number -= int64(head.Number)
+		log.Info(ctx, "delete segment success", map[string]interface{}{
+			"execution_id":     executionID,
+			"log_id":           elog.md.ID.String(),
+			"last_event_time":  head.LastEventBornTime,
+			"first_event_time": head.FirstEventBornTime,
+			"time":             time.Now(),
+			"number":           head.Number,
+			"reason":           reason,
+		})
+		if _, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head); !ok {
+			metrics.SegmentDeletedCounterVec.WithLabelValues(reason).Inc()
+		}
+	}
+	return count
+}
+
+// exceededRetention returns the reason why the head segment should be deleted, or empty if it's retained.
+// The size and number are the total of eventlog.
+func exceededRetention(policy *metadata.RetentionPolicy, head *Segment, size, number int64) string {
+	switch {
+	case policy.MaxAge > 0 && time.Since(head.LastEventBornTime.Add(policy.MaxAge)) > 0:
+		return metrics.LabelSegmentDeletedBecauseExpired
+	case policy.MaxBytes > 0 && size > policy.MaxBytes:
+		return metrics.LabelSegmentDeletedBecauseExceededBytes
+	case policy.MaxEvents > 0 && number > policy.MaxEvents:
+		return metrics.LabelSegmentDeletedBecauseExceededEvents
+	}
+	return ""
+}
+
+func (mgr *eventlogManager) compactSegments(ctx context.Context) {
+	ticker := time.NewTicker(mgr.compactSegmentInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of compact-segments stopped", nil)
+			return
+		case <-ticker.C:
+			count := 0
+			mgr.eventlogMap.Range(func(key, value interface{}) bool {
+				elog, _ := value.(*eventlog)
+				count += mgr.applyCompaction(ctx, elog)
+				return true
+			})
+			log.Info(ctx, "compact-segments completed", map[string]interface{}{
+				"count": count,
+			})
+		}
+	}
+}
+
+// applyCompaction compacts the full segments of eventlog by key, and returns the number of compacted segments.
+// The offsets of events are preserved, since the blocks of segments keep the sequence numbers of retained events.
+//
+// The segments are compacted from the newest to the oldest, and the key hashes of newer segments are passed to the
+// compaction of older ones, so only the latest event of a key is retained in the eventlog. Since the keys of all
+// newer segments are required, all full segments are compacted once any of them needs compaction. The keys of
+// segments which can't be compacted (e.g. offloaded) are unknown, so the older events of these keys are retained.
+func (mgr *eventlogManager) applyCompaction(ctx context.Context, elog *eventlog) int {
+	if mgr.compaction == nil {
+		return 0
+	}
+	policy := mgr.compaction(elog.md.EventbusID)
+	if !policy.IsEnabled() {
+		return 0
+	}
+
+	segs := make([]*Segment, 0)
+	needed := false
+	for _, seg := range elog.getAllSegments() {
+		if !seg.isFull() || !seg.isReady() || seg.isOffloaded() {
+			continue
+		}
+		segs = append(segs, seg)
+		needed = needed || seg.isNeedCompact(policy.TombstoneGrace)
+	}
+	if !needed {
+		return 0
+	}
+
+	// the keys are tracked by their fixed-size hashes, which are returned by the compaction of blocks.
+	newerKeys := make(map[string]struct{})
+	count := 0
+	for i := len(segs) - 1; i >= 0; i-- {
+		seg := segs[i]
+		now := time.Now()
+		size, err := mgr.compactSegment(ctx, seg, policy, now.Add(-policy.TombstoneGrace), newerKeys)
+		if err != nil {
+			log.Warning(ctx, "compact segment failed", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeySegmentID: seg.ID,
+				"eventlog_id":    elog.md.ID,
+			})
+			continue
+		}
+		elog.lock()
+		seg.Size = size
+		seg.CompactedAt = now
+		err = elog.updateSegment(ctx, seg)
+		elog.unlock()
+		if err != nil {
+			log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeySegmentID: seg.ID,
+			})
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// compactSegment compacts all blocks of segment, and returns the largest size of them. The key hashes of segment
+// are added to newerKeys.
+func (mgr *eventlogManager) compactSegment(
+	ctx context.Context, seg *Segment, policy *metadata.CompactionPolicy, tombstoneDeadline time.Time,
+	newerKeys map[string]struct{},
+) (int64, error) {
+	var keys [][]byte
+	if len(newerKeys) != 0 {
+		keys = make([][]byte, 0, len(newerKeys))
+		for key := range newerKeys {
+			keys = append(keys, []byte(key))
+		}
+	}
+
+	var size int64
+	segKeys := make([][]byte, 0)
+	for _, blk := range seg.Replicas.Peers {
+		ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+		if ins == nil {
+			return 0, errors.ErrVolumeInstanceNotFound
+		}
+		n, blkKeys, err := ins.CompactBlock(ctx, blk.ID, policy.KeyAttribute, tombstoneDeadline, keys)
+		if err != nil {
+			return 0, err
+		}
+		if n > size {
+			size = n
+		}
+		segKeys = append(segKeys, blkKeys...)
+	}
+	for _, key := range segKeys {
+		newerKeys[string(key)] = struct{}{}
+	}
+	return size, nil
+}
+
+func (mgr *eventlogManager) offloadSegments(ctx context.Context) {
+	ticker := time.NewTicker(mgr.offloadSegmentInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of offload-segments stopped", nil)
+			return
+		case <-ticker.C:
+			count := 0
+			mgr.eventlogMap.Range(func(key, value interface{}) bool {
+				elog, _ := value.(*eventlog)
+				count += mgr.applyOffload(ctx, elog, time.Now())
+				return true
+			})
+			log.Info(ctx, "offload-segments completed", map[string]interface{}{
+				"count": count,
+			})
+		}
+	}
+}
+
+// applyOffload offloads the full segments of eventlog whose last event is born before offloadAfter, and returns
+// the number of offloaded segments. The segments waiting for compaction are offloaded after compacted.
+func (mgr *eventlogManager) applyOffload(ctx context.Context, elog *eventlog, now time.Time) int {
+	var policy *metadata.CompactionPolicy
+	if mgr.compaction != nil {
+		policy = mgr.compaction(elog.md.EventbusID)
+	}
+	deadline := now.Add(-mgr.offloadAfter)
+	count := 0
+	for _, seg := range elog.getAllSegments() {
+		if !seg.isFull() || !seg.isReady() || seg.isOffloaded() || !seg.LastEventBornTime.Before(deadline) {
+			continue
+		}
+		if _, ok := mgr.getReplication(seg.ID); ok {
+			// offload it after the new replica caught up.
+			continue
+		}
+		if policy.IsEnabled() && seg.isNeedCompact(policy.TombstoneGrace) {
+			continue
+		}
+		key := getSegmentObjectKey(seg)
+		if err := mgr.offloadSegment(ctx, seg, key); err != nil {
+			log.Warning(ctx, "offload segment failed", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeySegmentID: seg.ID,
+				"eventlog_id":    elog.md.ID,
+			})
+			continue
+		}
+		elog.lock()
+		seg.ObjectKey = key
+		err := elog.updateSegment(ctx, seg)
+		elog.unlock()
+		if err != nil {
+			log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeySegmentID: seg.ID,
+			})
+			continue
+		}
+		log.Info(ctx, "the segment has been offloaded", map[string]interface{}{
+			log.KeySegmentID: seg.ID,
+			"eventlog_id":    elog.md.ID,
+			"object_key":     key,
+		})
+		count++
+	}
+	return count
+}
+
+// offloadSegment offloads all blocks of segment to the same object, the object is uploaded by the first block,
+// and reused by the others.
+func (mgr *eventlogManager) offloadSegment(ctx context.Context, seg *Segment, key string) error {
+	for _, blk := range seg.Replicas.Peers {
+		ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+		if ins == nil {
+			return errors.ErrVolumeInstanceNotFound
+		}
+		if err := ins.OffloadBlock(ctx, blk.ID, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSegmentObjectKey(seg *Segment) string {
+	return fmt.Sprintf("eventlogs/%s/segments/%s", seg.EventlogID.Key(), seg.ID.Key())
+}
+
 func (mgr *eventlogManager) recordMetrics(ctx context.Context) {
 	t := time.NewTicker(time.Second)
 	for {
@@ -726,6 +1058,9 @@ func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*S
 		return nil, err
 	}
 	seg.EventlogID = el.md.ID
+	if mgr.compression != nil {
+		seg.Compression = mgr.compression(el.md.EventbusID)
+	}
 
 	cur := el.currentAppendableSegment()
 	if cur == nil {
@@ -764,6 +1099,7 @@ func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*S
 		EventlogId:     seg.EventlogID.Uint64(),
 		ReplicaGroupId: seg.Replicas.ID.Uint64(),
 		Replicas:       mgr.getSegmentTopology(ctx, *seg),
+		Compression:    seg.Compression,
 	})
 
 	if err != nil {
@@ -819,6 +1155,13 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 			volumes = append(volumes, peer.VolumeID)
 		}
 		blocks, err = mgr.allocator.PickByVolumes(ctx, volumes)
+		// The volumes of current segment may be unavailable or above the high watermark.
+		if errors.Is(err, errors.ErrVolumeInstanceNoServer) {
+			log.Info(ctx, "the volumes of current segment are unavailable, select new volumes", map[string]interface{}{
+				log.KeyEventlogID: el.md.ID,
+			})
+			blocks, err = mgr.allocator.Pick(ctx, int(mgr.segmentReplicaNum))
+		}
 	}
 
 	if err != nil {
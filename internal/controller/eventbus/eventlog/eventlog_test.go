@@ -17,7 +17,6 @@ package eventlog
 import (
 	stdCtx "context"
 	stdJson "encoding/json"
-	"math"
 	"path/filepath"
 	"testing"
 	"time"
@@ -27,6 +26,7 @@ import (
 
 	"github.com/vanus-labs/vanus/pkg/errors"
 	"github.com/vanus-labs/vanus/pkg/util"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 	segpb "github.com/vanus-labs/vanus/proto/pkg/segment"
 
 	"github.com/vanus-labs/vanus/internal/controller/eventbus/block"
@@ -114,12 +114,20 @@ func TestEventlogManager_RunWithoutTask(t *testing.T) {
 				},
 			}
 
-			kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(func(
+			removedBlock := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+			removedData, _ := stdJson.Marshal(removedBlock)
+			kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(5).DoAndReturn(func(
 				ctx stdCtx.Context, path string,
 			) ([]kv.Pair, error) {
 				if path == metadata.EventlogKeyPrefixInKVStore {
 					return elPairs, nil
 				}
+				if path == metadata.RemovedBlockKeyPrefixInKVStore {
+					return []kv.Pair{{
+						Key:   metadata.GetRemovedBlockMetadataKey(removedBlock.ID),
+						Value: removedData,
+					}}, nil
+				}
 				if path == filepath.Join(metadata.EventlogSegmentsKeyPrefixInKVStore, el3.ID.String()) {
 					return segPairs, nil
 				}
@@ -169,6 +177,9 @@ func TestEventlogManager_RunWithoutTask(t *testing.T) {
 			So(v.(*eventlog).size(), ShouldEqual, 3)
 
 			So(util.MapLen(&utMgr.globalBlockMap), ShouldEqual, 9)
+			v, exist = utMgr.removedBlocks.Load(removedBlock.ID.Key())
+			So(exist, ShouldBeTrue)
+			So(v.(*metadata.Block).VolumeID, ShouldEqual, removedBlock.VolumeID)
 		})
 	})
 }
@@ -247,7 +258,7 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 		// suspend those tasks
 		utMgr.cleanInterval = time.Hour
 		utMgr.checkSegmentExpiredInterval = time.Hour
-		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(2).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
 		md1 := &metadata.Eventlog{
@@ -381,7 +392,7 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 		utMgr.scaleInterval = 5 * time.Millisecond
 		utMgr.cleanInterval = 5 * time.Millisecond
 		utMgr.checkSegmentExpiredInterval = time.Hour
-		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(2).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
 		md1 := &metadata.Eventlog{
@@ -412,7 +423,7 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 			el1.md.ID, head.ID)).Times(1).Return(nil)
 		for _, v := range head.Replicas.Peers {
 			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(v.VolumeID, v.ID)).Times(1).Return(nil)
-			volIns.EXPECT().DeleteBlock(gomock.Any(), v.ID).Times(1).Return(nil)
+			volIns.EXPECT().DeleteBlock(gomock.Any(), v.ID, false).Times(1).Return(nil)
 		}
 		_ = el1.deleteHead(ctx)
 		utMgr.segmentNeedBeClean.Store(head.ID.Key(), head)
@@ -947,6 +958,61 @@ func Test_ExpiredSegmentDeleting(t *testing.T) {
 			So(util.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 3)
 		})
 
+		Convey("test retention policy of eventbus", func() {
+			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			policies := map[vanus.ID]*metadata.RetentionPolicy{
+				el1.md.EventbusID: {KeepForever: true},
+				el2.md.EventbusID: {MaxBytes: 150},
+				el3.md.EventbusID: {MaxEvents: 20},
+			}
+			utMgr.retention = func(id vanus.ID) *metadata.RetentionPolicy {
+				return policies[id]
+			}
+			defer func() {
+				utMgr.retention = nil
+			}()
+			// the second of born time isn't 0, otherwise it's regarded as unset
+			born := time.Now().Add(-100 * time.Hour).Truncate(time.Minute).Add(time.Second)
+			newSegments := func(el *eventlog, num int) []*Segment {
+				segs := make([]*Segment, num)
+				el.segments = nil
+				for idx := range segs {
+					segs[idx] = &Segment{
+						ID:                 vanus.NewTestID(),
+						Size:               100,
+						Number:             10,
+						FirstEventBornTime: born,
+						LastEventBornTime:  born.Add(time.Hour),
+						State:              StateFrozen,
+					}
+					el.segmentList.Set(segs[idx].ID.Uint64(), segs[idx])
+					el.segments = append(el.segments, segs[idx].ID)
+				}
+				return segs
+			}
+			newSegments(el1, 3)
+			segs2 := newSegments(el2, 3)
+			segs3 := newSegments(el3, 4)
+
+			So(utMgr.applyRetention(ctx, "test", el1), ShouldEqual, 0)
+			So(el1.segments, ShouldHaveLength, 3)
+
+			// 300 bytes to 100 bytes
+			So(utMgr.applyRetention(ctx, "test", el2), ShouldEqual, 2)
+			So(el2.segments, ShouldHaveLength, 1)
+			So(el2.segments[0], ShouldEqual, segs2[2].ID)
+
+			// 40 events to 20 events
+			So(utMgr.applyRetention(ctx, "test", el3), ShouldEqual, 2)
+			So(el3.segments, ShouldHaveLength, 2)
+			So(el3.segments[0], ShouldEqual, segs3[2].ID)
+
+			// the default policy is used if no limit is set
+			policies[el1.md.EventbusID] = &metadata.RetentionPolicy{}
+			So(utMgr.applyRetention(ctx, "test", el1), ShouldEqual, 3)
+		})
+
 		Convey("test kv error", func() {
 			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
 			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
@@ -1027,9 +1093,357 @@ func Test_ExpiredSegmentDeleting(t *testing.T) {
 
 			So(el1.segmentList.Len(), ShouldEqual, 1)
 			So(el1.segments, ShouldHaveLength, 1)
-			minutes := math.Ceil(float64(time.Until(el1.head().LastEventBornTime)) / float64(time.Minute))
-			So(minutes, ShouldEqual, 60)
+			// the born time is stamped with now, regardless of the default max age.
+			So(el1.head().LastEventBornTime, ShouldHappenWithin, 2*time.Second, time.Now())
+		})
+	})
+}
+
+func TestEventlogManager_CompactSegments(t *testing.T) {
+	Convey("test compact segments", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		volIns := server.NewMockInstance(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{volMgr: volMgr}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+
+		volID := vanus.NewTestID()
+		newSegment := func(state SegmentState) *Segment {
+			blk := &metadata.Block{ID: vanus.NewTestID(), VolumeID: volID}
+			seg := &Segment{
+				ID:                vanus.NewTestID(),
+				Size:              100,
+				Number:            10,
+				LastEventBornTime: time.Now().Add(-time.Hour),
+				State:             state,
+				Replicas: &ReplicaGroup{
+					ID:     vanus.NewTestID(),
+					Leader: blk.ID.Uint64(),
+					Peers:  map[uint64]*metadata.Block{blk.ID.Uint64(): blk},
+				},
+			}
+			el.segmentList.Set(seg.ID.Uint64(), seg)
+			el.segments = append(el.segments, seg.ID)
+			return seg
+		}
+		s1 := newSegment(StateFrozen)
+		s2 := newSegment(StateFrozen)
+		newSegment(StateWorking)
+
+		// the compaction is disabled by default
+		So(utMgr.applyCompaction(ctx, el), ShouldEqual, 0)
+
+		policy := &metadata.CompactionPolicy{KeyAttribute: "subject", TombstoneGrace: 2 * time.Hour}
+		utMgr.compaction = func(id vanus.ID) *metadata.CompactionPolicy {
+			return policy
+		}
+		volMgr.EXPECT().GetVolumeInstanceByID(volID).AnyTimes().Return(volIns)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		b1 := s1.Replicas.Peers[s1.Replicas.Leader].ID
+		b2 := s2.Replicas.Peers[s2.Replicas.Leader].ID
+		gomock.InOrder(
+			volIns.EXPECT().CompactBlock(gomock.Any(), b2, "subject", gomock.Any(), gomock.Nil()).
+				Return(int64(0), nil, errors.ErrInternal),
+			volIns.EXPECT().CompactBlock(gomock.Any(), b1, "subject", gomock.Any(), gomock.Nil()).
+				Return(int64(40), [][]byte{[]byte("a")}, nil),
+			// the keys of newer segment are passed to the older one
+			volIns.EXPECT().CompactBlock(gomock.Any(), b2, "subject", gomock.Any(), gomock.Nil()).
+				Return(int64(60), [][]byte{[]byte("b")}, nil),
+			volIns.EXPECT().CompactBlock(gomock.Any(), b1, "subject", gomock.Any(), [][]byte{[]byte("b")}).
+				Return(int64(30), [][]byte{[]byte("a")}, nil),
+		)
+
+		// s2 failed, so the older events of its keys are retained in s1
+		So(utMgr.applyCompaction(ctx, el), ShouldEqual, 1)
+		So(s1.Size, ShouldEqual, 40)
+		So(s1.Number, ShouldEqual, 10)
+		So(s1.CompactedAt.IsZero(), ShouldBeFalse)
+		So(s2.Size, ShouldEqual, 100)
+		So(s2.CompactedAt.IsZero(), ShouldBeTrue)
+
+		// all full segments are compacted once any of them needs compaction
+		So(utMgr.applyCompaction(ctx, el), ShouldEqual, 2)
+		So(s1.Size, ShouldEqual, 30)
+		So(s2.Size, ShouldEqual, 60)
+
+		// all tombstones had exceeded the grace period at the last compaction
+		policy.TombstoneGrace = 0
+		So(utMgr.applyCompaction(ctx, el), ShouldEqual, 0)
+	})
+}
+
+func TestEventlogManager_OffloadSegments(t *testing.T) {
+	Convey("test offload segments", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		volIns := server.NewMockInstance(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{volMgr: volMgr, offloadAfter: 2 * time.Hour}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+
+		volID := vanus.NewTestID()
+		newSegment := func(state SegmentState, born time.Time) *Segment {
+			blk := &metadata.Block{ID: vanus.NewTestID(), VolumeID: volID}
+			seg := &Segment{
+				ID:                vanus.NewTestID(),
+				EventlogID:        el.md.ID,
+				LastEventBornTime: born,
+				State:             state,
+				Replicas: &ReplicaGroup{
+					ID:     vanus.NewTestID(),
+					Leader: blk.ID.Uint64(),
+					Peers:  map[uint64]*metadata.Block{blk.ID.Uint64(): blk},
+				},
+			}
+			el.segmentList.Set(seg.ID.Uint64(), seg)
+			el.segments = append(el.segments, seg.ID)
+			return seg
+		}
+		now := time.Now()
+		s1 := newSegment(StateFrozen, now.Add(-3*time.Hour))
+		s2 := newSegment(StateFrozen, now.Add(-time.Hour))
+		s3 := newSegment(StateWorking, now.Add(-3*time.Hour))
+
+		volMgr.EXPECT().GetVolumeInstanceByID(volID).AnyTimes().Return(volIns)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		Convey("wait for compaction", func() {
+			utMgr.compaction = func(id vanus.ID) *metadata.CompactionPolicy {
+				return &metadata.CompactionPolicy{KeyAttribute: "subject"}
+			}
+			So(utMgr.applyOffload(ctx, el, now), ShouldEqual, 0)
+		})
+
+		Convey("offload segments", func() {
+			volIns.EXPECT().OffloadBlock(gomock.Any(), s1.Replicas.Peers[s1.Replicas.Leader].ID,
+				getSegmentObjectKey(s1)).Times(1).Return(nil)
+			So(utMgr.applyOffload(ctx, el, now), ShouldEqual, 1)
+			So(s1.ObjectKey, ShouldEqual, getSegmentObjectKey(s1))
+			So(s2.isOffloaded(), ShouldBeFalse)
+			So(s3.isOffloaded(), ShouldBeFalse)
+
+			So(s1.storageTier(), ShouldEqual, metapb.StorageTier_S3)
+			So(s2.storageTier(), ShouldEqual, metapb.StorageTier_SSD)
+
+			// offloaded segments are neither offloaded again nor compacted
+			So(utMgr.applyOffload(ctx, el, now), ShouldEqual, 0)
+			utMgr.compaction = func(id vanus.ID) *metadata.CompactionPolicy {
+				return &metadata.CompactionPolicy{KeyAttribute: "subject"}
+			}
+			volIns.EXPECT().CompactBlock(gomock.Any(), s2.Replicas.Peers[s2.Replicas.Leader].ID, "subject",
+				gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil, nil)
+			So(utMgr.applyCompaction(ctx, el), ShouldEqual, 1)
+
+			volIns.EXPECT().OffloadBlock(gomock.Any(), s2.Replicas.Peers[s2.Replicas.Leader].ID,
+				getSegmentObjectKey(s2)).Times(1).Return(errors.ErrResourceCanNotOp)
+			So(utMgr.applyOffload(ctx, el, now.Add(2*time.Hour)), ShouldEqual, 0)
+			So(s2.isOffloaded(), ShouldBeFalse)
+		})
+	})
+}
+
+func TestEventlogManager_ReplicateSegments(t *testing.T) {
+	Convey("test replicate segments", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		alloc := block.NewMockAllocator(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			volMgr:            volMgr,
+			allocator:         alloc,
+			kvClient:          kvCli,
+			segmentReplicaNum: 3,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+
+		leaderIns := server.NewMockInstance(ctrl)
+		newIns := server.NewMockInstance(ctrl)
+		b1 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		b2 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		b3 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		seg := &Segment{
+			ID:         vanus.NewTestID(),
+			EventlogID: el.md.ID,
+			State:      StateWorking,
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: b1.ID.Uint64(),
+				Peers: map[uint64]*metadata.Block{
+					b1.ID.Uint64(): b1,
+					b2.ID.Uint64(): b2,
+					b3.ID.Uint64(): b3,
+				},
+			},
+		}
+		el.segmentList.Set(seg.ID.Uint64(), seg)
+		el.segments = append(el.segments, seg.ID)
+
+		lost := map[vanus.ID]bool{}
+		isLost := func(id vanus.ID) bool {
+			return lost[id]
+		}
+		notDraining := func(id vanus.ID) bool {
+			return false
+		}
+		volMgr.EXPECT().GetVolumeInstanceByID(b1.VolumeID).AnyTimes().Return(leaderIns)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		// all replicas are alive
+		So(utMgr.applyReplication(ctx, el, isLost, notDraining), ShouldEqual, 0)
+		So(utMgr.ListSegmentReplication(), ShouldHaveLength, 0)
+
+		lost[b3.VolumeID] = true
+		b4 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		leaderIns.EXPECT().RemoveReplica(gomock.Any(), b1.ID, b3.ID).Times(1).Return(nil)
+		alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(ctx stdCtx.Context, excluded []vanus.ID) (*metadata.Block, error) {
+				So(excluded, ShouldContain, b1.VolumeID)
+				So(excluded, ShouldContain, b2.VolumeID)
+				So(excluded, ShouldContain, b3.VolumeID)
+				return b4, nil
+			})
+		volMgr.EXPECT().GetVolumeInstanceByID(b4.VolumeID).AnyTimes().Return(newIns)
+		newIns.EXPECT().Address().AnyTimes().Return("127.0.0.1:11811")
+		leaderIns.EXPECT().AddReplica(gomock.Any(), b1.ID, b4.ID, "127.0.0.1:11811").Times(1).Return(nil)
+
+		So(utMgr.applyReplication(ctx, el, isLost, notDraining), ShouldEqual, 0)
+		So(seg.Replicas.Peers, ShouldHaveLength, 3)
+		So(seg.Replicas.Peers, ShouldContainKey, b4.ID.Uint64())
+		So(seg.Replicas.Peers, ShouldNotContainKey, b3.ID.Uint64())
+		So(b4.SegmentID, ShouldEqual, seg.ID)
+		_, removed := utMgr.removedBlocks.Load(b3.ID.Key())
+		So(removed, ShouldBeTrue)
+
+		rs := utMgr.ListSegmentReplication()
+		So(rs, ShouldHaveLength, 1)
+		So(rs[0].State, ShouldEqual, ReplicationStateCatchingUp)
+		So(rs[0].LostBlock, ShouldEqual, b3)
+		So(rs[0].NewBlock, ShouldEqual, b4)
+
+		// the new replica is catching up
+		leaderIns.EXPECT().GetBlockInfo(gomock.Any(), b1.ID).Times(1).Return(&segpb.GetBlockInfoResponse{
+			CommitIndex: 1000,
+			Replicas: []*segpb.ReplicaProgress{
+				{Id: b1.ID.Uint64(), MatchIndex: 1000, State: "replicate"},
+				{Id: b4.ID.Uint64(), MatchIndex: 250, State: "snapshot"},
+			},
+		}, nil)
+		So(utMgr.applyReplication(ctx, el, isLost, notDraining), ShouldEqual, 0)
+		rs = utMgr.ListSegmentReplication()
+		So(rs, ShouldHaveLength, 1)
+		So(rs[0].Progress, ShouldEqual, 25)
+
+		// the new replica has caught up
+		leaderIns.EXPECT().GetBlockInfo(gomock.Any(), b1.ID).Times(1).Return(&segpb.GetBlockInfoResponse{
+			CommitIndex: 1010,
+			Replicas: []*segpb.ReplicaProgress{
+				{Id: b1.ID.Uint64(), MatchIndex: 1010, State: "replicate"},
+				{Id: b4.ID.Uint64(), MatchIndex: 1000, State: "replicate"},
+			},
+		}, nil)
+		So(utMgr.applyReplication(ctx, el, isLost, notDraining), ShouldEqual, 1)
+		So(utMgr.ListSegmentReplication(), ShouldHaveLength, 0)
+	})
+}
+
+func TestEventlogManager_ReplicateOffloadedSegment(t *testing.T) {
+	Convey("test replicate offloaded segment", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			volMgr:            volMgr,
+			kvClient:          kvCli,
+			segmentReplicaNum: 2,
+			offloadAfter:      time.Hour,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+
+		leaderIns := server.NewMockInstance(ctrl)
+		newIns := server.NewMockInstance(ctrl)
+		b1 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		b2 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		seg := &Segment{
+			ID:                vanus.NewTestID(),
+			EventlogID:        el.md.ID,
+			State:             StateFrozen,
+			LastEventBornTime: time.Now().Add(-2 * time.Hour),
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: b1.ID.Uint64(),
+				Peers: map[uint64]*metadata.Block{
+					b1.ID.Uint64(): b1,
+					b2.ID.Uint64(): b2,
+				},
+			},
+		}
+		el.segmentList.Set(seg.ID.Uint64(), seg)
+		el.segments = append(el.segments, seg.ID)
+		utMgr.replications.Store(seg.ID.Key(), Replication{
+			SegmentID:  seg.ID,
+			EventlogID: seg.EventlogID,
+			State:      ReplicationStateCatchingUp,
+			NewBlock:   b2,
+			StartTime:  time.Now(),
 		})
+
+		// the segment isn't offloaded until the new replica caught up.
+		So(utMgr.applyOffload(ctx, el, time.Now()), ShouldEqual, 0)
+
+		seg.ObjectKey = getSegmentObjectKey(seg)
+		notLost := func(id vanus.ID) bool {
+			return false
+		}
+		volMgr.EXPECT().GetVolumeInstanceByID(b1.VolumeID).AnyTimes().Return(leaderIns)
+		volMgr.EXPECT().GetVolumeInstanceByID(b2.VolumeID).AnyTimes().Return(newIns)
+		leaderIns.EXPECT().GetBlockInfo(gomock.Any(), b1.ID).Times(2).Return(&segpb.GetBlockInfoResponse{
+			CommitIndex: 1000,
+			Replicas: []*segpb.ReplicaProgress{
+				{Id: b1.ID.Uint64(), MatchIndex: 1000, State: "replicate"},
+				{Id: b2.ID.Uint64(), MatchIndex: 1000, State: "replicate"},
+			},
+		}, nil)
+
+		// the new replica of offloaded segment is offloaded too before the re-replication completes.
+		newIns.EXPECT().OffloadBlock(gomock.Any(), b2.ID, seg.ObjectKey).Times(1).Return(errors.ErrResourceCanNotOp)
+		So(utMgr.applyReplication(ctx, el, notLost, notLost), ShouldEqual, 0)
+		rs := utMgr.ListSegmentReplication()
+		So(rs, ShouldHaveLength, 1)
+		So(rs[0].State, ShouldEqual, ReplicationStateCatchingUp)
+		So(rs[0].Message, ShouldNotBeEmpty)
+
+		newIns.EXPECT().OffloadBlock(gomock.Any(), b2.ID, seg.ObjectKey).Times(1).Return(nil)
+		So(utMgr.applyReplication(ctx, el, notLost, notLost), ShouldEqual, 1)
+		So(utMgr.ListSegmentReplication(), ShouldHaveLength, 0)
 	})
 }
 
@@ -1118,3 +1532,285 @@ func TestEventlog_MarkSegmentFull(t *testing.T) {
 		So(seg2.StartOffsetInLog, ShouldEqual, 111111+12345)
 	})
 }
+
+func TestEventlogManager_RebalanceLeaders(t *testing.T) {
+	Convey("test rebalance leaders", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			volMgr:   volMgr,
+			kvClient: kvCli,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		srv := server.NewMockServer(ctrl)
+		srv.EXPECT().IsActive(gomock.Any()).AnyTimes().Return(true)
+		vols := make([]vanus.ID, 3)
+		instances := make([]*server.MockInstance, 3)
+		for idx := range vols {
+			vols[idx] = vanus.NewTestID()
+			instances[idx] = server.NewMockInstance(ctrl)
+			instances[idx].EXPECT().GetServer().AnyTimes().Return(srv)
+			instances[idx].EXPECT().IsDraining().AnyTimes().Return(false)
+			instances[idx].EXPECT().Address().AnyTimes().Return("127.0.0.1:11811")
+			volMgr.EXPECT().GetVolumeInstanceByID(vols[idx]).AnyTimes().Return(instances[idx])
+		}
+
+		// all leaders are in the first volume, and a frozen segment is ignored.
+		for idx := 0; idx < 7; idx++ {
+			peers := make(map[uint64]*metadata.Block, len(vols))
+			var leader uint64
+			for _, vol := range vols {
+				blk := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vol}
+				peers[blk.ID.Uint64()] = blk
+				if vol == vols[0] {
+					leader = blk.ID.Uint64()
+				}
+			}
+			seg := &Segment{
+				ID:         vanus.NewTestID(),
+				EventlogID: el.md.ID,
+				State:      StateWorking,
+				Replicas: &ReplicaGroup{
+					ID:     vanus.NewTestID(),
+					Leader: leader,
+					Peers:  peers,
+				},
+			}
+			if idx == 6 {
+				seg.State = StateFrozen
+			}
+			el.segmentList.Set(seg.ID.Uint64(), seg)
+			el.segments = append(el.segments, seg.ID)
+		}
+
+		Convey("test dry run", func() {
+			res := utMgr.RebalanceLeaders(ctx, true, 0)
+			So(res.Transfers, ShouldHaveLength, 3)
+			for _, tr := range res.Transfers {
+				So(tr.From.VolumeID, ShouldEqual, vols[0])
+				So(tr.To.VolumeID, ShouldNotEqual, vols[0])
+				So(tr.Message, ShouldBeEmpty)
+			}
+			So(res.Volumes, ShouldHaveLength, 3)
+			after := map[vanus.ID]int{}
+			for _, v := range res.Volumes {
+				after[v.VolumeID] = v.After
+				if v.VolumeID == vols[0] {
+					So(v.Before, ShouldEqual, 6)
+				} else {
+					So(v.Before, ShouldEqual, 0)
+				}
+			}
+			So(after[vols[0]], ShouldEqual, 3)
+			So(after[vols[1]]+after[vols[2]], ShouldEqual, 3)
+
+			res = utMgr.RebalanceLeaders(ctx, true, 1)
+			So(res.Transfers, ShouldHaveLength, 4)
+			for _, v := range res.Volumes {
+				So(v.After, ShouldEqual, 2)
+			}
+		})
+
+		Convey("test apply", func() {
+			utMgr.leaderImbalanceThreshold = 1
+			instances[0].EXPECT().TransferLeader(gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Return(nil)
+			instances[0].EXPECT().TransferLeader(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+				errors.ErrInvalidRequest)
+			res := utMgr.RebalanceLeaders(ctx, false, 0)
+			So(res.Transfers, ShouldHaveLength, 4)
+			So(res.Transfers[3].Message, ShouldNotBeEmpty)
+			total := 0
+			for _, v := range res.Volumes {
+				total += v.After
+				if v.VolumeID == vols[0] {
+					So(v.After, ShouldEqual, 3)
+				}
+			}
+			So(total, ShouldEqual, 6)
+		})
+	})
+}
+
+func TestEventlogManager_DecommissionVolume(t *testing.T) {
+	Convey("test move replicas out of draining volume", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		alloc := block.NewMockAllocator(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			volMgr:            volMgr,
+			allocator:         alloc,
+			kvClient:          kvCli,
+			segmentReplicaNum: 3,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+		utMgr.eventlogMap.Store(el.md.ID.Key(), el)
+
+		b1 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		b2 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		b3 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		seg := &Segment{
+			ID:         vanus.NewTestID(),
+			EventlogID: el.md.ID,
+			State:      StateFrozen,
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: b1.ID.Uint64(),
+				Peers: map[uint64]*metadata.Block{
+					b1.ID.Uint64(): b1,
+					b2.ID.Uint64(): b2,
+					b3.ID.Uint64(): b3,
+				},
+			},
+		}
+		el.segmentList.Set(seg.ID.Uint64(), seg)
+		el.segments = append(el.segments, seg.ID)
+
+		ins1 := server.NewMockInstance(ctrl)
+		ins2 := server.NewMockInstance(ctrl)
+		newIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(b1.VolumeID).AnyTimes().Return(ins1)
+		volMgr.EXPECT().GetVolumeInstanceByID(b2.VolumeID).AnyTimes().Return(ins2)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		isLost := func(id vanus.ID) bool {
+			return false
+		}
+		isDraining := func(id vanus.ID) bool {
+			return id == b1.VolumeID
+		}
+
+		replicas, leaders := utMgr.CountVolumeReplicas(b1.VolumeID)
+		So(replicas, ShouldEqual, 1)
+		So(leaders, ShouldEqual, 1)
+
+		// the leadership is transferred away first.
+		to := b2
+		if b3.ID < b2.ID {
+			to = b3
+		}
+		ins1.EXPECT().TransferLeader(gomock.Any(), b1.ID, to.ID).Times(1).Return(errors.ErrInternal)
+		transferred, err := utMgr.transferLeaderAway(ctx, seg, b1, isDraining)
+		So(transferred, ShouldBeFalse)
+		So(err, ShouldNotBeNil)
+
+		// no replica is added while the transfer keeps failing.
+		ins1.EXPECT().TransferLeader(gomock.Any(), b1.ID, to.ID).Times(3).Return(errors.ErrInternal)
+		for i := 0; i < 3; i++ {
+			So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 0)
+		}
+		So(utMgr.ListSegmentReplication(), ShouldHaveLength, 0)
+		So(seg.Replicas.Peers, ShouldHaveLength, 3)
+
+		ins1.EXPECT().TransferLeader(gomock.Any(), b1.ID, to.ID).Times(1).Return(nil)
+		So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 0)
+		So(utMgr.ListSegmentReplication(), ShouldHaveLength, 0)
+
+		// then the replica in draining volume is replaced.
+		seg.Replicas.Leader = b2.ID.Uint64()
+		b4 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		ins2.EXPECT().RemoveReplica(gomock.Any(), b2.ID, b1.ID).Times(1).Return(nil)
+		alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any()).Times(1).Return(b4, nil)
+		volMgr.EXPECT().GetVolumeInstanceByID(b4.VolumeID).AnyTimes().Return(newIns)
+		newIns.EXPECT().Address().AnyTimes().Return("127.0.0.1:11811")
+		ins2.EXPECT().AddReplica(gomock.Any(), b2.ID, b4.ID, "127.0.0.1:11811").Times(1).Return(nil)
+		So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 0)
+		So(seg.Replicas.Peers, ShouldNotContainKey, b1.ID.Uint64())
+		So(seg.Replicas.Peers, ShouldContainKey, b4.ID.Uint64())
+		rs := utMgr.ListSegmentReplication()
+		So(rs, ShouldHaveLength, 1)
+		So(rs[0].LostBlock, ShouldEqual, b1)
+
+		replicas, leaders = utMgr.CountVolumeReplicas(b1.VolumeID)
+		So(replicas, ShouldEqual, 0)
+		So(leaders, ShouldEqual, 0)
+	})
+
+	Convey("test move the only replica out of draining volume", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		volMgr := volume.NewMockManager(ctrl)
+		alloc := block.NewMockAllocator(ctrl)
+		ctx := stdCtx.Background()
+		utMgr := &eventlogManager{
+			volMgr:            volMgr,
+			allocator:         alloc,
+			kvClient:          kvCli,
+			segmentReplicaNum: 1,
+		}
+
+		el, err := newEventlog(ctx, &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}, kvCli, false)
+		So(err, ShouldBeNil)
+
+		b1 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		seg := &Segment{
+			ID:         vanus.NewTestID(),
+			EventlogID: el.md.ID,
+			State:      StateWorking,
+			Replicas: &ReplicaGroup{
+				ID:     vanus.NewTestID(),
+				Leader: b1.ID.Uint64(),
+				Peers:  map[uint64]*metadata.Block{b1.ID.Uint64(): b1},
+			},
+		}
+		el.segmentList.Set(seg.ID.Uint64(), seg)
+		el.segments = append(el.segments, seg.ID)
+
+		ins1 := server.NewMockInstance(ctrl)
+		newIns := server.NewMockInstance(ctrl)
+		b2 := &metadata.Block{ID: vanus.NewTestID(), VolumeID: vanus.NewTestID()}
+		volMgr.EXPECT().GetVolumeInstanceByID(b1.VolumeID).AnyTimes().Return(ins1)
+		volMgr.EXPECT().GetVolumeInstanceByID(b2.VolumeID).AnyTimes().Return(newIns)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		isLost := func(id vanus.ID) bool {
+			return false
+		}
+		isDraining := func(id vanus.ID) bool {
+			return id == b1.VolumeID
+		}
+
+		// no replica can take over, so a new one is added without removing the leader.
+		alloc.EXPECT().PickExcluding(gomock.Any(), gomock.Any()).Times(1).Return(b2, nil)
+		newIns.EXPECT().Address().AnyTimes().Return("127.0.0.1:11811")
+		ins1.EXPECT().AddReplica(gomock.Any(), b1.ID, b2.ID, "127.0.0.1:11811").Times(1).Return(nil)
+		So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 0)
+		So(seg.Replicas.Peers, ShouldHaveLength, 2)
+		rs := utMgr.ListSegmentReplication()
+		So(rs, ShouldHaveLength, 1)
+		So(rs[0].State, ShouldEqual, ReplicationStateCatchingUp)
+		So(rs[0].LostBlock, ShouldEqual, b1)
+
+		ins1.EXPECT().GetBlockInfo(gomock.Any(), b1.ID).Times(1).Return(&segpb.GetBlockInfoResponse{
+			CommitIndex: 10,
+			Replicas: []*segpb.ReplicaProgress{
+				{Id: b1.ID.Uint64(), MatchIndex: 10, State: "replicate"},
+				{Id: b2.ID.Uint64(), MatchIndex: 10, State: "replicate"},
+			},
+		}, nil)
+		So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 1)
+
+		// the leadership is transferred to the new replica once it has caught up.
+		ins1.EXPECT().TransferLeader(gomock.Any(), b1.ID, b2.ID).Times(1).Return(nil)
+		So(utMgr.applyReplication(ctx, el, isLost, isDraining), ShouldEqual, 0)
+	})
+}
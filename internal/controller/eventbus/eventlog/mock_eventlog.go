@@ -52,6 +52,21 @@ func (mr *MockManagerMockRecorder) AcquireEventlog(ctx, eventbusID, eventbusName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEventlog", reflect.TypeOf((*MockManager)(nil).AcquireEventlog), ctx, eventbusID, eventbusName)
 }
 
+// CountVolumeReplicas mocks base method.
+func (m *MockManager) CountVolumeReplicas(volumeID vanus.ID) (int, int) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountVolumeReplicas", volumeID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	return ret0, ret1
+}
+
+// CountVolumeReplicas indicates an expected call of CountVolumeReplicas.
+func (mr *MockManagerMockRecorder) CountVolumeReplicas(volumeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountVolumeReplicas", reflect.TypeOf((*MockManager)(nil).CountVolumeReplicas), volumeID)
+}
+
 // DeleteEventlog mocks base method.
 func (m *MockManager) DeleteEventlog(ctx context.Context, id vanus.ID) {
 	m.ctrl.T.Helper()
@@ -136,6 +151,34 @@ func (mr *MockManagerMockRecorder) GetSegmentByBlockID(block interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegmentByBlockID", reflect.TypeOf((*MockManager)(nil).GetSegmentByBlockID), block)
 }
 
+// ListSegmentReplication mocks base method.
+func (m *MockManager) ListSegmentReplication() []Replication {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegmentReplication")
+	ret0, _ := ret[0].([]Replication)
+	return ret0
+}
+
+// ListSegmentReplication indicates an expected call of ListSegmentReplication.
+func (mr *MockManagerMockRecorder) ListSegmentReplication() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegmentReplication", reflect.TypeOf((*MockManager)(nil).ListSegmentReplication))
+}
+
+// RebalanceLeaders mocks base method.
+func (m *MockManager) RebalanceLeaders(ctx context.Context, dryRun bool, threshold int) LeaderRebalance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceLeaders", ctx, dryRun, threshold)
+	ret0, _ := ret[0].(LeaderRebalance)
+	return ret0
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockManagerMockRecorder) RebalanceLeaders(ctx, dryRun, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockManager)(nil).RebalanceLeaders), ctx, dryRun, threshold)
+}
+
 // Run mocks base method.
 func (m *MockManager) Run(ctx context.Context, kvClient kv.Client, startTask bool) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,278 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	defaultRebalanceLeaderInterval  = time.Minute
+	defaultLeaderImbalanceThreshold = 2
+)
+
+// LeaderTransfer is a raft leadership transfer of segment, which moves the leader from a block to another.
+type LeaderTransfer struct {
+	SegmentID  vanus.ID
+	EventlogID vanus.ID
+	From       *metadata.Block
+	To         *metadata.Block
+	// Message is the error of transfer, it's empty if no error or the transfer isn't applied.
+	Message string
+}
+
+// VolumeLeaders is the number of working segments led by a volume.
+type VolumeLeaders struct {
+	VolumeID vanus.ID
+	Address  string
+	Before   int
+	After    int
+}
+
+type LeaderRebalance struct {
+	Transfers []LeaderTransfer
+	Volumes   []VolumeLeaders
+}
+
+// RebalanceLeaders transfers the leaders of working segments between volumes, so the difference between the
+// leader numbers of volumes isn't larger than threshold. Only the events of working segments are appended, so the
+// leaders of others are ignored. If dryRun is true, the transfers are planned but not applied.
+func (mgr *eventlogManager) RebalanceLeaders(ctx context.Context, dryRun bool, threshold int) LeaderRebalance {
+	if threshold <= 0 {
+		threshold = mgr.leaderImbalanceThreshold
+	}
+	if threshold <= 0 {
+		threshold = defaultLeaderImbalanceThreshold
+	}
+
+	segments := make([]*Segment, 0)
+	mgr.eventlogMap.Range(func(_, value interface{}) bool {
+		elog, _ := value.(*eventlog)
+		for _, seg := range elog.getAllSegments() {
+			if seg.State != StateWorking || !seg.isReady() || seg.isOffloaded() {
+				continue
+			}
+			// The replicas of segment are changing, leave it alone.
+			if _, exist := mgr.getReplication(seg.ID); exist {
+				continue
+			}
+			segments = append(segments, seg)
+		}
+		return true
+	})
+
+	transfers, before, after := planLeaderTransfers(segments, mgr.activeVolumeChecker(ctx), threshold)
+	if !dryRun {
+		for idx := range transfers {
+			t := &transfers[idx]
+			if err := mgr.transferLeader(ctx, t); err != nil {
+				t.Message = err.Error()
+				after[t.From.VolumeID]++
+				after[t.To.VolumeID]--
+				log.Warning(ctx, "transfer leader of segment failed", map[string]interface{}{
+					log.KeyError:     err,
+					log.KeySegmentID: t.SegmentID,
+					"from_block_id":  t.From.ID,
+					"to_block_id":    t.To.ID,
+				})
+			}
+		}
+	}
+
+	volumes := make([]VolumeLeaders, 0, len(before))
+	for id, n := range before {
+		vl := VolumeLeaders{VolumeID: id, Before: n, After: after[id]}
+		if ins := mgr.volMgr.GetVolumeInstanceByID(id); ins != nil {
+			vl.Address = ins.Address()
+		}
+		volumes = append(volumes, vl)
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].VolumeID < volumes[j].VolumeID
+	})
+	return LeaderRebalance{Transfers: transfers, Volumes: volumes}
+}
+
+func (mgr *eventlogManager) transferLeader(ctx context.Context, t *LeaderTransfer) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(t.From.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	return ins.TransferLeader(ctx, t.From.ID, t.To.ID)
+}
+
+// activeVolumeChecker returns a function to check whether the server of a volume is active, the draining volumes
+// are taken as inactive, since their leaders are transferred away. The result of each volume is cached.
+func (mgr *eventlogManager) activeVolumeChecker(ctx context.Context) func(id vanus.ID) bool {
+	checked := make(map[vanus.ID]bool)
+	return func(id vanus.ID) bool {
+		if active, ok := checked[id]; ok {
+			return active
+		}
+		ins := mgr.volMgr.GetVolumeInstanceByID(id)
+		active := ins != nil && !ins.IsDraining() && ins.GetServer() != nil && ins.GetServer().IsActive(ctx)
+		checked[id] = active
+		return active
+	}
+}
+
+func (mgr *eventlogManager) rebalanceLeaders(ctx context.Context) {
+	ticker := time.NewTicker(mgr.rebalanceLeaderInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of rebalance-leaders stopped", nil)
+			return
+		case <-ticker.C:
+			res := mgr.RebalanceLeaders(ctx, false, mgr.leaderImbalanceThreshold)
+			if len(res.Transfers) != 0 {
+				log.Info(ctx, "rebalance-leaders completed", map[string]interface{}{
+					"transfers": len(res.Transfers),
+				})
+			}
+		}
+	}
+}
+
+// planLeaderTransfers plans the leader transfers of segments, and returns them with the leader numbers of volumes
+// before and after the transfers. Only the active volumes which host the replicas of segments are taken into
+// account, and the leader of each segment is transferred once at most.
+func planLeaderTransfers(
+	segments []*Segment, isActive func(vanus.ID) bool, threshold int,
+) ([]LeaderTransfer, map[vanus.ID]int, map[vanus.ID]int) {
+	counts := make(map[vanus.ID]int)
+	led := make(map[vanus.ID][]*Segment)
+	for _, seg := range segments {
+		leader := seg.GetLeaderBlock()
+		// The leader reported may be stale if its volume is inactive.
+		if leader == nil || !isActive(leader.VolumeID) {
+			continue
+		}
+		for _, blk := range seg.Replicas.Peers {
+			if _, ok := counts[blk.VolumeID]; !ok && isActive(blk.VolumeID) {
+				counts[blk.VolumeID] = 0
+			}
+		}
+		counts[leader.VolumeID]++
+		led[leader.VolumeID] = append(led[leader.VolumeID], seg)
+	}
+
+	before := make(map[vanus.ID]int, len(counts))
+	for id, n := range counts {
+		before[id] = n
+	}
+	for _, segs := range led {
+		sort.Slice(segs, func(i, j int) bool {
+			return segs[i].ID < segs[j].ID
+		})
+	}
+
+	transfers := make([]LeaderTransfer, 0)
+	for {
+		volumes := sortVolumesByCount(counts)
+		if len(volumes) < 2 || counts[volumes[0]]-counts[volumes[len(volumes)-1]] <= threshold {
+			break
+		}
+		t, ok := nextLeaderTransfer(volumes, counts, led, isActive)
+		if !ok {
+			break
+		}
+		counts[t.From.VolumeID]--
+		counts[t.To.VolumeID]++
+		transfers = append(transfers, t)
+	}
+	return transfers, before, counts
+}
+
+// nextLeaderTransfer finds a segment led by the most loaded volume possible, whose leader can be transferred to a
+// volume which leads at least 2 segments less, so the imbalance is always reduced.
+func nextLeaderTransfer(
+	volumes []vanus.ID, counts map[vanus.ID]int, led map[vanus.ID][]*Segment, isActive func(vanus.ID) bool,
+) (LeaderTransfer, bool) {
+	for _, src := range volumes {
+		segs := led[src]
+		for idx, seg := range segs {
+			from := seg.GetLeaderBlock()
+			var to *metadata.Block
+			for _, blk := range seg.Replicas.Peers {
+				if blk.VolumeID == src || !isActive(blk.VolumeID) {
+					continue
+				}
+				if to == nil || counts[blk.VolumeID] < counts[to.VolumeID] ||
+					(counts[blk.VolumeID] == counts[to.VolumeID] && blk.VolumeID < to.VolumeID) {
+					to = blk
+				}
+			}
+			if to == nil || counts[src]-counts[to.VolumeID] < 2 {
+				continue
+			}
+			led[src] = append(segs[:idx], segs[idx+1:]...)
+			return LeaderTransfer{SegmentID: seg.ID, EventlogID: seg.EventlogID, From: from, To: to}, true
+		}
+	}
+	return LeaderTransfer{}, false
+}
+
+// sortVolumesByCount returns the volumes sorted by leader number in descending order.
+func sortVolumesByCount(counts map[vanus.ID]int) []vanus.ID {
+	volumes := make([]vanus.ID, 0, len(counts))
+	for id := range counts {
+		volumes = append(volumes, id)
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		if counts[volumes[i]] != counts[volumes[j]] {
+			return counts[volumes[i]] > counts[volumes[j]]
+		}
+		return volumes[i] < volumes[j]
+	})
+	return volumes
+}
+
+func Convert2ProtoLeaderRebalance(res LeaderRebalance) *ctrlpb.RebalanceLeadersResponse {
+	pb := &ctrlpb.RebalanceLeadersResponse{
+		Transfers: make([]*ctrlpb.LeaderTransfer, len(res.Transfers)),
+		Volumes:   make([]*ctrlpb.VolumeLeaders, len(res.Volumes)),
+	}
+	for idx, t := range res.Transfers {
+		pb.Transfers[idx] = &ctrlpb.LeaderTransfer{
+			SegmentId:    t.SegmentID.Uint64(),
+			EventlogId:   t.EventlogID.Uint64(),
+			FromBlockId:  t.From.ID.Uint64(),
+			FromVolumeId: t.From.VolumeID.Uint64(),
+			ToBlockId:    t.To.ID.Uint64(),
+			ToVolumeId:   t.To.VolumeID.Uint64(),
+			Message:      t.Message,
+		}
+	}
+	for idx, v := range res.Volumes {
+		pb.Volumes[idx] = &ctrlpb.VolumeLeaders{
+			VolumeId: v.VolumeID.Uint64(),
+			Address:  v.Address,
+			Before:   int32(v.Before),
+			After:    int32(v.After),
+		}
+	}
+	return pb
+}
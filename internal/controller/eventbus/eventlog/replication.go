@@ -0,0 +1,546 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	segpb "github.com/vanus-labs/vanus/proto/pkg/segment"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	defaultReplicateSegmentInterval = 10 * time.Second
+	defaultReplicaLostTimeout       = 5 * time.Minute
+	// the new replica is considered to have caught up if it lags behind the commit index no more than it.
+	defaultReplicaCatchUpLag = 64
+	// the state of replica reported by the leader, when it's receiving log entries steadily.
+	replicaStateReplicate = "replicate"
+)
+
+type ReplicationState string
+
+const (
+	ReplicationStateRemoving   = ReplicationState("removing")
+	ReplicationStateAdding     = ReplicationState("adding")
+	ReplicationStateCatchingUp = ReplicationState("catching_up")
+)
+
+// Replication is the progress of re-replicating a segment whose replica is lost or in a draining volume. A lost
+// replica is removed from the raft group of segment first, then a new one is added, so the quorum isn't enlarged
+// while the new replica is catching up.
+type Replication struct {
+	SegmentID  vanus.ID
+	EventlogID vanus.ID
+	// LostBlock is nil if the lost block had been removed before the controller started, it's the leader block if
+	// the leader is in a draining volume and no other replica can take over.
+	LostBlock *metadata.Block
+	NewBlock  *metadata.Block
+	State     ReplicationState
+	// Progress is the percentage of committed raft log which has been replicated to NewBlock.
+	Progress int32
+	// Message is the error of last step, it's empty if no error.
+	Message   string
+	StartTime time.Time
+}
+
+func (mgr *eventlogManager) ListSegmentReplication() []Replication {
+	result := make([]Replication, 0)
+	mgr.replications.Range(func(_, value any) bool {
+		r, _ := value.(Replication)
+		result = append(result, r)
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartTime.Before(result[j].StartTime)
+	})
+	return result
+}
+
+func (mgr *eventlogManager) replicateSegments(ctx context.Context) {
+	ticker := time.NewTicker(mgr.replicateSegmentInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of replicate-segments stopped", nil)
+			return
+		case <-ticker.C:
+			count := 0
+			isLost := mgr.lostVolumeChecker(ctx, time.Now())
+			isDraining := mgr.drainingVolumeChecker()
+			mgr.eventlogMap.Range(func(key, value interface{}) bool {
+				elog, _ := value.(*eventlog)
+				count += mgr.applyReplication(ctx, elog, isLost, isDraining)
+				return true
+			})
+			mgr.cleanRemovedBlocks(ctx)
+			log.Debug(ctx, "replicate-segments completed", map[string]interface{}{
+				"count": count,
+			})
+		}
+	}
+}
+
+// lostVolumeChecker returns a function to check whether a volume is lost, which means its server has been inactive
+// longer than replicaLostTimeout. The result of each volume is cached, so the function should be used in one round
+// of checking only.
+func (mgr *eventlogManager) lostVolumeChecker(ctx context.Context, now time.Time) func(id vanus.ID) bool {
+	checked := make(map[vanus.ID]bool)
+	return func(id vanus.ID) bool {
+		if lost, ok := checked[id]; ok {
+			return lost
+		}
+		lost := false
+		ins := mgr.volMgr.GetVolumeInstanceByID(id)
+		if ins != nil && ins.GetServer() != nil && ins.GetServer().IsActive(ctx) {
+			delete(mgr.inactiveVolumes, id)
+		} else if since, ok := mgr.inactiveVolumes[id]; ok {
+			lost = now.Sub(since) >= mgr.replicaLostTimeout
+		} else {
+			mgr.inactiveVolumes[id] = now
+		}
+		checked[id] = lost
+		return lost
+	}
+}
+
+// applyReplication re-replicates the segments of eventlog which have replicas located in lost or draining volumes,
+// or have less replicas than expected, and returns the number of segments whose re-replication completed.
+func (mgr *eventlogManager) applyReplication(
+	ctx context.Context, elog *eventlog, isLost, isDraining func(vanus.ID) bool,
+) int {
+	count := 0
+	for _, seg := range elog.getAllSegments() {
+		// The offloaded segments are re-replicated too, since their events are read from object storage through
+		// the replicas.
+		if !seg.isReady() {
+			continue
+		}
+		if mgr.replicateSegment(ctx, elog, seg, isLost, isDraining) {
+			count++
+		}
+	}
+	return count
+}
+
+// replicateSegment moves the re-replication of segment forward by one step, and returns true if it completed.
+// The replicas in draining volumes are replaced like the lost ones, except that the leadership is transferred to
+// another replica first.
+func (mgr *eventlogManager) replicateSegment(
+	ctx context.Context, elog *eventlog, seg *Segment, isLost, isDraining func(vanus.ID) bool,
+) bool {
+	isGone := func(id vanus.ID) bool {
+		return isLost(id) || isDraining(id)
+	}
+
+	r, exist := mgr.getReplication(seg.ID)
+	if exist && r.State == ReplicationStateCatchingUp {
+		return mgr.checkCatchingUp(ctx, seg, r, isGone)
+	}
+
+	leader := seg.GetLeaderBlock()
+	if leader == nil || isLost(leader.VolumeID) {
+		// waiting for a new leader to be elected from the alive replicas.
+		return false
+	}
+
+	// If no replica can take over the leadership from the draining volume, a new replica is added first.
+	leaderDraining := isDraining(leader.VolumeID)
+	if leaderDraining {
+		transferred, err := mgr.transferLeaderAway(ctx, seg, leader, isGone)
+		if transferred || err != nil {
+			// waiting for the new leader to be reported, or retry the transfer in next round.
+			return false
+		}
+	}
+
+	lostBlocks := make([]*metadata.Block, 0)
+	for _, blk := range seg.Replicas.Peers {
+		if blk.ID != leader.ID && isGone(blk.VolumeID) {
+			lostBlocks = append(lostBlocks, blk)
+		}
+	}
+
+	if !exist {
+		if len(lostBlocks) == 0 && len(seg.Replicas.Peers) >= int(mgr.segmentReplicaNum) && !leaderDraining {
+			return false
+		}
+		r = Replication{
+			SegmentID:  seg.ID,
+			EventlogID: seg.EventlogID,
+			State:      ReplicationStateRemoving,
+			StartTime:  time.Now(),
+		}
+		if len(lostBlocks) > 0 {
+			r.LostBlock = lostBlocks[0]
+		} else if leaderDraining {
+			r.LostBlock = leader
+		}
+		mgr.replications.Store(seg.ID.Key(), r)
+		log.Info(ctx, "start to re-replicate the segment", map[string]interface{}{
+			log.KeySegmentID: seg.ID,
+			"eventlog_id":    seg.EventlogID,
+			"lost_blocks":    len(lostBlocks),
+			"replicas":       len(seg.Replicas.Peers),
+		})
+	}
+
+	for _, blk := range lostBlocks {
+		if err := mgr.removeReplica(ctx, elog, seg, leader, blk); err != nil {
+			mgr.failReplication(ctx, r, err)
+			return false
+		}
+	}
+
+	if r.NewBlock == nil && len(seg.Replicas.Peers) >= int(mgr.segmentReplicaNum) && !leaderDraining {
+		mgr.replications.Delete(seg.ID.Key())
+		return false
+	}
+
+	r.State = ReplicationStateAdding
+	if err := mgr.addReplica(ctx, elog, seg, leader, &r, isGone); err != nil {
+		mgr.failReplication(ctx, r, err)
+		return false
+	}
+	r.State = ReplicationStateCatchingUp
+	r.Message = ""
+	mgr.replications.Store(seg.ID.Key(), r)
+	return false
+}
+
+// removeReplica removes the lost block from the raft group and the metadata of segment, the block is deleted
+// once its volume is active again.
+func (mgr *eventlogManager) removeReplica(
+	ctx context.Context, elog *eventlog, seg *Segment, leader, blk *metadata.Block,
+) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(leader.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	if err := ins.RemoveReplica(ctx, leader.ID, blk.ID); err != nil {
+		return err
+	}
+
+	elog.lock()
+	replicas := seg.Replicas
+	seg.Replicas = replicas.clone()
+	delete(seg.Replicas.Peers, blk.ID.Uint64())
+	err := elog.updateSegment(ctx, seg)
+	if err != nil {
+		seg.Replicas = replicas
+	}
+	elog.unlock()
+	if err != nil {
+		return err
+	}
+
+	mgr.globalBlockMap.Delete(blk.ID.Key())
+	mgr.markBlockRemoved(ctx, blk)
+	log.Info(ctx, "the lost replica has been removed from segment", map[string]interface{}{
+		log.KeySegmentID: seg.ID,
+		"block_id":       blk.ID,
+		"volume_id":      blk.VolumeID,
+	})
+	return nil
+}
+
+// addReplica picks a block in the volume which doesn't hold the segment, and adds it to the raft group and the
+// metadata of segment. The picked block is kept in r, so it's reused if any step failed.
+func (mgr *eventlogManager) addReplica(
+	ctx context.Context, elog *eventlog, seg *Segment, leader *metadata.Block, r *Replication,
+	isLost func(vanus.ID) bool,
+) error {
+	ins := mgr.volMgr.GetVolumeInstanceByID(leader.VolumeID)
+	if ins == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+
+	if r.NewBlock != nil && isLost(r.NewBlock.VolumeID) {
+		if _, ok := seg.Replicas.Peers[r.NewBlock.ID.Uint64()]; !ok {
+			// the volume picked before is lost too, make sure the block isn't in raft group, then pick another one.
+			if err := ins.RemoveReplica(ctx, leader.ID, r.NewBlock.ID); err != nil {
+				return err
+			}
+			mgr.markBlockRemoved(ctx, r.NewBlock)
+			r.NewBlock = nil
+		}
+	}
+
+	if r.NewBlock == nil {
+		excluded := make([]vanus.ID, 0, len(seg.Replicas.Peers)+1)
+		for _, blk := range seg.Replicas.Peers {
+			excluded = append(excluded, blk.VolumeID)
+		}
+		if r.LostBlock != nil {
+			excluded = append(excluded, r.LostBlock.VolumeID)
+		}
+		blk, err := mgr.allocator.PickExcluding(ctx, excluded)
+		if err != nil {
+			return err
+		}
+		blk.SegmentID = seg.ID
+		blk.EventlogID = seg.EventlogID
+		data, _ := json.Marshal(blk)
+		if err = mgr.kvClient.Set(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID), data); err != nil {
+			return err
+		}
+		r.NewBlock = blk
+		mgr.replications.Store(seg.ID.Key(), *r)
+	}
+
+	newIns := mgr.volMgr.GetVolumeInstanceByID(r.NewBlock.VolumeID)
+	if newIns == nil {
+		return errors.ErrVolumeInstanceNotFound
+	}
+	if err := ins.AddReplica(ctx, leader.ID, r.NewBlock.ID, newIns.Address()); err != nil {
+		return err
+	}
+
+	if _, ok := seg.Replicas.Peers[r.NewBlock.ID.Uint64()]; !ok {
+		elog.lock()
+		replicas := seg.Replicas
+		seg.Replicas = replicas.clone()
+		seg.Replicas.Peers[r.NewBlock.ID.Uint64()] = r.NewBlock
+		err := elog.updateSegment(ctx, seg)
+		if err != nil {
+			seg.Replicas = replicas
+		}
+		elog.unlock()
+		if err != nil {
+			return err
+		}
+		mgr.globalBlockMap.Store(r.NewBlock.ID.Key(), r.NewBlock)
+	}
+
+	log.Info(ctx, "the new replica has been added to segment", map[string]interface{}{
+		log.KeySegmentID: seg.ID,
+		"block_id":       r.NewBlock.ID,
+		"volume_id":      r.NewBlock.VolumeID,
+	})
+	return nil
+}
+
+// checkCatchingUp updates the progress of new replica by the replication status of leader, and returns true if
+// the new replica has caught up with the leader.
+func (mgr *eventlogManager) checkCatchingUp(
+	ctx context.Context, seg *Segment, r Replication, isLost func(vanus.ID) bool,
+) bool {
+	if isLost(r.NewBlock.VolumeID) {
+		// the new replica is lost too, it's replaced by another re-replication.
+		mgr.replications.Delete(seg.ID.Key())
+		return false
+	}
+
+	leader := seg.GetLeaderBlock()
+	if leader == nil {
+		return false
+	}
+	ins := mgr.volMgr.GetVolumeInstanceByID(leader.VolumeID)
+	if ins == nil {
+		mgr.failReplication(ctx, r, errors.ErrVolumeInstanceNotFound)
+		return false
+	}
+	info, err := ins.GetBlockInfo(ctx, leader.ID)
+	if err != nil {
+		mgr.failReplication(ctx, r, err)
+		return false
+	}
+	if len(info.Replicas) == 0 {
+		mgr.failReplication(ctx, r, errors.ErrNotLeader)
+		return false
+	}
+
+	var pr *segpb.ReplicaProgress
+	for _, p := range info.Replicas {
+		if p.Id == r.NewBlock.ID.Uint64() {
+			pr = p
+			break
+		}
+	}
+	if pr == nil {
+		// The conf change is dropped by raft if there is another one pending, so add the new replica again.
+		r.State = ReplicationStateAdding
+		mgr.replications.Store(seg.ID.Key(), r)
+		return false
+	}
+
+	r.Progress = replicationProgress(pr.MatchIndex, info.CommitIndex)
+	r.Message = ""
+	if pr.State == replicaStateReplicate && pr.MatchIndex+defaultReplicaCatchUpLag >= info.CommitIndex {
+		if seg.isOffloaded() {
+			// The new replica of offloaded segment holds the whole data, replace it with the shared object.
+			newIns := mgr.volMgr.GetVolumeInstanceByID(r.NewBlock.VolumeID)
+			if newIns == nil {
+				mgr.failReplication(ctx, r, errors.ErrVolumeInstanceNotFound)
+				return false
+			}
+			if err = newIns.OffloadBlock(ctx, r.NewBlock.ID, seg.ObjectKey); err != nil {
+				mgr.failReplication(ctx, r, err)
+				return false
+			}
+		}
+		mgr.replications.Delete(seg.ID.Key())
+		log.Info(ctx, "the segment has been re-replicated", map[string]interface{}{
+			log.KeySegmentID: seg.ID,
+			"block_id":       r.NewBlock.ID,
+			"volume_id":      r.NewBlock.VolumeID,
+			"duration":       time.Since(r.StartTime),
+		})
+		return true
+	}
+	mgr.replications.Store(seg.ID.Key(), r)
+	return false
+}
+
+func replicationProgress(match, commit uint64) int32 {
+	if commit == 0 || match >= commit {
+		return 100
+	}
+	return int32(match * 100 / commit)
+}
+
+func (mgr *eventlogManager) getReplication(segID vanus.ID) (Replication, bool) {
+	v, exist := mgr.replications.Load(segID.Key())
+	if !exist {
+		return Replication{}, false
+	}
+	return v.(Replication), true
+}
+
+func (mgr *eventlogManager) failReplication(ctx context.Context, r Replication, err error) {
+	r.Message = err.Error()
+	mgr.replications.Store(r.SegmentID.Key(), r)
+	log.Warning(ctx, "re-replicate segment failed", map[string]interface{}{
+		log.KeyError:     err,
+		log.KeySegmentID: r.SegmentID,
+		"state":          r.State,
+	})
+}
+
+// markBlockRemoved records the block which has been removed from its segment, the record is persisted so that the
+// block is deleted from its volume by cleanRemovedBlocks even if the controller restarts.
+func (mgr *eventlogManager) markBlockRemoved(ctx context.Context, blk *metadata.Block) {
+	mgr.removedBlocks.Store(blk.ID.Key(), blk)
+	data, _ := json.Marshal(blk)
+	if err := mgr.kvClient.Set(ctx, metadata.GetRemovedBlockMetadataKey(blk.ID), data); err != nil {
+		log.Warning(ctx, "persist removed block failed", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   blk.ID,
+			"volume_id":  blk.VolumeID,
+		})
+	}
+}
+
+// loadRemovedBlocks loads the blocks which were removed from segments but not deleted before the controller restarts.
+func (mgr *eventlogManager) loadRemovedBlocks(ctx context.Context) error {
+	pairs, err := mgr.kvClient.List(ctx, metadata.RemovedBlockKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		blk := &metadata.Block{}
+		if err = json.Unmarshal(pair.Value, blk); err != nil {
+			return err
+		}
+		mgr.removedBlocks.Store(blk.ID.Key(), blk)
+	}
+	return nil
+}
+
+// forgetRemovedBlock deletes the record of removed block, after it has been deleted from its volume.
+func (mgr *eventlogManager) forgetRemovedBlock(ctx context.Context, blk *metadata.Block) error {
+	if err := mgr.kvClient.Delete(ctx, metadata.GetRemovedBlockMetadataKey(blk.ID)); err != nil {
+		return err
+	}
+	mgr.removedBlocks.Delete(blk.ID.Key())
+	return nil
+}
+
+// cleanRemovedBlocks deletes the blocks which have been removed from segments, once their volumes are active.
+func (mgr *eventlogManager) cleanRemovedBlocks(ctx context.Context) {
+	mgr.removedBlocks.Range(func(key, value any) bool {
+		blk, _ := value.(*metadata.Block)
+		ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID)
+		if ins == nil {
+			// the volume has been removed with its blocks.
+			_ = mgr.forgetRemovedBlock(ctx, blk)
+			return true
+		}
+		if ins.GetServer() == nil || !ins.GetServer().IsActive(ctx) {
+			return true
+		}
+		if err := ins.DeleteBlock(ctx, blk.ID, false); err != nil {
+			log.Warning(ctx, "delete removed block failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   blk.ID,
+				"volume_id":  blk.VolumeID,
+			})
+			return true
+		}
+		if err := mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID)); err != nil {
+			log.Warning(ctx, "delete block metadata in kv failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   blk.ID,
+			})
+			return true
+		}
+		if err := mgr.forgetRemovedBlock(ctx, blk); err != nil {
+			log.Warning(ctx, "delete removed block record in kv failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   blk.ID,
+			})
+			return true
+		}
+		log.Info(ctx, "the removed block has been deleted", map[string]interface{}{
+			"block_id":  blk.ID,
+			"volume_id": blk.VolumeID,
+		})
+		return true
+	})
+}
+
+func Convert2ProtoReplication(ins ...Replication) []*ctrlpb.SegmentReplication {
+	result := make([]*ctrlpb.SegmentReplication, len(ins))
+	for idx := range ins {
+		r := ins[idx]
+		pb := &ctrlpb.SegmentReplication{
+			SegmentId:  r.SegmentID.Uint64(),
+			EventlogId: r.EventlogID.Uint64(),
+			State:      string(r.State),
+			Progress:   r.Progress,
+			Message:    r.Message,
+			StartTime:  r.StartTime.UnixMilli(),
+		}
+		if r.LostBlock != nil {
+			pb.LostBlockId = r.LostBlock.ID.Uint64()
+			pb.LostVolumeId = r.LostBlock.VolumeID.Uint64()
+		}
+		if r.NewBlock != nil {
+			pb.NewBlockId = r.NewBlock.ID.Uint64()
+			pb.NewVolumeId = r.NewBlock.VolumeID.Uint64()
+		}
+		result[idx] = pb
+	}
+	return result
+}
@@ -49,6 +49,13 @@ type Segment struct {
 	Number             int32         `json:"number,omitempty"`
 	FirstEventBornTime time.Time     `json:"first_event_born_time"`
 	LastEventBornTime  time.Time     `json:"last_event_born_time"`
+	// CompactedAt is the time of last compaction, it's zero if the segment has never been compacted.
+	CompactedAt time.Time `json:"compacted_at,omitempty"`
+	// ObjectKey is the key of object which the segment is offloaded to, it's empty if the segment is stored in
+	// the volumes of store nodes.
+	ObjectKey string `json:"object_key,omitempty"`
+	// Compression is the algorithm used to compress the events of segment, which is decided when it's created.
+	Compression metapb.CompressAlgorithm `json:"compression,omitempty"`
 }
 
 func (seg *Segment) IsAppendable() bool {
@@ -107,6 +114,24 @@ func (seg *Segment) isNeedUpdate(newSeg Segment) bool {
 	return needed
 }
 
+// isNeedCompact returns false if all tombstones of segment had exceeded the grace period at last compaction,
+// compacting it again removes nothing.
+func (seg *Segment) isNeedCompact(tombstoneGrace time.Duration) bool {
+	return seg.CompactedAt.IsZero() || seg.CompactedAt.Before(seg.LastEventBornTime.Add(tombstoneGrace))
+}
+
+func (seg *Segment) isOffloaded() bool {
+	return seg.ObjectKey != ""
+}
+
+// storageTier returns where the events of segment are stored.
+func (seg *Segment) storageTier() metapb.StorageTier {
+	if seg.isOffloaded() {
+		return metapb.StorageTier_S3
+	}
+	return metapb.StorageTier_SSD
+}
+
 func (seg *Segment) isFull() bool {
 	return seg.State == StateFrozen
 }
@@ -129,6 +154,9 @@ func (seg *Segment) Copy() Segment {
 		Number:             seg.Number,
 		FirstEventBornTime: seg.FirstEventBornTime,
 		LastEventBornTime:  seg.LastEventBornTime,
+		CompactedAt:        seg.CompactedAt,
+		ObjectKey:          seg.ObjectKey,
+		Compression:        seg.Compression,
 	}
 }
 
@@ -143,6 +171,18 @@ type ReplicaGroup struct {
 	DestroyAt time.Time                  `json:"destroy_at"`
 }
 
+// clone returns a copy of the replica group with its own peers, it's used to change the peers since the original
+// one may be read concurrently.
+func (rg *ReplicaGroup) clone() *ReplicaGroup {
+	peers := make(map[uint64]*metadata.Block, len(rg.Peers))
+	for id, blk := range rg.Peers {
+		peers[id] = blk
+	}
+	replicas := *rg
+	replicas.Peers = peers
+	return &replicas
+}
+
 func Convert2ProtoSegment(ctx context.Context, ins ...Segment) []*metapb.Segment {
 	segs := make([]*metapb.Segment, len(ins))
 	for idx := 0; idx < len(ins); idx++ {
@@ -172,6 +212,9 @@ func Convert2ProtoSegment(ctx context.Context, ins ...Segment) []*metapb.Segment
 			State:                    string(seg.State),
 			FirstEventBornAtByUnixMs: seg.FirstEventBornTime.UnixMilli(),
 			LastEvnetBornAtByUnixMs:  seg.LastEventBornTime.UnixMilli(),
+			StorageTier:              seg.storageTier(),
+			ObjectKey:                seg.ObjectKey,
+			Compressed:               seg.Compression,
 		}
 		if seg.GetLeaderBlock() != nil {
 			segs[idx].LeaderBlockId = seg.GetLeaderBlock().ID.Uint64()
@@ -20,17 +20,171 @@ import (
 
 	"github.com/vanus-labs/vanus/proto/pkg/meta"
 
+	"github.com/vanus-labs/vanus/internal/primitive"
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
 
+type Namespace struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	Quota       *NamespaceQuota `json:"quota,omitempty"`
+}
+
+// NamespaceQuota limits the resources and ingress of a namespace, 0 means unlimited.
+type NamespaceQuota struct {
+	MaxEventbuses      int32 `json:"max_eventbuses,omitempty"`
+	MaxSubscriptions   int32 `json:"max_subscriptions,omitempty"`
+	MaxEventsPerSecond int64 `json:"max_events_per_second,omitempty"`
+	MaxBytesPerSecond  int64 `json:"max_bytes_per_second,omitempty"`
+	MaxRetainedBytes   int64 `json:"max_retained_bytes,omitempty"`
+}
+
+func Convert2ProtoNamespace(ns *Namespace) *meta.Namespace {
+	return &meta.Namespace{
+		Name:        ns.Name,
+		Description: ns.Description,
+		CreatedAt:   ns.CreatedAt.UnixMilli(),
+		UpdatedAt:   ns.UpdatedAt.UnixMilli(),
+		Quota:       Convert2ProtoNamespaceQuota(ns.Quota),
+	}
+}
+
+func Convert2ProtoNamespaceQuota(quota *NamespaceQuota) *meta.NamespaceQuota {
+	if quota == nil {
+		return nil
+	}
+	return &meta.NamespaceQuota{
+		MaxEventbuses:      quota.MaxEventbuses,
+		MaxSubscriptions:   quota.MaxSubscriptions,
+		MaxEventsPerSecond: quota.MaxEventsPerSecond,
+		MaxBytesPerSecond:  quota.MaxBytesPerSecond,
+		MaxRetainedBytes:   quota.MaxRetainedBytes,
+	}
+}
+
+func ConvertFromProtoNamespaceQuota(quota *meta.NamespaceQuota) *NamespaceQuota {
+	if quota == nil {
+		return nil
+	}
+	return &NamespaceQuota{
+		MaxEventbuses:      quota.MaxEventbuses,
+		MaxSubscriptions:   quota.MaxSubscriptions,
+		MaxEventsPerSecond: quota.MaxEventsPerSecond,
+		MaxBytesPerSecond:  quota.MaxBytesPerSecond,
+		MaxRetainedBytes:   quota.MaxRetainedBytes,
+	}
+}
+
 type Eventbus struct {
 	ID          vanus.ID    `json:"id"`
 	Name        string      `json:"name"`
+	Namespace   string      `json:"namespace"`
 	LogNumber   int         `json:"log_number"`
 	Eventlogs   []*Eventlog `json:"event_logs"`
 	Description string      `json:"description"`
 	CreatedAt   time.Time   `json:"created_at"`
 	UpdatedAt   time.Time   `json:"updated_at"`
+	// Auth is the authentication of gateway ingress, the tokens and secret are encrypted.
+	Auth       *EventbusAuth     `json:"auth,omitempty"`
+	Retention  *RetentionPolicy  `json:"retention,omitempty"`
+	Compaction *CompactionPolicy `json:"compaction,omitempty"`
+	// Compression is the algorithm used to compress the events written to the new segments.
+	Compression meta.CompressAlgorithm `json:"compression,omitempty"`
+}
+
+// RetentionPolicy decides when the full head segments of eventlogs are deleted, 0 means the limit is unset.
+type RetentionPolicy struct {
+	KeepForever bool          `json:"keep_forever,omitempty"`
+	MaxAge      time.Duration `json:"max_age,omitempty"`
+	MaxBytes    int64         `json:"max_bytes,omitempty"`
+	MaxEvents   int64         `json:"max_events,omitempty"`
+}
+
+// IsDefault returns true if no limit is set, the default max age of cluster is used in this case.
+func (r *RetentionPolicy) IsDefault() bool {
+	return r == nil || (!r.KeepForever && r.MaxAge == 0 && r.MaxBytes == 0 && r.MaxEvents == 0)
+}
+
+func Convert2ProtoRetentionPolicy(r *RetentionPolicy) *meta.RetentionPolicy {
+	if r == nil {
+		return nil
+	}
+	return &meta.RetentionPolicy{
+		KeepForever:   r.KeepForever,
+		MaxAgeSeconds: int64(r.MaxAge / time.Second),
+		MaxBytes:      r.MaxBytes,
+		MaxEvents:     r.MaxEvents,
+	}
+}
+
+func ConvertFromProtoRetentionPolicy(r *meta.RetentionPolicy) *RetentionPolicy {
+	if r == nil {
+		return nil
+	}
+	return &RetentionPolicy{
+		KeepForever: r.KeepForever,
+		MaxAge:      time.Duration(r.MaxAgeSeconds) * time.Second,
+		MaxBytes:    r.MaxBytes,
+		MaxEvents:   r.MaxEvents,
+	}
+}
+
+// CompactionPolicy enables the compaction by key for the full segments of eventlogs.
+type CompactionPolicy struct {
+	KeyAttribute   string        `json:"key_attribute"`
+	TombstoneGrace time.Duration `json:"tombstone_grace,omitempty"`
+}
+
+// IsEnabled returns true if the key attribute is set.
+func (c *CompactionPolicy) IsEnabled() bool {
+	return c != nil && c.KeyAttribute != ""
+}
+
+func Convert2ProtoCompactionPolicy(c *CompactionPolicy) *meta.CompactionPolicy {
+	if !c.IsEnabled() {
+		return nil
+	}
+	return &meta.CompactionPolicy{
+		KeyAttribute:          c.KeyAttribute,
+		TombstoneGraceSeconds: int64(c.TombstoneGrace / time.Second),
+	}
+}
+
+func ConvertFromProtoCompactionPolicy(c *meta.CompactionPolicy) *CompactionPolicy {
+	if c.GetKeyAttribute() == "" {
+		return nil
+	}
+	return &CompactionPolicy{
+		KeyAttribute:   c.KeyAttribute,
+		TombstoneGrace: time.Duration(c.TombstoneGraceSeconds) * time.Second,
+	}
+}
+
+type EventbusAuth struct {
+	Type      meta.EventbusAuth_AuthType `json:"type"`
+	Tokens    []string                   `json:"tokens,omitempty"`
+	Secret    string                     `json:"secret,omitempty"`
+	Tolerance uint32                     `json:"tolerance,omitempty"`
+}
+
+// Convert2ProtoEventbusAuth converts the auth with masked tokens and secret.
+func Convert2ProtoEventbusAuth(auth *EventbusAuth) *meta.EventbusAuth {
+	if auth == nil {
+		return nil
+	}
+	pa := &meta.EventbusAuth{
+		Type:      auth.Type,
+		Tolerance: auth.Tolerance,
+	}
+	for range auth.Tokens {
+		pa.Tokens = append(pa.Tokens, primitive.SecretsMask)
+	}
+	if auth.Secret != "" {
+		pa.Secret = primitive.SecretsMask
+	}
+	return pa
 }
 
 func Convert2ProtoEventbus(ins ...*Eventbus) []*meta.Eventbus {
@@ -39,12 +193,17 @@ func Convert2ProtoEventbus(ins ...*Eventbus) []*meta.Eventbus {
 		eb := ins[idx]
 		pebs[idx] = &meta.Eventbus{
 			Name:        eb.Name,
+			Namespace:   eb.Namespace,
 			LogNumber:   int32(eb.LogNumber),
 			Logs:        Convert2ProtoEventlog(eb.Eventlogs...),
 			Id:          eb.ID.Uint64(),
 			Description: eb.Description,
 			CreatedAt:   eb.CreatedAt.UnixMilli(),
 			UpdatedAt:   eb.UpdatedAt.UnixMilli(),
+			Auth:        Convert2ProtoEventbusAuth(eb.Auth),
+			Retention:   Convert2ProtoRetentionPolicy(eb.Retention),
+			Compaction:  Convert2ProtoCompactionPolicy(eb.Compaction),
+			Compression: eb.Compression,
 		}
 	}
 	return pebs
@@ -83,6 +242,8 @@ type VolumeMetadata struct {
 	Capacity int64             `json:"capacity"`
 	Used     int64             `json:"used"`
 	Blocks   map[uint64]*Block `json:"blocks"`
+	// Draining is true if the volume is being decommissioned, its replicas are moved to other volumes.
+	Draining bool `json:"draining,omitempty"`
 }
 
 type Block struct {
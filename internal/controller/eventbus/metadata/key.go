@@ -25,14 +25,28 @@ const (
 	BlockKeyPrefixInKVStore          = "/vanus/internal/resource/volume/block"
 	VolumeInstanceKeyPrefixInKVStore = "/vanus/internal/resource/volume/instance"
 
-	EventbusKeyPrefixInKVStore = "/vanus/internal/resource/eventbus"
-	EventlogKeyPrefixInKVStore = "/vanus/internal/resource/eventlog"
-	SegmentKeyPrefixInKVStore  = "/vanus/internal/resource/segment"
+	NamespaceKeyPrefixInKVStore = "/vanus/internal/resource/namespace"
+	EventbusKeyPrefixInKVStore  = "/vanus/internal/resource/eventbus"
+	EventlogKeyPrefixInKVStore  = "/vanus/internal/resource/eventlog"
+	SegmentKeyPrefixInKVStore   = "/vanus/internal/resource/segment"
 
 	EventlogSegmentsKeyPrefixInKVStore = "/vanus/internal/resource/segs_of_eventlog"
+	// RemovedBlockKeyPrefixInKVStore is the prefix of blocks which have been removed from their segments, but
+	// haven't been deleted from volumes.
+	RemovedBlockKeyPrefixInKVStore = "/vanus/internal/resource/removed_block"
 )
 
-func GetEventbusMetadataKey(ebName string) string {
+func GetNamespaceMetadataKey(name string) string {
+	return path.Join(NamespaceKeyPrefixInKVStore, name)
+}
+
+// GetEventbusMetadataKey returns the key of eventbus, the name of eventbus is unique in a namespace.
+func GetEventbusMetadataKey(namespace, ebName string) string {
+	return path.Join(EventbusKeyPrefixInKVStore, namespace, ebName)
+}
+
+// GetLegacyEventbusMetadataKey returns the key of eventbus created before namespace was introduced.
+func GetLegacyEventbusMetadataKey(ebName string) string {
 	return path.Join(EventbusKeyPrefixInKVStore, ebName)
 }
 
@@ -51,3 +65,7 @@ func GetSegmentMetadataKey(segmentID vanus.ID) string {
 func GetEventlogSegmentsMetadataKey(eventlogID, segmentID vanus.ID) string {
 	return path.Join(EventlogSegmentsKeyPrefixInKVStore, eventlogID.Key(), segmentID.Key())
 }
+
+func GetRemovedBlockMetadataKey(blockID vanus.ID) string {
+	return path.Join(RemovedBlockKeyPrefixInKVStore, blockID.Key())
+}
@@ -0,0 +1,301 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/primitive"
+)
+
+const maximumNamespaceNameLength = 64
+
+func (ctrl *controller) CreateNamespace(
+	ctx context.Context, req *ctrlpb.CreateNamespaceRequest,
+) (*metapb.Namespace, error) {
+	if err := isValidNamespaceName(req.Name); err != nil {
+		return nil, err
+	}
+	if err := validateNamespaceQuota(req.Quota); err != nil {
+		return nil, err
+	}
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if !ctrl.isReady(ctx) {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the cluster isn't ready for create namespace")
+	}
+	if _, exist := ctrl.namespaceMap[req.Name]; exist {
+		return nil, errors.ErrResourceAlreadyExist.WithMessage("the namespace already exist")
+	}
+	now := time.Now()
+	ns := &metadata.Namespace{
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Quota:       metadata.ConvertFromProtoNamespaceQuota(req.Quota),
+	}
+	if err := ctrl.putNamespace(ctx, ns); err != nil {
+		return nil, err
+	}
+	return metadata.Convert2ProtoNamespace(ns), nil
+}
+
+func (ctrl *controller) GetNamespace(
+	_ context.Context, req *ctrlpb.GetNamespaceRequest,
+) (*metapb.Namespace, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	ns, exist := ctrl.namespaceMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the namespace doesn't exist")
+	}
+	pb := metadata.Convert2ProtoNamespace(ns)
+	pb.Usage = ctrl.getNamespaceUsage(ns.Name)
+	return pb, nil
+}
+
+func (ctrl *controller) SetNamespaceQuota(
+	ctx context.Context, req *ctrlpb.SetNamespaceQuotaRequest,
+) (*metapb.Namespace, error) {
+	if err := validateNamespaceQuota(req.Quota); err != nil {
+		return nil, err
+	}
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	ns, exist := ctrl.namespaceMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the namespace doesn't exist")
+	}
+	updated := *ns
+	updated.Quota = metadata.ConvertFromProtoNamespaceQuota(req.Quota)
+	updated.UpdatedAt = time.Now()
+	if err := ctrl.putNamespace(ctx, &updated); err != nil {
+		return nil, err
+	}
+	pb := metadata.Convert2ProtoNamespace(&updated)
+	pb.Usage = ctrl.getNamespaceUsage(updated.Name)
+	return pb, nil
+}
+
+func validateNamespaceQuota(quota *metapb.NamespaceQuota) error {
+	if quota.GetMaxEventbuses() < 0 || quota.GetMaxSubscriptions() < 0 || quota.GetMaxEventsPerSecond() < 0 ||
+		quota.GetMaxBytesPerSecond() < 0 || quota.GetMaxRetainedBytes() < 0 {
+		return errors.ErrInvalidRequest.WithMessage("the quota can't be negative")
+	}
+	return nil
+}
+
+// getNamespaceUsage returns the number of eventbuses and the retained bytes of segments in the namespace.
+func (ctrl *controller) getNamespaceUsage(name string) *metapb.NamespaceUsage {
+	usage := &metapb.NamespaceUsage{}
+	for _, eb := range ctrl.eventbusMap {
+		if eb.Namespace != name {
+			continue
+		}
+		usage.Eventbuses++
+		for _, el := range eb.Eventlogs {
+			if el == nil {
+				continue
+			}
+			for _, seg := range ctrl.eventlogMgr.GetEventlogSegmentList(el.ID) {
+				usage.RetainedBytes += seg.Size
+			}
+		}
+	}
+	return usage
+}
+
+// checkEventbusQuota returns ErrQuotaExceeded if the number of eventbuses in the namespace reaches the quota.
+func (ctrl *controller) checkEventbusQuota(name string) error {
+	ns, exist := ctrl.namespaceMap[name]
+	if !exist || ns.Quota == nil || ns.Quota.MaxEventbuses == 0 {
+		return nil
+	}
+	var num int32
+	for _, eb := range ctrl.eventbusMap {
+		if eb.Namespace == name {
+			num++
+		}
+	}
+	if num < ns.Quota.MaxEventbuses {
+		return nil
+	}
+	metrics.NamespaceQuotaExceededCounterVec.WithLabelValues(name, primitive.QuotaEventbuses).Inc()
+	return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+		"the number of eventbuses in namespace %s reached the quota %s=%d",
+		name, primitive.QuotaEventbuses, ns.Quota.MaxEventbuses))
+}
+
+func (ctrl *controller) recordNamespaceMetrics() {
+	for name := range ctrl.namespaceMap {
+		usage := ctrl.getNamespaceUsage(name)
+		metrics.NamespaceEventbusGaugeVec.WithLabelValues(name).Set(float64(usage.Eventbuses))
+		metrics.NamespaceRetainedBytesGaugeVec.WithLabelValues(name).Set(float64(usage.RetainedBytes))
+	}
+}
+
+func (ctrl *controller) DeleteNamespace(
+	ctx context.Context, req *ctrlpb.DeleteNamespaceRequest,
+) (*emptypb.Empty, error) {
+	if req.Name == primitive.DefaultNamespace || req.Name == primitive.SystemNamespace {
+		return nil, errors.ErrInvalidRequest.WithMessage("the built-in namespace can't be deleted")
+	}
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if _, exist := ctrl.namespaceMap[req.Name]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the namespace doesn't exist")
+	}
+	for _, eb := range ctrl.eventbusMap {
+		if eb.Namespace == req.Name {
+			return nil, errors.ErrResourceCanNotOp.WithMessage(
+				"the namespace isn't empty, delete the eventbuses in it first")
+		}
+	}
+	subs, err := ctrl.triggerCtrl.ListSubscription(ctx, &ctrlpb.ListSubscriptionRequest{Namespace: req.Name})
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list subscriptions of namespace failed").Wrap(err)
+	}
+	if len(subs.GetSubscription()) > 0 {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the namespace isn't empty, delete the subscriptions in it first")
+	}
+	if err := ctrl.kvStore.Delete(ctx, metadata.GetNamespaceMetadataKey(req.Name)); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete namespace metadata in kv failed").Wrap(err)
+	}
+	delete(ctrl.namespaceMap, req.Name)
+	metrics.NamespaceEventbusGaugeVec.DeleteLabelValues(req.Name)
+	metrics.NamespaceRetainedBytesGaugeVec.DeleteLabelValues(req.Name)
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) ListNamespace(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListNamespaceResponse, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	list := make([]*metapb.Namespace, 0, len(ctrl.namespaceMap))
+	for _, ns := range ctrl.namespaceMap {
+		list = append(list, metadata.Convert2ProtoNamespace(ns))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return &ctrlpb.ListNamespaceResponse{Namespaces: list}, nil
+}
+
+func isValidNamespaceName(name string) error {
+	if name == "" || len(name) > maximumNamespaceNameLength {
+		return errors.ErrInvalidRequest.WithMessage("the length of namespace name must be in [1, 64]")
+	}
+	for _, c := range name {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+			c == '.' || c == '_' || c == '-' {
+			continue
+		}
+		return errors.ErrInvalidRequest.WithMessage("namespace name must be insist of 0-9a-zA-Z.-_")
+	}
+	return nil
+}
+
+// getNamespace returns the namespace of eventbus, the default namespace is used if it's empty.
+func (ctrl *controller) getNamespace(name string) (string, error) {
+	if name == "" {
+		name = primitive.DefaultNamespace
+	}
+	if _, exist := ctrl.namespaceMap[name]; !exist {
+		return "", errors.ErrResourceNotFound.WithMessage("the namespace doesn't exist")
+	}
+	return name, nil
+}
+
+func (ctrl *controller) putNamespace(ctx context.Context, ns *metadata.Namespace) error {
+	data, _ := json.Marshal(ns)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetNamespaceMetadataKey(ns.Name), data); err != nil {
+		return err
+	}
+	ctrl.namespaceMap[ns.Name] = ns
+	return nil
+}
+
+// loadNamespace loads namespaces from kv, and creates the built-in namespaces if they don't exist.
+func (ctrl *controller) loadNamespace(ctx context.Context) error {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.NamespaceKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	for idx := range pairs {
+		ns := &metadata.Namespace{}
+		if err = json.Unmarshal(pairs[idx].Value, ns); err != nil {
+			return err
+		}
+		ctrl.namespaceMap[ns.Name] = ns
+	}
+	builtin := map[string]string{
+		primitive.DefaultNamespace: "The default namespace",
+		primitive.SystemNamespace:  "The namespace of system eventbuses",
+	}
+	for name, desc := range builtin {
+		if _, exist := ctrl.namespaceMap[name]; exist {
+			continue
+		}
+		now := time.Now()
+		err = ctrl.putNamespace(ctx, &metadata.Namespace{
+			Name:        name,
+			Description: desc,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateEventbus moves the eventbus created before namespace was introduced to the key of its namespace,
+// the system eventbuses belong to system namespace and others belong to default namespace.
+func (ctrl *controller) migrateEventbus(ctx context.Context, eb *metadata.Eventbus) error {
+	if strings.HasPrefix(eb.Name, primitive.SystemEventbusNamePrefix) {
+		eb.Namespace = primitive.SystemNamespace
+	} else {
+		eb.Namespace = primitive.DefaultNamespace
+	}
+	data, _ := json.Marshal(eb)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Namespace, eb.Name), data); err != nil {
+		return err
+	}
+	if err := ctrl.kvStore.Delete(ctx, metadata.GetLegacyEventbusMetadataKey(eb.Name)); err != nil {
+		return err
+	}
+	log.Info(ctx, "migrated eventbus to namespace", map[string]interface{}{
+		log.KeyEventbusName: eb.Name,
+		log.KeyNamespace:    eb.Namespace,
+	})
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vanus-labs/vanus/pkg/errors"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/vanus-labs/vanus/internal/controller/member"
+	"github.com/vanus-labs/vanus/internal/kv"
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestController_Namespace(t *testing.T) {
+	Convey("test namespace", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		mockMember := member.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().IsLeader().AnyTimes().Return(true)
+		mockMember.EXPECT().IsReady().AnyTimes().Return(true)
+		mockMember.EXPECT().GetLeaderAddr().AnyTimes().Return("test")
+		ctx := stdCtx.Background()
+
+		kvCli.EXPECT().List(ctx, metadata.NamespaceKeyPrefixInKVStore).Return([]kv.Pair{}, nil)
+		kvCli.EXPECT().Set(ctx, metadata.GetNamespaceMetadataKey(primitive.DefaultNamespace), gomock.Any()).Return(nil)
+		kvCli.EXPECT().Set(ctx, metadata.GetNamespaceMetadataKey(primitive.SystemNamespace), gomock.Any()).Return(nil)
+		So(ctrl.loadNamespace(ctx), ShouldBeNil)
+
+		Convey("create, list and delete namespace", func() {
+			_, err := ctrl.CreateNamespace(ctx, &ctrlpb.CreateNamespaceRequest{Name: "a/b"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.CreateNamespace(ctx, &ctrlpb.CreateNamespaceRequest{Name: primitive.DefaultNamespace})
+			So(errors.Is(err, errors.ErrResourceAlreadyExist), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetNamespaceMetadataKey("test"), gomock.Any()).Return(nil)
+			ns, err := ctrl.CreateNamespace(ctx, &ctrlpb.CreateNamespaceRequest{Name: "test", Description: "desc"})
+			So(err, ShouldBeNil)
+			So(ns.Name, ShouldEqual, "test")
+			So(ns.Description, ShouldEqual, "desc")
+
+			res, err := ctrl.ListNamespace(ctx, &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(res.Namespaces, ShouldHaveLength, 3)
+			So(res.Namespaces[0].Name, ShouldEqual, primitive.DefaultNamespace)
+			So(res.Namespaces[1].Name, ShouldEqual, primitive.SystemNamespace)
+			So(res.Namespaces[2].Name, ShouldEqual, "test")
+
+			_, err = ctrl.DeleteNamespace(ctx, &ctrlpb.DeleteNamespaceRequest{Name: primitive.DefaultNamespace})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			id := vanus.NewTestID()
+			ctrl.eventbusMap[id] = &metadata.Eventbus{ID: id, Name: "eb", Namespace: "test"}
+			_, err = ctrl.DeleteNamespace(ctx, &ctrlpb.DeleteNamespaceRequest{Name: "test"})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			delete(ctrl.eventbusMap, id)
+			triggerCtrl := ctrlpb.NewMockTriggerControllerClient(mockCtrl)
+			ctrl.triggerCtrl = triggerCtrl
+			triggerCtrl.EXPECT().ListSubscription(ctx, &ctrlpb.ListSubscriptionRequest{Namespace: "test"}).
+				Return(&ctrlpb.ListSubscriptionResponse{Subscription: []*metapb.Subscription{{Namespace: "test"}}}, nil)
+			_, err = ctrl.DeleteNamespace(ctx, &ctrlpb.DeleteNamespaceRequest{Name: "test"})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			triggerCtrl.EXPECT().ListSubscription(ctx, gomock.Any()).Return(&ctrlpb.ListSubscriptionResponse{}, nil)
+			kvCli.EXPECT().Delete(ctx, metadata.GetNamespaceMetadataKey("test")).Return(nil)
+			_, err = ctrl.DeleteNamespace(ctx, &ctrlpb.DeleteNamespaceRequest{Name: "test"})
+			So(err, ShouldBeNil)
+			_, err = ctrl.DeleteNamespace(ctx, &ctrlpb.DeleteNamespaceRequest{Name: "test"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("eventbus name is unique in namespace", func() {
+			id1, id2 := vanus.NewTestID(), vanus.NewTestID()
+			ctrl.eventbusMap[id1] = &metadata.Eventbus{ID: id1, Name: "eb", Namespace: primitive.DefaultNamespace}
+			ctrl.eventbusMap[id2] = &metadata.Eventbus{ID: id2, Name: "eb", Namespace: "test"}
+
+			eb, err := ctrl.GetEventbusWithHumanFriendly(ctx, &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+				EventbusName: "eb",
+			})
+			So(err, ShouldBeNil)
+			So(eb.Id, ShouldEqual, id1.Uint64())
+			eb, err = ctrl.GetEventbusWithHumanFriendly(ctx, &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+				Namespace:    "test",
+				EventbusName: "eb",
+			})
+			So(err, ShouldBeNil)
+			So(eb.Id, ShouldEqual, id2.Uint64())
+			So(eb.Namespace, ShouldEqual, "test")
+
+			res, err := ctrl.ListEventbus(ctx, &ctrlpb.ListEventbusRequest{Namespace: "test"})
+			So(err, ShouldBeNil)
+			So(res.Eventbus, ShouldHaveLength, 1)
+			So(res.Eventbus[0].Id, ShouldEqual, id2.Uint64())
+
+			_, err = ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{Name: "eb", Namespace: "unknown"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			_, err = ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{
+				Name:      "eb",
+				Namespace: primitive.SystemNamespace,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("namespace quota", func() {
+			_, err := ctrl.CreateNamespace(ctx, &ctrlpb.CreateNamespaceRequest{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventbuses: -1},
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(ctx, metadata.GetNamespaceMetadataKey("test"), gomock.Any()).Times(2).Return(nil)
+			ns, err := ctrl.CreateNamespace(ctx, &ctrlpb.CreateNamespaceRequest{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventbuses: 1, MaxEventsPerSecond: 100},
+			})
+			So(err, ShouldBeNil)
+			So(ns.Quota.MaxEventbuses, ShouldEqual, 1)
+
+			id := vanus.NewTestID()
+			ctrl.eventbusMap[id] = &metadata.Eventbus{ID: id, Name: "eb", Namespace: "test"}
+			ns, err = ctrl.GetNamespace(ctx, &ctrlpb.GetNamespaceRequest{Name: "test"})
+			So(err, ShouldBeNil)
+			So(ns.Usage.Eventbuses, ShouldEqual, 1)
+			So(ns.Quota.MaxEventsPerSecond, ShouldEqual, 100)
+
+			_, err = ctrl.CreateEventbus(ctx, &ctrlpb.CreateEventbusRequest{Name: "eb2", Namespace: "test"})
+			So(errors.Is(err, errors.ErrQuotaExceeded), ShouldBeTrue)
+
+			ns, err = ctrl.SetNamespaceQuota(ctx, &ctrlpb.SetNamespaceQuotaRequest{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventbuses: 2},
+			})
+			So(err, ShouldBeNil)
+			So(ns.Quota.MaxEventbuses, ShouldEqual, 2)
+			So(ns.Quota.MaxEventsPerSecond, ShouldEqual, 0)
+			So(ctrl.checkEventbusQuota("test"), ShouldBeNil)
+
+			_, err = ctrl.SetNamespaceQuota(ctx, &ctrlpb.SetNamespaceQuotaRequest{Name: "unknown"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("migrate legacy eventbus", func() {
+			id := vanus.NewTestID()
+			data, _ := json.Marshal(&metadata.Eventbus{ID: id, Name: "legacy"})
+			kvCli.EXPECT().List(ctx, metadata.EventbusKeyPrefixInKVStore).Return([]kv.Pair{
+				{Key: metadata.GetLegacyEventbusMetadataKey("legacy"), Value: data},
+			}, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey(primitive.DefaultNamespace, "legacy"),
+				gomock.Any()).Return(nil)
+			kvCli.EXPECT().Delete(ctx, metadata.GetLegacyEventbusMetadataKey("legacy")).Return(nil)
+			So(ctrl.loadEventbus(ctx), ShouldBeNil)
+			So(ctrl.eventbusMap[id].Namespace, ShouldEqual, primitive.DefaultNamespace)
+		})
+	})
+}
@@ -17,7 +17,9 @@ package server
 
 import (
 	"context"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/vanus-labs/vanus/observability/log"
 	"github.com/vanus-labs/vanus/pkg/errors"
@@ -33,19 +35,48 @@ type Instance interface {
 	Close() error
 	GetMeta() *metadata.VolumeMetadata
 	CreateBlock(context.Context, int64) (*metadata.Block, error)
-	DeleteBlock(context.Context, vanus.ID) error
+	// DeleteBlock deletes the block, and the object which its segment is offloaded to if deleteObject is true.
+	DeleteBlock(ctx context.Context, id vanus.ID, deleteObject bool) error
+	CompactBlock(ctx context.Context, id vanus.ID, keyAttribute string, tombstoneDeadline time.Time,
+		newerKeyHashes [][]byte) (int64, [][]byte, error)
+	OffloadBlock(ctx context.Context, id vanus.ID, key string) error
+	GetBlockInfo(ctx context.Context, id vanus.ID) (*segpb.GetBlockInfoResponse, error)
+	AddReplica(ctx context.Context, id vanus.ID, replica vanus.ID, endpoint string) error
+	RemoveReplica(ctx context.Context, id vanus.ID, replica vanus.ID) error
+	TransferLeader(ctx context.Context, id vanus.ID, transferee vanus.ID) error
+	GetStatistics() Statistics
+	UpdateStatistics(stat Statistics)
+	IsDraining() bool
+	SetDraining(draining bool)
 	GetServer() Server
 	SetServer(Server)
 }
 
+// Statistics is the usage of volume, which is reported by the heartbeats of its server.
+type Statistics struct {
+	// Capacity is the bytes of volume, it's unknown if zero.
+	Capacity uint64
+	// Used is the bytes reserved by the blocks in volume.
+	Used      uint64
+	BlockNum  int
+	LeaderNum int
+	Labels    map[string]string
+}
+
 func NewInstance(md *metadata.VolumeMetadata) Instance {
 	return &volumeInstance{
 		md: md,
+		stat: Statistics{
+			Capacity: uint64(md.Capacity),
+			Used:     uint64(md.Used),
+			BlockNum: len(md.Blocks),
+		},
 	}
 }
 
 type volumeInstance struct {
 	md        *metadata.VolumeMetadata
+	stat      Statistics
 	metaMutex sync.Mutex
 	srv       Server
 	rwMutex   sync.RWMutex
@@ -80,10 +111,13 @@ func (ins *volumeInstance) CreateBlock(ctx context.Context, capacity int64) (*me
 	defer ins.metaMutex.Unlock()
 	ins.md.Used += capacity
 	ins.md.Blocks[blk.ID.Uint64()] = blk
+	// The statistics are refreshed by next heartbeat, count the new block in advance.
+	ins.stat.Used += uint64(capacity)
+	ins.stat.BlockNum++
 	return blk, nil
 }
 
-func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
+func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID, deleteObject bool) error {
 	if ins.srv == nil {
 		return errors.ErrVolumeInstanceNoServer
 	}
@@ -94,7 +128,10 @@ func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
 	if ins.srv == nil {
 		return nil
 	}
-	_, err := ins.srv.GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64()})
+	_, err := ins.srv.GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{
+		Id:           id.Uint64(),
+		DeleteObject: deleteObject,
+	})
 	if err != nil {
 		return err
 	}
@@ -106,6 +143,146 @@ func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
 	return nil
 }
 
+// CompactBlock compacts the archived block by key, the events whose key hashes are in newerKeyHashes are removed
+// since they are in the newer segments. It returns the size of block after compaction and the hashes of keys of
+// events in the block. The key hashes are streamed in batches, so they aren't limited by the max message size.
+func (ins *volumeInstance) CompactBlock(
+	ctx context.Context, id vanus.ID, keyAttribute string, tombstoneDeadline time.Time, newerKeyHashes [][]byte,
+) (int64, [][]byte, error) {
+	if ins.srv == nil {
+		return 0, nil, errors.ErrVolumeInstanceNoServer
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := ins.srv.GetClient().CompactBlock(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req := &segpb.CompactBlockRequest{
+		Id:                id.Uint64(),
+		KeyAttribute:      keyAttribute,
+		TombstoneDeadline: tombstoneDeadline.UnixMilli(),
+	}
+	for {
+		n := len(newerKeyHashes)
+		if n > segpb.CompactKeyHashBatchSize {
+			n = segpb.CompactKeyHashBatchSize
+		}
+		req.NewerKeyHashes = newerKeyHashes[:n]
+		newerKeyHashes = newerKeyHashes[n:]
+		if err = stream.Send(req); err != nil {
+			// the error of stream is returned by Recv.
+			if err == io.EOF {
+				break
+			}
+			return 0, nil, err
+		}
+		if len(newerKeyHashes) == 0 {
+			break
+		}
+		req = &segpb.CompactBlockRequest{}
+	}
+	if err = stream.CloseSend(); err != nil {
+		return 0, nil, err
+	}
+
+	var size int64
+	keyHashes := make([][]byte, 0)
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return size, keyHashes, nil
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		size = res.Size
+		keyHashes = append(keyHashes, res.KeyHashes...)
+	}
+}
+
+// OffloadBlock offloads the archived block to object storage as object key.
+func (ins *volumeInstance) OffloadBlock(ctx context.Context, id vanus.ID, key string) error {
+	if ins.srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	_, err := ins.srv.GetClient().OffloadBlock(ctx, &segpb.OffloadBlockRequest{
+		Id:  id.Uint64(),
+		Key: key,
+	})
+	return err
+}
+
+// GetBlockInfo returns the health info of block, and the replication status of its raft group if it's the leader.
+func (ins *volumeInstance) GetBlockInfo(ctx context.Context, id vanus.ID) (*segpb.GetBlockInfoResponse, error) {
+	if ins.srv == nil {
+		return nil, errors.ErrVolumeInstanceNoServer
+	}
+	return ins.srv.GetClient().GetBlockInfo(ctx, &segpb.GetBlockInfoRequest{Id: id.Uint64()})
+}
+
+// AddReplica adds the block replica located in endpoint to the raft group of leader block id.
+func (ins *volumeInstance) AddReplica(ctx context.Context, id vanus.ID, replica vanus.ID, endpoint string) error {
+	if ins.srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	_, err := ins.srv.GetClient().AddReplica(ctx, &segpb.AddReplicaRequest{
+		Id:        id.Uint64(),
+		ReplicaId: replica.Uint64(),
+		Endpoint:  endpoint,
+	})
+	return err
+}
+
+// RemoveReplica removes the block replica from the raft group of leader block id.
+func (ins *volumeInstance) RemoveReplica(ctx context.Context, id vanus.ID, replica vanus.ID) error {
+	if ins.srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	_, err := ins.srv.GetClient().RemoveReplica(ctx, &segpb.RemoveReplicaRequest{
+		Id:        id.Uint64(),
+		ReplicaId: replica.Uint64(),
+	})
+	return err
+}
+
+// TransferLeader transfers the leadership of raft group from leader block id to the block transferee.
+func (ins *volumeInstance) TransferLeader(ctx context.Context, id vanus.ID, transferee vanus.ID) error {
+	if ins.srv == nil {
+		return errors.ErrVolumeInstanceNoServer
+	}
+	_, err := ins.srv.GetClient().TransferLeader(ctx, &segpb.TransferLeaderRequest{
+		Id:           id.Uint64(),
+		TransfereeId: transferee.Uint64(),
+	})
+	return err
+}
+
+func (ins *volumeInstance) GetStatistics() Statistics {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	return ins.stat
+}
+
+func (ins *volumeInstance) UpdateStatistics(stat Statistics) {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	ins.stat = stat
+}
+
+func (ins *volumeInstance) IsDraining() bool {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	return ins.md.Draining
+}
+
+func (ins *volumeInstance) SetDraining(draining bool) {
+	ins.metaMutex.Lock()
+	defer ins.metaMutex.Unlock()
+	ins.md.Draining = draining
+}
+
 func (ins *volumeInstance) ID() vanus.ID {
 	return ins.md.ID
 }
@@ -16,6 +16,8 @@ package server
 
 import (
 	stdCtx "context"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -91,19 +93,73 @@ func TestVolumeInstance(t *testing.T) {
 		So(md.Used, ShouldEqual, 96*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldEqual, block)
 		So(md.Blocks[block2.ID.Uint64()], ShouldEqual, block2)
+		stat := ins.GetStatistics()
+		So(stat.Capacity, ShouldEqual, 32*1024*1024*1024)
+		So(stat.Used, ShouldEqual, 96*1024*1024)
+		So(stat.BlockNum, ShouldEqual, 2)
+
+		ins.UpdateStatistics(Statistics{
+			Capacity:  32 * 1024 * 1024 * 1024,
+			Used:      128 * 1024 * 1024,
+			BlockNum:  3,
+			LeaderNum: 1,
+			Labels:    map[string]string{"zone": "a"},
+		})
+		stat = ins.GetStatistics()
+		So(stat.Used, ShouldEqual, 128*1024*1024)
+		So(stat.LeaderNum, ShouldEqual, 1)
+		So(stat.Labels["zone"], ShouldEqual, "a")
 
 		f2 := func(ctx stdCtx.Context, in *segpb.RemoveBlockRequest,
 			opts ...grpc.CallOption,
 		) (*emptypb.Empty, error) {
 			So(in.Id, ShouldEqual, block.ID.Uint64())
+			So(in.DeleteObject, ShouldBeTrue)
 			return &emptypb.Empty{}, nil
 		}
 		segCli.EXPECT().RemoveBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f2)
 
-		err = ins.DeleteBlock(ctx, block.ID)
+		err = ins.DeleteBlock(ctx, block.ID, true)
 		So(err, ShouldBeNil)
 		So(md.Used, ShouldEqual, 64*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldBeNil)
 		So(md.Blocks[block2.ID.Uint64()], ShouldEqual, block2)
+
+		// the key hashes are more than a message can hold, they are sent in batches.
+		deadline := time.Now()
+		newerKeys := make([][]byte, segpb.CompactKeyHashBatchSize+1)
+		for i := range newerKeys {
+			newerKeys[i] = []byte(fmt.Sprintf("%016d", i))
+		}
+		stream := segpb.NewMockSegmentServer_CompactBlockClient(ctrl)
+		segCli.EXPECT().CompactBlock(gomock.Any(), gomock.Any()).Times(1).Return(stream, nil)
+		var reqs []*segpb.CompactBlockRequest
+		stream.EXPECT().Send(gomock.Any()).Times(2).DoAndReturn(func(req *segpb.CompactBlockRequest) error {
+			reqs = append(reqs, req)
+			return nil
+		})
+		stream.EXPECT().CloseSend().Times(1).Return(nil)
+		stream.EXPECT().Recv().Times(1).Return(&segpb.CompactBlockResponse{
+			KeyHashes: [][]byte{[]byte("b")},
+		}, nil)
+		stream.EXPECT().Recv().Times(1).Return(&segpb.CompactBlockResponse{
+			Size: 1024, KeyHashes: [][]byte{[]byte("c")},
+		}, nil)
+		stream.EXPECT().Recv().Times(1).Return(nil, io.EOF)
+		size, keys, err := ins.CompactBlock(ctx, block2.ID, "subject", deadline, newerKeys)
+		So(err, ShouldBeNil)
+		So(size, ShouldEqual, 1024)
+		So(keys, ShouldResemble, [][]byte{[]byte("b"), []byte("c")})
+		So(reqs[0].Id, ShouldEqual, block2.ID.Uint64())
+		So(reqs[0].KeyAttribute, ShouldEqual, "subject")
+		So(reqs[0].TombstoneDeadline, ShouldEqual, deadline.UnixMilli())
+		So(reqs[0].NewerKeyHashes, ShouldResemble, newerKeys[:segpb.CompactKeyHashBatchSize])
+		So(reqs[1].NewerKeyHashes, ShouldResemble, newerKeys[segpb.CompactKeyHashBatchSize:])
+
+		segCli.EXPECT().OffloadBlock(ctx, &segpb.OffloadBlockRequest{
+			Id:  block2.ID.Uint64(),
+			Key: "segments/1",
+		}).Times(1).Return(&emptypb.Empty{}, nil)
+		So(ins.OffloadBlock(ctx, block2.ID, "segments/1"), ShouldBeNil)
 	})
 }
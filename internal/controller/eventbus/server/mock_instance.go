@@ -7,10 +7,12 @@ package server
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	metadata "github.com/vanus-labs/vanus/internal/controller/eventbus/metadata"
 	vanus "github.com/vanus-labs/vanus/internal/primitive/vanus"
+	segment "github.com/vanus-labs/vanus/proto/pkg/segment"
 )
 
 // MockInstance is a mock of Instance interface.
@@ -36,6 +38,20 @@ func (m *MockInstance) EXPECT() *MockInstanceMockRecorder {
 	return m.recorder
 }
 
+// AddReplica mocks base method.
+func (m *MockInstance) AddReplica(ctx context.Context, id, replica vanus.ID, endpoint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReplica", ctx, id, replica, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReplica indicates an expected call of AddReplica.
+func (mr *MockInstanceMockRecorder) AddReplica(ctx, id, replica, endpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockInstance)(nil).AddReplica), ctx, id, replica, endpoint)
+}
+
 // Address mocks base method.
 func (m *MockInstance) Address() string {
 	m.ctrl.T.Helper()
@@ -64,6 +80,22 @@ func (mr *MockInstanceMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockInstance)(nil).Close))
 }
 
+// CompactBlock mocks base method.
+func (m *MockInstance) CompactBlock(ctx context.Context, id vanus.ID, keyAttribute string, tombstoneDeadline time.Time, newerKeyHashes [][]byte) (int64, [][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactBlock", ctx, id, keyAttribute, tombstoneDeadline, newerKeyHashes)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompactBlock indicates an expected call of CompactBlock.
+func (mr *MockInstanceMockRecorder) CompactBlock(ctx, id, keyAttribute, tombstoneDeadline, newerKeyHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlock", reflect.TypeOf((*MockInstance)(nil).CompactBlock), ctx, id, keyAttribute, tombstoneDeadline, newerKeyHashes)
+}
+
 // CreateBlock mocks base method.
 func (m *MockInstance) CreateBlock(arg0 context.Context, arg1 int64) (*metadata.Block, error) {
 	m.ctrl.T.Helper()
@@ -80,17 +112,32 @@ func (mr *MockInstanceMockRecorder) CreateBlock(arg0, arg1 interface{}) *gomock.
 }
 
 // DeleteBlock mocks base method.
-func (m *MockInstance) DeleteBlock(arg0 context.Context, arg1 vanus.ID) error {
+func (m *MockInstance) DeleteBlock(ctx context.Context, id vanus.ID, deleteObject bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBlock", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteBlock", ctx, id, deleteObject)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBlock indicates an expected call of DeleteBlock.
-func (mr *MockInstanceMockRecorder) DeleteBlock(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockInstanceMockRecorder) DeleteBlock(ctx, id, deleteObject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockInstance)(nil).DeleteBlock), ctx, id, deleteObject)
+}
+
+// GetBlockInfo mocks base method.
+func (m *MockInstance) GetBlockInfo(ctx context.Context, id vanus.ID) (*segment.GetBlockInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockInfo", ctx, id)
+	ret0, _ := ret[0].(*segment.GetBlockInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockInfo indicates an expected call of GetBlockInfo.
+func (mr *MockInstanceMockRecorder) GetBlockInfo(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockInstance)(nil).DeleteBlock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockInstance)(nil).GetBlockInfo), ctx, id)
 }
 
 // GetMeta mocks base method.
@@ -121,6 +168,20 @@ func (mr *MockInstanceMockRecorder) GetServer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockInstance)(nil).GetServer))
 }
 
+// GetStatistics mocks base method.
+func (m *MockInstance) GetStatistics() Statistics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics")
+	ret0, _ := ret[0].(Statistics)
+	return ret0
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockInstanceMockRecorder) GetStatistics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockInstance)(nil).GetStatistics))
+}
+
 // ID mocks base method.
 func (m *MockInstance) ID() vanus.ID {
 	m.ctrl.T.Helper()
@@ -135,6 +196,60 @@ func (mr *MockInstanceMockRecorder) ID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockInstance)(nil).ID))
 }
 
+// IsDraining mocks base method.
+func (m *MockInstance) IsDraining() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDraining")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDraining indicates an expected call of IsDraining.
+func (mr *MockInstanceMockRecorder) IsDraining() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDraining", reflect.TypeOf((*MockInstance)(nil).IsDraining))
+}
+
+// OffloadBlock mocks base method.
+func (m *MockInstance) OffloadBlock(ctx context.Context, id vanus.ID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadBlock", ctx, id, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffloadBlock indicates an expected call of OffloadBlock.
+func (mr *MockInstanceMockRecorder) OffloadBlock(ctx, id, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlock", reflect.TypeOf((*MockInstance)(nil).OffloadBlock), ctx, id, key)
+}
+
+// RemoveReplica mocks base method.
+func (m *MockInstance) RemoveReplica(ctx context.Context, id, replica vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReplica", ctx, id, replica)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReplica indicates an expected call of RemoveReplica.
+func (mr *MockInstanceMockRecorder) RemoveReplica(ctx, id, replica interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplica", reflect.TypeOf((*MockInstance)(nil).RemoveReplica), ctx, id, replica)
+}
+
+// SetDraining mocks base method.
+func (m *MockInstance) SetDraining(draining bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDraining", draining)
+}
+
+// SetDraining indicates an expected call of SetDraining.
+func (mr *MockInstanceMockRecorder) SetDraining(draining interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDraining", reflect.TypeOf((*MockInstance)(nil).SetDraining), draining)
+}
+
 // SetServer mocks base method.
 func (m *MockInstance) SetServer(arg0 Server) {
 	m.ctrl.T.Helper()
@@ -146,3 +261,29 @@ func (mr *MockInstanceMockRecorder) SetServer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServer", reflect.TypeOf((*MockInstance)(nil).SetServer), arg0)
 }
+
+// TransferLeader mocks base method.
+func (m *MockInstance) TransferLeader(ctx context.Context, id, transferee vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", ctx, id, transferee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockInstanceMockRecorder) TransferLeader(ctx, id, transferee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockInstance)(nil).TransferLeader), ctx, id, transferee)
+}
+
+// UpdateStatistics mocks base method.
+func (m *MockInstance) UpdateStatistics(stat Statistics) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateStatistics", stat)
+}
+
+// UpdateStatistics indicates an expected call of UpdateStatistics.
+func (mr *MockInstanceMockRecorder) UpdateStatistics(stat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatistics", reflect.TypeOf((*MockInstance)(nil).UpdateStatistics), stat)
+}
@@ -38,6 +38,7 @@ type Manager interface {
 	GetVolumeInstanceByID(id vanus.ID) server.Instance
 	LookupVolumeByServerID(id vanus.ID) server.Instance
 	GetBlocksOfVolume(ctx context.Context, instance server.Instance) (map[uint64]*metadata.Block, error)
+	Decommission(ctx context.Context, ins server.Instance) error
 }
 
 var mgr = &volumeMgr{}
@@ -223,6 +224,28 @@ func (mgr *volumeMgr) UpdateRouting(ctx context.Context, ins server.Instance, sr
 	mgr.volInstanceMap.Store(ins.ID().Key(), ins)
 }
 
+// Decommission marks the volume as draining persistently, so no new block is placed in it.
+func (mgr *volumeMgr) Decommission(ctx context.Context, ins server.Instance) error {
+	if ins.IsDraining() {
+		return nil
+	}
+	md := ins.GetMeta()
+	data, _ := json.Marshal(&metadata.VolumeMetadata{
+		ID:       md.ID,
+		Capacity: md.Capacity,
+		Draining: true,
+	})
+	key := filepath.Join(metadata.VolumeKeyPrefixInKVStore, md.ID.String())
+	if err := mgr.kvCli.Set(ctx, key, data); err != nil {
+		return err
+	}
+	ins.SetDraining(true)
+	log.Info(ctx, "the volume is marked as draining", map[string]interface{}{
+		"volume_id": md.ID,
+	})
+	return nil
+}
+
 func (mgr *volumeMgr) GetBlocksOfVolume(ctx context.Context,
 	instance server.Instance,
 ) (map[uint64]*metadata.Block, error) {
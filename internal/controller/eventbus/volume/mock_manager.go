@@ -38,6 +38,20 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// Decommission mocks base method.
+func (m *MockManager) Decommission(ctx context.Context, ins server.Instance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decommission", ctx, ins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decommission indicates an expected call of Decommission.
+func (mr *MockManagerMockRecorder) Decommission(ctx, ins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decommission", reflect.TypeOf((*MockManager)(nil).Decommission), ctx, ins)
+}
+
 // GetAllActiveVolumes mocks base method.
 func (m *MockManager) GetAllActiveVolumes() []server.Instance {
 	m.ctrl.T.Helper()
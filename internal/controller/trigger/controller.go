@@ -52,6 +52,7 @@ var _ ctrlpb.TriggerControllerServer = &controller{}
 
 const (
 	defaultGcSubscriptionInterval = time.Second * 10
+	defaultPullLeaseTimeout       = time.Second * 30
 )
 
 func NewController(config Config, mem member.Member) *controller {
@@ -59,6 +60,7 @@ func NewController(config Config, mem member.Member) *controller {
 		config:                config,
 		member:                mem,
 		needCleanSubscription: map[vanus.ID]string{},
+		pullLeases:            map[vanus.ID]pullLease{},
 		state:                 primitive.ServerStateCreated,
 		cl:                    cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
 		ebClient:              eb.Connect(config.ControllerAddr),
@@ -84,6 +86,15 @@ type controller struct {
 	state                 primitive.ServerState
 	cl                    cluster.Cluster
 	ebClient              eb.Client
+	// pullLeases are kept in memory, the gateways renew them on the new leader.
+	pullLeases     map[vanus.ID]pullLease
+	pullLeaseMutex sync.Mutex
+}
+
+// pullLease pins a disabled subscription to the gateway which pulls it.
+type pullLease struct {
+	holder   string
+	expireAt time.Time
 }
 
 func (ctrl *controller) SetDeadLetterEventOffset(ctx context.Context,
@@ -179,13 +190,23 @@ func (ctrl *controller) CreateSubscription(ctx context.Context,
 		})
 		return nil, err
 	}
-	// subscription name can't be repeated in an eventbus
-	eventbusID := vanus.NewIDFromUint64(request.Subscription.EventbusId)
-	_sub := ctrl.subscriptionManager.GetSubscriptionByName(ctx, eventbusID, request.Subscription.Name)
+	if request.Subscription.Namespace == "" {
+		request.Subscription.Namespace = primitive.DefaultNamespace
+	}
+	// subscription name can't be repeated in a namespace
+	_sub := ctrl.subscriptionManager.GetSubscriptionByName(ctx,
+		request.Subscription.Namespace, request.Subscription.Name)
 	if _sub != nil {
 		return nil, errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("subscription name %s has exist", request.Subscription.Name))
 	}
+	ns, err := ctrl.checkSubscriptionNamespace(ctx, request.Subscription)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctrl.checkSubscriptionQuota(ctx, ns); err != nil {
+		return nil, err
+	}
 	sub := convert.FromPbSubscriptionRequest(request.Subscription)
 	sub.ID, err = vanus.NewID()
 	sub.CreatedAt = time.Now()
@@ -209,6 +230,53 @@ func (ctrl *controller) CreateSubscription(ctx context.Context,
 	return resp, nil
 }
 
+// checkSubscriptionNamespace returns the namespace of subscription, it must exist and be the same as the
+// namespace of eventbus.
+func (ctrl *controller) checkSubscriptionNamespace(
+	ctx context.Context, request *ctrlpb.SubscriptionRequest,
+) (*metapb.Namespace, error) {
+	ns, err := ctrl.cl.EventbusService().GetNamespace(ctx, request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	bus, err := ctrl.cl.EventbusService().GetEventbus(ctx, request.EventbusId)
+	if err != nil {
+		return nil, err
+	}
+	busNamespace := bus.Namespace
+	if busNamespace == "" {
+		busNamespace = primitive.DefaultNamespace
+	}
+	if busNamespace != request.Namespace {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf(
+			"the namespace %s of subscription isn't the namespace %s of eventbus", request.Namespace, busNamespace))
+	}
+	return ns, nil
+}
+
+// checkSubscriptionQuota returns ErrQuotaExceeded if the number of subscriptions in the namespace
+// reaches the quota.
+func (ctrl *controller) checkSubscriptionQuota(ctx context.Context, ns *metapb.Namespace) error {
+	namespace := ns.Name
+	max := ns.GetQuota().GetMaxSubscriptions()
+	if max == 0 {
+		return nil
+	}
+	var num int32
+	for _, sub := range ctrl.subscriptionManager.ListSubscription(ctx) {
+		if sub.Namespace == namespace {
+			num++
+		}
+	}
+	if num < max {
+		return nil
+	}
+	metrics.NamespaceQuotaExceededCounterVec.WithLabelValues(namespace, primitive.QuotaSubscriptions).Inc()
+	return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+		"the number of subscriptions in namespace %s reached the quota %s=%d",
+		namespace, primitive.QuotaSubscriptions, max))
+}
+
 func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	request *ctrlpb.UpdateSubscriptionRequest,
 ) (*metapb.Subscription, error) {
@@ -230,9 +298,12 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	if request.Subscription.EventbusId != uint64(sub.EventbusID) {
 		return nil, errors.ErrInvalidRequest.WithMessage("can not change eventbus")
 	}
+	if request.Subscription.Namespace != "" && request.Subscription.Namespace != sub.Namespace {
+		return nil, errors.ErrInvalidRequest.WithMessage("can not change namespace")
+	}
 	if request.Subscription.Name != sub.Name {
-		// subscription name can't be repeated in an eventbus
-		_sub := ctrl.subscriptionManager.GetSubscriptionByName(ctx, sub.EventbusID, request.Subscription.Name)
+		// subscription name can't be repeated in a namespace
+		_sub := ctrl.subscriptionManager.GetSubscriptionByName(ctx, sub.Namespace, request.Subscription.Name)
 		if _sub != nil {
 			return nil, errors.ErrInvalidRequest.WithMessage(
 				fmt.Sprintf("subscription name %s has exist", request.Subscription.Name))
@@ -328,6 +399,9 @@ func (ctrl *controller) ResumeSubscription(ctx context.Context,
 	if sub.Phase != metadata.SubscriptionPhaseStopped {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is not disable")
 	}
+	if ctrl.isPulled(subID, time.Now()) {
+		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is being pulled from gateway")
+	}
 	sub.Phase = metadata.SubscriptionPhasePending
 	err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub)
 	if err != nil {
@@ -337,6 +411,54 @@ func (ctrl *controller) ResumeSubscription(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+// AcquirePullLease acquires or renews the lease of pulling the disabled subscription, it fails if the lease is
+// held by another gateway.
+func (ctrl *controller) AcquirePullLease(ctx context.Context,
+	request *ctrlpb.AcquirePullLeaseRequest,
+) (*emptypb.Empty, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if request.Holder == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("holder is empty")
+	}
+	subID := vanus.ID(request.SubscriptionId)
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
+	if sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage(
+			fmt.Sprintf("subscrption %d not exist", subID))
+	}
+	// the enabled subscription is dispatched to trigger workers, which commit offset too.
+	if sub.Phase != metadata.SubscriptionPhaseStopped {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the subscription must be disabled before pulling events")
+	}
+	timeout := defaultPullLeaseTimeout
+	if request.LeaseTimeoutMs > 0 {
+		timeout = time.Duration(request.LeaseTimeoutMs) * time.Millisecond
+	}
+	now := time.Now()
+	ctrl.pullLeaseMutex.Lock()
+	defer ctrl.pullLeaseMutex.Unlock()
+	if l, ok := ctrl.pullLeases[subID]; ok && l.holder != request.Holder && now.Before(l.expireAt) {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			fmt.Sprintf("the subscription is being pulled by gateway %s", l.holder))
+	}
+	ctrl.pullLeases[subID] = pullLease{holder: request.Holder, expireAt: now.Add(timeout)}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) isPulled(id vanus.ID, now time.Time) bool {
+	ctrl.pullLeaseMutex.Lock()
+	defer ctrl.pullLeaseMutex.Unlock()
+	l, ok := ctrl.pullLeases[id]
+	if ok && !now.Before(l.expireAt) {
+		delete(ctrl.pullLeases, id)
+		return false
+	}
+	return ok
+}
+
 func (ctrl *controller) GetSubscription(ctx context.Context,
 	request *ctrlpb.GetSubscriptionRequest,
 ) (*metapb.Subscription, error) {
@@ -394,7 +516,8 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(ctx context.Context,
 	now := time.Now()
 	for _, subInfo := range req.SubscriptionInfo {
 		subscriptionID := vanus.ID(subInfo.SubscriptionId)
-		err := ctrl.subscriptionManager.Heartbeat(ctx, subscriptionID, req.Address, now)
+		err := ctrl.subscriptionManager.Heartbeat(ctx, subscriptionID, req.Address,
+			convert.FromPbCircuitBreakerState(subInfo.CircuitBreakerState), now)
 		if err != nil {
 			log.Warning(ctx, "heartbeat subscription heartbeat error", map[string]interface{}{
 				log.KeyError:             err,
@@ -463,6 +586,9 @@ func (ctrl *controller) ListSubscription(ctx context.Context,
 		if request.EventbusId != 0 && request.EventbusId != sub.EventbusID.Uint64() {
 			continue
 		}
+		if request.Namespace != "" && request.Namespace != sub.Namespace {
+			continue
+		}
 		if request.Name != "" && !strings.Contains(sub.Name, request.Name) {
 			continue
 		}
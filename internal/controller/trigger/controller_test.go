@@ -24,6 +24,8 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/vanus-labs/vanus/pkg/cluster"
+	"github.com/vanus-labs/vanus/pkg/errors"
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 
@@ -123,9 +125,17 @@ func TestController_CreateSubscription(t *testing.T) {
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
 		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		cl := cluster.NewMockCluster(mockCtrl)
+		ctrl.cl = cl
+		ebSvc := cluster.NewMockEventbusService(mockCtrl)
+		cl.EXPECT().EventbusService().AnyTimes().Return(ebSvc)
 
 		ctrl.state = primitive.ServerStateRunning
 		Convey("create subscription", func() {
+			ebSvc.EXPECT().GetNamespace(gomock.Any(), primitive.DefaultNamespace).AnyTimes().
+				Return(&metapb.Namespace{Name: primitive.DefaultNamespace}, nil)
+			ebSvc.EXPECT().GetEventbus(gomock.Any(), gomock.Any()).AnyTimes().
+				Return(&metapb.Eventbus{Namespace: primitive.DefaultNamespace}, nil)
 			subManager.EXPECT().GetSubscriptionByName(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 			subManager.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 			create := &ctrlpb.CreateSubscriptionRequest{
@@ -147,6 +157,54 @@ func TestController_CreateSubscription(t *testing.T) {
 			So(resp2.EventbusId, ShouldEqual, request.EventbusId)
 			So(resp.Id, ShouldNotEqual, resp2.Id)
 		})
+
+		Convey("create subscription exceeds quota", func() {
+			ebSvc.EXPECT().GetNamespace(gomock.Any(), "test").AnyTimes().Return(&metapb.Namespace{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxSubscriptions: 1},
+			}, nil)
+			ebSvc.EXPECT().GetEventbus(gomock.Any(), gomock.Any()).AnyTimes().
+				Return(&metapb.Eventbus{Namespace: "test"}, nil)
+			subManager.EXPECT().GetSubscriptionByName(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			subManager.EXPECT().ListSubscription(gomock.Any()).AnyTimes().Return([]*metadata.Subscription{
+				{ID: vanus.NewTestID(), Namespace: "test"},
+				{ID: vanus.NewTestID(), Namespace: primitive.DefaultNamespace},
+			})
+			_, err := ctrl.CreateSubscription(ctx, &ctrlpb.CreateSubscriptionRequest{
+				Subscription: &ctrlpb.SubscriptionRequest{
+					EventbusId: vanus.NewTestID().Uint64(),
+					Name:       "test-name",
+					Sink:       "test-sink",
+					Namespace:  "test",
+				},
+			})
+			So(errors.Is(err, errors.ErrQuotaExceeded), ShouldBeTrue)
+		})
+
+		Convey("create subscription in other namespace", func() {
+			subManager.EXPECT().GetSubscriptionByName(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			create := &ctrlpb.CreateSubscriptionRequest{
+				Subscription: &ctrlpb.SubscriptionRequest{
+					EventbusId: vanus.NewTestID().Uint64(),
+					Name:       "test-name",
+					Sink:       "test-sink",
+					Namespace:  "test",
+				},
+			}
+			Convey("the namespace doesn't exist", func() {
+				ebSvc.EXPECT().GetNamespace(gomock.Any(), "test").Return(nil,
+					errors.ErrResourceNotFound.WithMessage("the namespace doesn't exist"))
+				_, err := ctrl.CreateSubscription(ctx, create)
+				So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			})
+			Convey("the namespace isn't the namespace of eventbus", func() {
+				ebSvc.EXPECT().GetNamespace(gomock.Any(), "test").Return(&metapb.Namespace{Name: "test"}, nil)
+				ebSvc.EXPECT().GetEventbus(gomock.Any(), create.Subscription.EventbusId).
+					Return(&metapb.Eventbus{}, nil)
+				_, err := ctrl.CreateSubscription(ctx, create)
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			})
+		})
 	})
 }
 
@@ -439,6 +497,79 @@ func TestController_GetSubscription(t *testing.T) {
 			So(resp.EventbusId, ShouldEqual, sub.EventbusID.Uint64())
 			So(resp.Id, ShouldEqual, sub.ID)
 		})
+		Convey("get subscription with sink credential", func() {
+			credentialType := primitive.HTTP
+			sub := &metadata.Subscription{
+				ID:                 subID,
+				EventbusID:         vanus.NewTestID(),
+				SinkCredentialType: &credentialType,
+				SinkCredential: &primitive.HTTPSinkCredential{
+					AuthType: primitive.HTTPAuthBearer,
+					Token:    "token",
+				},
+			}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			subManager.EXPECT().GetOffset(gomock.Any(), gomock.Any()).Return(info.ListOffsetInfo{}, nil)
+			resp, err := ctrl.GetSubscription(ctx, request)
+			So(err, ShouldBeNil)
+			credential := resp.SinkCredential.GetHttp()
+			So(credential.AuthType, ShouldEqual, metapb.HTTPCredential_BEARER)
+			So(credential.Token, ShouldEqual, primitive.SecretsMask)
+			So(credential.Username, ShouldBeEmpty)
+			So(credential.Password, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestController_AcquirePullLease(t *testing.T) {
+	Convey("test acquire pull lease", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil)
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		subID := vanus.NewTestID()
+		sub := &metadata.Subscription{
+			ID:    subID,
+			Phase: metadata.SubscriptionPhaseStopped,
+		}
+		subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).AnyTimes().Return(sub)
+		request := &ctrlpb.AcquirePullLeaseRequest{
+			SubscriptionId: subID.Uint64(),
+			Holder:         "gateway-1",
+		}
+		Convey("acquire lease of enabled subscription", func() {
+			sub.Phase = metadata.SubscriptionPhaseRunning
+			_, err := ctrl.AcquirePullLease(ctx, request)
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+		Convey("acquire lease held by another gateway", func() {
+			_, err := ctrl.AcquirePullLease(ctx, request)
+			So(err, ShouldBeNil)
+			_, err = ctrl.AcquirePullLease(ctx, request)
+			So(err, ShouldBeNil)
+			_, err = ctrl.AcquirePullLease(ctx, &ctrlpb.AcquirePullLeaseRequest{
+				SubscriptionId: subID.Uint64(),
+				Holder:         "gateway-2",
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			_, err = ctrl.ResumeSubscription(ctx, &ctrlpb.ResumeSubscriptionRequest{Id: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+		Convey("acquire expired lease", func() {
+			request.LeaseTimeoutMs = 1
+			_, err := ctrl.AcquirePullLease(ctx, request)
+			So(err, ShouldBeNil)
+			time.Sleep(5 * time.Millisecond)
+			_, err = ctrl.AcquirePullLease(ctx, &ctrlpb.AcquirePullLeaseRequest{
+				SubscriptionId: subID.Uint64(),
+				Holder:         "gateway-2",
+			})
+			So(err, ShouldBeNil)
+		})
 	})
 }
 
@@ -508,9 +639,11 @@ func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 			SubscriptionInfo: []*metapb.SubscriptionInfo{sub1, sub2, sub3},
 		}
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID1), request.Address,
-			gomock.Any()).AnyTimes().Return(fmt.Errorf("error"))
-		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID2), request.Address, gomock.Any()).AnyTimes().Return(nil)
-		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID3), request.Address, gomock.Any()).AnyTimes().Return(nil)
+			gomock.Any(), gomock.Any()).AnyTimes().Return(fmt.Errorf("error"))
+		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID2), request.Address,
+			gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID3), request.Address,
+			gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 		Convey("heartbeat error", func() {
 			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(),
 				gomock.Eq(request.Address)).Return(fmt.Errorf("error"))
@@ -78,6 +78,7 @@ type Subscription struct {
 	EventbusID         vanus.ID                        `json:"eventbus_id"`
 	Transformer        *primitive.Transformer          `json:"transformer,omitempty"`
 	Name               string                          `json:"name"`
+	Namespace          string                          `json:"namespace"`
 	Description        string                          `json:"description"`
 	CreatedAt          time.Time                       `json:"created_at"`
 	UpdatedAt          time.Time                       `json:"updated_at"`
@@ -89,6 +90,8 @@ type Subscription struct {
 	Phase                SubscriptionPhase `json:"phase"`
 	TriggerWorker        string            `json:"trigger_worker,omitempty"`
 	HeartbeatTime        time.Time         `json:"-"`
+	// reported by trigger worker
+	CircuitBreakerState primitive.CircuitBreakerState `json:"-"`
 }
 
 // Update property change from api .
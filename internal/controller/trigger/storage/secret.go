@@ -97,6 +97,15 @@ func (p *SecretStorage) Read(ctx context.Context,
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
 		return primitive.NewPlainSinkCredential(identifier, secret), nil
+	case primitive.HTTP:
+		credential := &primitive.HTTPSinkCredential{}
+		if err = json.Unmarshal(v, credential); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		if err = p.cryptHTTPCredential(credential, crypto.AESDecrypt); err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		return credential, nil
 	}
 	return nil, errors.ErrInvalidRequest.WithMessage("unknown credential type")
 }
@@ -133,6 +142,13 @@ func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential pr
 			return errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewPlainSinkCredential(identifier, s)
+	case primitive.HTTP:
+		http, _ := credential.(*primitive.HTTPSinkCredential)
+		encrypted := *http
+		if err := p.cryptHTTPCredential(&encrypted, crypto.AESEncrypt); err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		save = &encrypted
 	default:
 		return errors.ErrInvalidRequest.WithMessage("unknown credential type")
 	}
@@ -145,6 +161,26 @@ func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential pr
 	return p.client.Set(ctx, key, v)
 }
 
+// cryptHTTPCredential encrypts or decrypts the non-empty fields of the credential except auth type.
+func (p *SecretStorage) cryptHTTPCredential(credential *primitive.HTTPSinkCredential,
+	fn func(value, key string) (string, error),
+) error {
+	for _, field := range []*string{
+		&credential.Username, &credential.Password, &credential.Token, &credential.HMACSecret,
+		&credential.ClientCert, &credential.ClientKey, &credential.CACert,
+	} {
+		if *field == "" {
+			continue
+		}
+		v, err := fn(*field, p.cipherKey)
+		if err != nil {
+			return err
+		}
+		*field = v
+	}
+	return nil
+}
+
 func (p *SecretStorage) Delete(ctx context.Context, subID vanus.ID) error {
 	key := p.getKey(subID)
 	return p.client.Delete(ctx, key)
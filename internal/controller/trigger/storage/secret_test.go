@@ -100,6 +100,30 @@ func TestSecretStorage(t *testing.T) {
 				So(err, ShouldBeNil)
 			})
 		})
+		Convey("test credential type http", func() {
+			subID := vanus.NewTestID()
+			credential := &primitive.HTTPSinkCredential{
+				AuthType: primitive.HTTPAuthBasic,
+				Username: "test_username",
+				Password: "test_password",
+				CACert:   "test_ca_cert",
+			}
+			var saved []byte
+			kvClient.EXPECT().Set(ctx, secret.getKey(subID), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, v []byte) error {
+					saved = v
+					return nil
+				})
+			err := secret.Write(ctx, subID, credential)
+			So(err, ShouldBeNil)
+			So(string(saved), ShouldNotContainSubstring, "test_password")
+			So(credential.Password, ShouldEqual, "test_password")
+
+			kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(saved, nil)
+			read, err := secret.Read(ctx, subID, primitive.HTTP)
+			So(err, ShouldBeNil)
+			So(read, ShouldResemble, credential)
+		})
 		Convey("test delete", func() {
 			subID := vanus.NewTestID()
 			kvClient.EXPECT().Delete(ctx, secret.getKey(subID)).Return(nil)
@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	metadata "github.com/vanus-labs/vanus/internal/controller/trigger/metadata"
+	primitive "github.com/vanus-labs/vanus/internal/primitive"
 	info "github.com/vanus-labs/vanus/internal/primitive/info"
 	vanus "github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
@@ -126,31 +127,31 @@ func (mr *MockManagerMockRecorder) GetSubscription(ctx, id interface{}) *gomock.
 }
 
 // GetSubscriptionByName mocks base method.
-func (m *MockManager) GetSubscriptionByName(ctx context.Context, eventbusID vanus.ID, name string) *metadata.Subscription {
+func (m *MockManager) GetSubscriptionByName(ctx context.Context, namespace, name string) *metadata.Subscription {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscriptionByName", ctx, eventbusID, name)
+	ret := m.ctrl.Call(m, "GetSubscriptionByName", ctx, namespace, name)
 	ret0, _ := ret[0].(*metadata.Subscription)
 	return ret0
 }
 
 // GetSubscriptionByName indicates an expected call of GetSubscriptionByName.
-func (mr *MockManagerMockRecorder) GetSubscriptionByName(ctx, eventbusID, name interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) GetSubscriptionByName(ctx, namespace, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByName", reflect.TypeOf((*MockManager)(nil).GetSubscriptionByName), ctx, eventbusID, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByName", reflect.TypeOf((*MockManager)(nil).GetSubscriptionByName), ctx, namespace, name)
 }
 
 // Heartbeat mocks base method.
-func (m *MockManager) Heartbeat(ctx context.Context, id vanus.ID, addr string, time time.Time) error {
+func (m *MockManager) Heartbeat(ctx context.Context, id vanus.ID, addr string, state primitive.CircuitBreakerState, time time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Heartbeat", ctx, id, addr, time)
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, id, addr, state, time)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Heartbeat indicates an expected call of Heartbeat.
-func (mr *MockManagerMockRecorder) Heartbeat(ctx, id, addr, time interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) Heartbeat(ctx, id, addr, state, time interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockManager)(nil).Heartbeat), ctx, id, addr, time)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockManager)(nil).Heartbeat), ctx, id, addr, state, time)
 }
 
 // Init mocks base method.
@@ -48,10 +48,10 @@ type Manager interface {
 	ResetOffsetByTimestamp(ctx context.Context, id vanus.ID, timestamp uint64) (info.ListOffsetInfo, error)
 	ListSubscription(ctx context.Context) []*metadata.Subscription
 	GetSubscription(ctx context.Context, id vanus.ID) *metadata.Subscription
-	GetSubscriptionByName(ctx context.Context, eventbusID vanus.ID, name string) *metadata.Subscription
+	GetSubscriptionByName(ctx context.Context, namespace, name string) *metadata.Subscription
 	AddSubscription(ctx context.Context, subscription *metadata.Subscription) error
 	UpdateSubscription(ctx context.Context, subscription *metadata.Subscription) error
-	Heartbeat(ctx context.Context, id vanus.ID, addr string, time time.Time) error
+	Heartbeat(ctx context.Context, id vanus.ID, addr string, state primitive.CircuitBreakerState, time time.Time) error
 	DeleteSubscription(ctx context.Context, id vanus.ID) error
 	Init(ctx context.Context) error
 	Start()
@@ -104,14 +104,11 @@ func (m *manager) ListSubscription(_ context.Context) []*metadata.Subscription {
 	return list
 }
 
-func (m *manager) GetSubscriptionByName(ctx context.Context, eventbusID vanus.ID, name string) *metadata.Subscription {
+func (m *manager) GetSubscriptionByName(ctx context.Context, namespace, name string) *metadata.Subscription {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 	for _, sub := range m.subscriptionMap {
-		if sub.EventbusID != eventbusID {
-			continue
-		}
-		if sub.Name == name {
+		if sub.Namespace == namespace && sub.Name == name {
 			return sub
 		}
 	}
@@ -267,7 +264,9 @@ func (m *manager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 	return nil
 }
 
-func (m *manager) Heartbeat(ctx context.Context, id vanus.ID, addr string, time time.Time) error {
+func (m *manager) Heartbeat(ctx context.Context, id vanus.ID, addr string,
+	state primitive.CircuitBreakerState, time time.Time,
+) error {
 	subscription := m.GetSubscription(ctx, id)
 	if subscription == nil {
 		return errors.ErrResourceNotFound
@@ -281,6 +280,7 @@ func (m *manager) Heartbeat(ctx context.Context, id vanus.ID, addr string, time
 		})
 	}
 	subscription.HeartbeatTime = time
+	subscription.CircuitBreakerState = state
 	return nil
 }
 
@@ -305,6 +305,10 @@ func (m *manager) Init(ctx context.Context) error {
 			}
 			sub.SinkCredential = credential
 		}
+		if sub.Namespace == "" {
+			// the subscription created before namespace was introduced
+			sub.Namespace = primitive.DefaultNamespace
+		}
 		m.subscriptionMap[sub.ID] = sub
 		metrics.SubscriptionGauge.WithLabelValues(sub.EventbusID.Key()).Inc()
 		if sub.Transformer.Exist() {
@@ -81,7 +81,7 @@ func TestGetListSubscription(t *testing.T) {
 			So(subscription, ShouldBeNil)
 		})
 		Convey("heartbeat subscription no exist", func() {
-			err := m.Heartbeat(ctx, id, "addr", time.Now())
+			err := m.Heartbeat(ctx, id, "addr", primitive.CircuitBreakerClosed, time.Now())
 			So(err, ShouldNotBeNil)
 		})
 		storage.MockSubscriptionStorage.EXPECT().ListSubscription(ctx).Return([]*metadata.Subscription{
@@ -98,7 +98,7 @@ func TestGetListSubscription(t *testing.T) {
 			So(subscription, ShouldNotBeNil)
 		})
 		Convey("heartbeat", func() {
-			err := m.Heartbeat(ctx, id, "addr", time.Now())
+			err := m.Heartbeat(ctx, id, "addr", primitive.CircuitBreakerClosed, time.Now())
 			So(err, ShouldBeNil)
 		})
 	})
@@ -16,6 +16,8 @@ package validation
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/url"
 
@@ -69,7 +71,7 @@ func validateProtocol(ctx context.Context, protocol metapb.Protocol) error {
 	case metapb.Protocol_AWS_LAMBDA:
 	case metapb.Protocol_GCLOUD_FUNCTIONS:
 	case metapb.Protocol_GRPC:
-
+	case metapb.Protocol_KAFKA:
 	default:
 		return errors.ErrInvalidRequest.WithMessage("protocol is invalid")
 	}
@@ -105,6 +107,28 @@ func ValidateSinkAndProtocol(ctx context.Context,
 				WithMessage("protocol is http, sink is url,url parse error").Wrap(err)
 		}
 	case metapb.Protocol_GRPC:
+	case metapb.Protocol_KAFKA:
+		if _, err := primitive.ParseKafkaSink(sink); err != nil {
+			return errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+	}
+	if credential.GetCredentialType() == metapb.SinkCredential_HTTP {
+		switch protocol {
+		case metapb.Protocol_HTTP:
+		case metapb.Protocol_GRPC:
+			if credential.GetHttp().GetAuthType() == metapb.HTTPCredential_HMAC {
+				return errors.ErrInvalidRequest.WithMessage("protocol is grpc, hmac signing isn't supported")
+			}
+		case metapb.Protocol_KAFKA:
+			switch credential.GetHttp().GetAuthType() {
+			case metapb.HTTPCredential_NONE, metapb.HTTPCredential_BASIC:
+			default:
+				return errors.ErrInvalidRequest.WithMessage("protocol is kafka, only basic auth is supported as SASL/PLAIN")
+			}
+		default:
+			return errors.ErrInvalidRequest.WithMessage(
+				"sink credential type http is only for protocol http, grpc and kafka")
+		}
 	}
 	return nil
 }
@@ -139,12 +163,50 @@ func validateSinkCredential(ctx context.Context, sink string, credential *metapb
 			return errors.ErrInvalidRequest.
 				WithMessage("gcloud credential json invalid").Wrap(err)
 		}
+	case metapb.SinkCredential_HTTP:
+		return validateHTTPCredential(credential.GetHttp())
 	default:
 		return errors.ErrInvalidRequest.WithMessage("sink credential type is invalid")
 	}
 	return nil
 }
 
+func validateHTTPCredential(credential *metapb.HTTPCredential) error {
+	switch credential.GetAuthType() {
+	case metapb.HTTPCredential_NONE:
+	case metapb.HTTPCredential_BASIC:
+		if credential.GetUsername() == "" {
+			return errors.ErrInvalidRequest.WithMessage("http auth type is basic, username can not be empty")
+		}
+	case metapb.HTTPCredential_BEARER:
+		if credential.GetToken() == "" {
+			return errors.ErrInvalidRequest.WithMessage("http auth type is bearer, token can not be empty")
+		}
+	case metapb.HTTPCredential_HMAC:
+		if credential.GetHmacSecret() == "" {
+			return errors.ErrInvalidRequest.WithMessage("http auth type is hmac, hmac secret can not be empty")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("http auth type is invalid")
+	}
+	cert, key := credential.GetClientCert(), credential.GetClientKey()
+	if (cert == "") != (key == "") {
+		return errors.ErrInvalidRequest.WithMessage("client cert and client key must be set together")
+	}
+	// the masked values are filled with the stored ones when updating.
+	if cert != "" && cert != primitive.SecretsMask && key != primitive.SecretsMask {
+		if _, err := tls.X509KeyPair([]byte(cert), []byte(key)); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("client cert or client key is invalid").Wrap(err)
+		}
+	}
+	if ca := credential.GetCaCert(); ca != "" && ca != primitive.SecretsMask {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(ca)) {
+			return errors.ErrInvalidRequest.WithMessage("ca cert is invalid")
+		}
+	}
+	return nil
+}
+
 func validateSubscriptionConfig(ctx context.Context, cfg *metapb.SubscriptionConfig) error {
 	if cfg == nil {
 		return nil
@@ -163,6 +225,40 @@ func validateSubscriptionConfig(ctx context.Context, cfg *metapb.SubscriptionCon
 		return errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("could not set max retry attempts greater than %d", primitive.MaxRetryAttempts))
 	}
+	if err := validateRetryPolicy(ctx, cfg.RetryPolicy); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateRetryPolicy(ctx context.Context, policy *metapb.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch policy.BackoffType {
+	case metapb.RetryPolicy_DEFAULT:
+		return nil
+	case metapb.RetryPolicy_FIXED, metapb.RetryPolicy_LINEAR:
+		if policy.Multiplier < 0 {
+			return errors.ErrInvalidRequest.WithMessage("retry policy multiplier can not be negative")
+		}
+	case metapb.RetryPolicy_EXPONENTIAL:
+		if policy.Multiplier < 1 {
+			return errors.ErrInvalidRequest.WithMessage(
+				"retry policy is exponential, multiplier can not be less than 1")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("retry policy backoff type is invalid")
+	}
+	if policy.InitialDelay == 0 {
+		return errors.ErrInvalidRequest.WithMessage("retry policy initial delay can not be 0")
+	}
+	if policy.MaxDelay > 0 && policy.MaxDelay < policy.InitialDelay {
+		return errors.ErrInvalidRequest.WithMessage("retry policy max delay can not be less than initial delay")
+	}
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		return errors.ErrInvalidRequest.WithMessage("retry policy jitter must be in [0, 1]")
+	}
 	return nil
 }
 
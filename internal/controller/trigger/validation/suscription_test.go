@@ -21,6 +21,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/vanus-labs/vanus/internal/primitive"
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
@@ -66,6 +67,34 @@ func TestValidateSubscriptionConfig(t *testing.T) {
 			}
 			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
 		})
+		Convey("test retry policy", func() {
+			config := &metapb.SubscriptionConfig{
+				RetryPolicy: &metapb.RetryPolicy{
+					BackoffType:  metapb.RetryPolicy_EXPONENTIAL,
+					InitialDelay: 1000,
+					Multiplier:   2,
+					MaxDelay:     60000,
+					Jitter:       0.1,
+				},
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.RetryPolicy.Multiplier = 0.5
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.Multiplier = 2
+			config.RetryPolicy.Jitter = 2
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.Jitter = 0
+			config.RetryPolicy.MaxDelay = 10
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.BackoffType = metapb.RetryPolicy_FIXED
+			config.RetryPolicy.InitialDelay = 0
+			config.RetryPolicy.MaxDelay = 0
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.RetryPolicy.InitialDelay = 1000
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.RetryPolicy.BackoffType = metapb.RetryPolicy_BackoffType(99)
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
 	})
 }
 
@@ -147,6 +176,18 @@ func TestValidateSinkAndProtocol(t *testing.T) {
 			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_GCLOUD_FUNCTIONS, credential), ShouldBeNil)
 		})
 	})
+	Convey("subscription protocol is kafka", t, func() {
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "http://localhost:9092/topic", metapb.Protocol_KAFKA, nil), ShouldNotBeNil)
+			So(ValidateSinkAndProtocol(ctx, "kafka://localhost:9092", metapb.Protocol_KAFKA, nil), ShouldNotBeNil)
+			So(ValidateSinkAndProtocol(ctx, "kafka://localhost:9092/topic?mode=invalid",
+				metapb.Protocol_KAFKA, nil), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, "kafka://localhost:9092,localhost:9093/topic?key=subject&mode=structured",
+				metapb.Protocol_KAFKA, nil), ShouldBeNil)
+		})
+	})
 }
 
 func TestValidateSinkCredential(t *testing.T) {
@@ -192,6 +233,62 @@ func TestValidateSinkCredential(t *testing.T) {
 			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
 		})
 	})
+	Convey("subscription sink credential type is http", t, func() {
+		sink := "https://example.com"
+		newCredential := func(http *metapb.HTTPCredential) *metapb.SinkCredential {
+			return &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_HTTP,
+				Credential:     &metapb.SinkCredential_Http{Http: http},
+			}
+		}
+		Convey("basic without username", func() {
+			credential := newCredential(&metapb.HTTPCredential{AuthType: metapb.HTTPCredential_BASIC})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("bearer without token", func() {
+			credential := newCredential(&metapb.HTTPCredential{AuthType: metapb.HTTPCredential_BEARER})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("hmac without secret", func() {
+			credential := newCredential(&metapb.HTTPCredential{AuthType: metapb.HTTPCredential_HMAC})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("client cert without key", func() {
+			credential := newCredential(&metapb.HTTPCredential{ClientCert: "cert"})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("invalid client cert", func() {
+			credential := newCredential(&metapb.HTTPCredential{ClientCert: "cert", ClientKey: "key"})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("invalid ca cert", func() {
+			credential := newCredential(&metapb.HTTPCredential{CaCert: "ca"})
+			So(validateSinkCredential(ctx, sink, credential), ShouldNotBeNil)
+		})
+		Convey("masked values", func() {
+			credential := newCredential(&metapb.HTTPCredential{
+				AuthType:   metapb.HTTPCredential_BEARER,
+				Token:      primitive.SecretsMask,
+				ClientCert: primitive.SecretsMask,
+				ClientKey:  primitive.SecretsMask,
+				CaCert:     primitive.SecretsMask,
+			})
+			So(validateSinkCredential(ctx, sink, credential), ShouldBeNil)
+		})
+		Convey("only for http, grpc and kafka protocol", func() {
+			credential := newCredential(&metapb.HTTPCredential{AuthType: metapb.HTTPCredential_BEARER, Token: "token"})
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_HTTP, credential), ShouldBeNil)
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_GRPC, credential), ShouldBeNil)
+			credential.GetHttp().AuthType = metapb.HTTPCredential_HMAC
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_GRPC, credential), ShouldNotBeNil)
+			kafkaSink := "kafka://localhost:9092/topic"
+			So(ValidateSinkAndProtocol(ctx, kafkaSink, metapb.Protocol_KAFKA, credential), ShouldNotBeNil)
+			credential.GetHttp().AuthType = metapb.HTTPCredential_BASIC
+			So(ValidateSinkAndProtocol(ctx, kafkaSink, metapb.Protocol_KAFKA, credential), ShouldBeNil)
+			So(ValidateSinkAndProtocol(ctx, "arn:aws:lambda:us-west-2:843378899134:function:xdltest",
+				metapb.Protocol_AWS_LAMBDA, credential), ShouldNotBeNil)
+		})
+	})
 }
 
 func TestValidateFilter(t *testing.T) {
@@ -41,6 +41,7 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) *metadata.Subscrip
 		Transformer:        fromPbTransformer(sub.Transformer),
 		EventbusID:         vanus.NewIDFromUint64(sub.EventbusId),
 		Name:               sub.Name,
+		Namespace:          sub.Namespace,
 		Description:        sub.Description,
 	}
 	return to
@@ -57,6 +58,8 @@ func fromPbProtocol(from pb.Protocol) primitive.Protocol {
 		to = primitive.GCloudFunctions
 	case pb.Protocol_GRPC:
 		to = primitive.GRPC
+	case pb.Protocol_KAFKA:
+		to = primitive.KafkaProtocol
 	}
 	return to
 }
@@ -72,10 +75,32 @@ func toPbProtocol(from primitive.Protocol) pb.Protocol {
 		to = pb.Protocol_GCLOUD_FUNCTIONS
 	case primitive.GRPC:
 		to = pb.Protocol_GRPC
+	case primitive.KafkaProtocol:
+		to = pb.Protocol_KAFKA
 	}
 	return to
 }
 
+func ToPbCircuitBreakerState(from primitive.CircuitBreakerState) pb.CircuitBreakerState {
+	switch from {
+	case primitive.CircuitBreakerOpen:
+		return pb.CircuitBreakerState_OPEN
+	case primitive.CircuitBreakerHalfOpen:
+		return pb.CircuitBreakerState_HALF_OPEN
+	}
+	return pb.CircuitBreakerState_CLOSED
+}
+
+func FromPbCircuitBreakerState(from pb.CircuitBreakerState) primitive.CircuitBreakerState {
+	switch from {
+	case pb.CircuitBreakerState_OPEN:
+		return primitive.CircuitBreakerOpen
+	case pb.CircuitBreakerState_HALF_OPEN:
+		return primitive.CircuitBreakerHalfOpen
+	}
+	return primitive.CircuitBreakerClosed
+}
+
 func fromPbProtocolSettings(from *pb.ProtocolSetting) *primitive.ProtocolSetting {
 	if from == nil {
 		return nil
@@ -110,6 +135,8 @@ func fromPbSinkCredentialType(from *pb.SinkCredential) *primitive.CredentialType
 		to = primitive.GCloud
 	case pb.SinkCredential_PLAIN:
 		to = primitive.Plain
+	case pb.SinkCredential_HTTP:
+		to = primitive.HTTP
 	}
 	return &to
 }
@@ -130,43 +157,77 @@ func fromPbSinkCredential(from *pb.SinkCredential) primitive.SinkCredential {
 	case pb.SinkCredential_PLAIN:
 		plain := from.GetPlain()
 		return primitive.NewPlainSinkCredential(plain.GetIdentifier(), plain.GetSecret())
+	case pb.SinkCredential_HTTP:
+		http := from.GetHttp()
+		return &primitive.HTTPSinkCredential{
+			AuthType:   fromPbHTTPAuthType(http.GetAuthType()),
+			Username:   http.GetUsername(),
+			Password:   http.GetPassword(),
+			Token:      http.GetToken(),
+			HMACSecret: http.GetHmacSecret(),
+			ClientCert: http.GetClientCert(),
+			ClientKey:  http.GetClientKey(),
+			CACert:     http.GetCaCert(),
+		}
 	}
 	return nil
 }
 
-func toPbSinkCredentialByType(credentialType *primitive.CredentialType) *pb.SinkCredential {
-	if credentialType == nil {
-		return nil
-	}
-	to := &pb.SinkCredential{}
-	switch *credentialType {
-	case primitive.AWS:
-		to.CredentialType = pb.SinkCredential_AWS
-		to.Credential = &pb.SinkCredential_Aws{
-			Aws: &pb.AKSKCredential{
-				AccessKeyId:     primitive.SecretsMask,
-				SecretAccessKey: primitive.SecretsMask,
-			},
-		}
-	case primitive.GCloud:
-		to.CredentialType = pb.SinkCredential_GCLOUD
-		to.Credential = &pb.SinkCredential_Gcloud{
-			Gcloud: &pb.GCloudCredential{
-				CredentialsJson: primitive.SecretsMask,
-			},
-		}
-	case primitive.Plain:
-		to.CredentialType = pb.SinkCredential_PLAIN
-		to.Credential = &pb.SinkCredential_Plain{
-			Plain: &pb.PlainCredential{
-				Identifier: primitive.SecretsMask,
-				Secret:     primitive.SecretsMask,
-			},
-		}
+func fromPbHTTPAuthType(from pb.HTTPCredential_AuthType) primitive.HTTPAuthType {
+	switch from {
+	case pb.HTTPCredential_BASIC:
+		return primitive.HTTPAuthBasic
+	case pb.HTTPCredential_BEARER:
+		return primitive.HTTPAuthBearer
+	case pb.HTTPCredential_HMAC:
+		return primitive.HTTPAuthHMAC
+	}
+	return primitive.HTTPAuthNone
+}
+
+func toPbHTTPAuthType(from primitive.HTTPAuthType) pb.HTTPCredential_AuthType {
+	switch from {
+	case primitive.HTTPAuthBasic:
+		return pb.HTTPCredential_BASIC
+	case primitive.HTTPAuthBearer:
+		return pb.HTTPCredential_BEARER
+	case primitive.HTTPAuthHMAC:
+		return pb.HTTPCredential_HMAC
+	}
+	return pb.HTTPCredential_NONE
+}
+
+// toPbMaskedSinkCredential returns the sink credential whose non-empty secrets are masked.
+func toPbMaskedSinkCredential(from primitive.SinkCredential) *pb.SinkCredential {
+	to := toPbSinkCredential(from)
+	switch credential := to.GetCredential().(type) {
+	case *pb.SinkCredential_Aws:
+		maskSecret(&credential.Aws.AccessKeyId)
+		maskSecret(&credential.Aws.SecretAccessKey)
+	case *pb.SinkCredential_Gcloud:
+		maskSecret(&credential.Gcloud.CredentialsJson)
+	case *pb.SinkCredential_Plain:
+		maskSecret(&credential.Plain.Identifier)
+		maskSecret(&credential.Plain.Secret)
+	case *pb.SinkCredential_Http:
+		maskSecret(&credential.Http.Username)
+		maskSecret(&credential.Http.Password)
+		maskSecret(&credential.Http.Token)
+		maskSecret(&credential.Http.HmacSecret)
+		maskSecret(&credential.Http.ClientCert)
+		maskSecret(&credential.Http.ClientKey)
+		maskSecret(&credential.Http.CaCert)
 	}
 	return to
 }
 
+// maskSecret masks the secret unless it's empty, so that the empty ones aren't taken as set.
+func maskSecret(secret *string) {
+	if *secret != "" {
+		*secret = primitive.SecretsMask
+	}
+}
+
 func toPbSinkCredential(from primitive.SinkCredential) *pb.SinkCredential {
 	if from == nil {
 		return nil
@@ -199,6 +260,21 @@ func toPbSinkCredential(from primitive.SinkCredential) *pb.SinkCredential {
 				Secret:     credential.Secret,
 			},
 		}
+	case primitive.HTTP:
+		credential, _ := from.(*primitive.HTTPSinkCredential)
+		to.CredentialType = pb.SinkCredential_HTTP
+		to.Credential = &pb.SinkCredential_Http{
+			Http: &pb.HTTPCredential{
+				AuthType:   toPbHTTPAuthType(credential.AuthType),
+				Username:   credential.Username,
+				Password:   credential.Password,
+				Token:      credential.Token,
+				HmacSecret: credential.HMACSecret,
+				ClientCert: credential.ClientCert,
+				ClientKey:  credential.ClientKey,
+				CaCert:     credential.CACert,
+			},
+		}
 	}
 	return to
 }
@@ -213,6 +289,8 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 		DeliveryTimeout:   config.DeliveryTimeout,
 		DisableDeadLetter: config.DisableDeadLetter,
 		OrderedEvent:      config.OrderedEvent,
+		OrderedKey:        config.OrderedKey,
+		RetryPolicy:       fromPbRetryPolicy(config.RetryPolicy),
 	}
 	switch config.OffsetType {
 	case pb.SubscriptionConfig_LATEST:
@@ -233,6 +311,8 @@ func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.Subscriptio
 		DeliveryTimeout:   config.DeliveryTimeout,
 		DisableDeadLetter: config.DisableDeadLetter,
 		OrderedEvent:      config.OrderedEvent,
+		OrderedKey:        config.OrderedKey,
+		RetryPolicy:       ToPbRetryPolicy(config.RetryPolicy),
 	}
 	switch config.OffsetType {
 	case primitive.LatestOffset:
@@ -246,6 +326,56 @@ func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.Subscriptio
 	return to
 }
 
+func fromPbRetryPolicy(policy *pb.RetryPolicy) *primitive.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	to := &primitive.RetryPolicy{
+		InitialDelay:      policy.InitialDelay,
+		Multiplier:        policy.Multiplier,
+		MaxDelay:          policy.MaxDelay,
+		Jitter:            policy.Jitter,
+		RetriableCodes:    policy.RetriableCodes,
+		NonRetriableCodes: policy.NonRetriableCodes,
+	}
+	switch policy.BackoffType {
+	case pb.RetryPolicy_DEFAULT:
+		to.BackoffType = primitive.DefaultBackoff
+	case pb.RetryPolicy_FIXED:
+		to.BackoffType = primitive.FixedBackoff
+	case pb.RetryPolicy_LINEAR:
+		to.BackoffType = primitive.LinearBackoff
+	case pb.RetryPolicy_EXPONENTIAL:
+		to.BackoffType = primitive.ExponentialBackoff
+	}
+	return to
+}
+
+func ToPbRetryPolicy(policy *primitive.RetryPolicy) *pb.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	to := &pb.RetryPolicy{
+		InitialDelay:      policy.InitialDelay,
+		Multiplier:        policy.Multiplier,
+		MaxDelay:          policy.MaxDelay,
+		Jitter:            policy.Jitter,
+		RetriableCodes:    policy.RetriableCodes,
+		NonRetriableCodes: policy.NonRetriableCodes,
+	}
+	switch policy.BackoffType {
+	case primitive.DefaultBackoff:
+		to.BackoffType = pb.RetryPolicy_DEFAULT
+	case primitive.FixedBackoff:
+		to.BackoffType = pb.RetryPolicy_FIXED
+	case primitive.LinearBackoff:
+		to.BackoffType = pb.RetryPolicy_LINEAR
+	case primitive.ExponentialBackoff:
+		to.BackoffType = pb.RetryPolicy_EXPONENTIAL
+	}
+	return to
+}
+
 func FromPbAddSubscription(sub *pbtrigger.AddSubscriptionRequest) *primitive.Subscription {
 	to := &primitive.Subscription{
 		ID:                   vanus.ID(sub.Id),
@@ -291,7 +421,7 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Types:            sub.Types,
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Sink:             string(sub.Sink),
-		SinkCredential:   toPbSinkCredentialByType(sub.SinkCredentialType),
+		SinkCredential:   toPbMaskedSinkCredential(sub.SinkCredential),
 		Protocol:         toPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
 		EventbusId:       sub.EventbusID.Uint64(),
@@ -299,9 +429,12 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Transformer:      ToPbTransformer(sub.Transformer),
 		Offsets:          ToPbOffsetInfos(offsets),
 		Name:             sub.Name,
+		Namespace:        sub.Namespace,
 		Description:      sub.Description,
 		CreatedAt:        sub.CreatedAt.UnixMilli(),
 		UpdatedAt:        sub.UpdatedAt.UnixMilli(),
+
+		CircuitBreakerState: ToPbCircuitBreakerState(sub.CircuitBreakerState),
 	}
 	if sub.Phase == metadata.SubscriptionPhaseStopped {
 		to.Disable = true
@@ -0,0 +1,270 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	stderr "errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	headerAPIKey          = "X-Api-Key"
+	headerGitHubSignature = "X-Hub-Signature-256"
+	headerStripeSignature = "Stripe-Signature"
+
+	bearerPrefix    = "Bearer "
+	githubPrefix    = "sha256="
+	stripeTimestamp = "t"
+	stripeSignature = "v1"
+
+	defaultSignatureTolerance = 5 * time.Minute
+	authCacheTTL              = 30 * time.Second
+)
+
+var (
+	// errMissingCredential means the request isn't authenticated, it's responded with 401.
+	errMissingCredential = stderr.New("missing credential")
+	// errInvalidCredential means the credential is wrong, it's responded with 403.
+	errInvalidCredential = stderr.New("invalid credential")
+	errSignatureExpired  = stderr.New("signature timestamp is out of tolerance")
+)
+
+type authFetcher func(ctx context.Context, id vanus.ID) (*metapb.EventbusAuth, error)
+
+type eventbusResolver func(ctx context.Context, reqData *cehttp.RequestData) (vanus.ID, error)
+
+type authCacheEntry struct {
+	auth     *metapb.EventbusAuth
+	expireAt time.Time
+}
+
+// authenticator verifies the requests to eventbus, the auth of eventbus is cached for a while,
+// so the change of auth takes effect in authCacheTTL. The body read for verifying signature is limited
+// by maxBodySize, since the requests aren't authenticated yet.
+type authenticator struct {
+	fetch       authFetcher
+	resolve     eventbusResolver
+	ttl         time.Duration
+	maxBodySize int64
+	cache       sync.Map
+}
+
+func newAuthenticator(fetch authFetcher, resolve eventbusResolver, maxBodySize int64) *authenticator {
+	return &authenticator{
+		fetch:       fetch,
+		resolve:     resolve,
+		ttl:         authCacheTTL,
+		maxBodySize: maxBodySize,
+	}
+}
+
+func (a *authenticator) get(ctx context.Context, id vanus.ID) (*metapb.EventbusAuth, error) {
+	if v, ok := a.cache.Load(id); ok {
+		entry, _ := v.(*authCacheEntry)
+		if time.Now().Before(entry.expireAt) {
+			return entry.auth, nil
+		}
+	}
+	auth, err := a.fetch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	a.cache.Store(id, &authCacheEntry{auth: auth, expireAt: time.Now().Add(a.ttl)})
+	return auth, nil
+}
+
+// middleware rejects the requests which aren't authenticated before they are parsed as CloudEvents.
+func (a *authenticator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		id, err := a.resolve(ctx, &cehttp.RequestData{URL: req.URL})
+		if stderr.Is(err, errInvalidEventbusPath) {
+			// the invalid path is rejected by receiver.
+			next.ServeHTTP(w, req)
+			return
+		}
+		var auth *metapb.EventbusAuth
+		if err == nil {
+			auth, err = a.get(ctx, id)
+		}
+		if err != nil {
+			if errors.Is(err, errors.ErrResourceNotFound) {
+				http.Error(w, "eventbus not found", http.StatusNotFound)
+				return
+			}
+			log.Warning(ctx, "failed to get eventbus auth", map[string]interface{}{
+				log.KeyError:      err,
+				log.KeyEventbusID: id,
+			})
+			http.Error(w, "failed to get eventbus auth", http.StatusInternalServerError)
+			return
+		}
+		if auth.GetType() == metapb.EventbusAuth_NONE {
+			next.ServeHTTP(w, req)
+			return
+		}
+		var body []byte
+		if req.Body != nil {
+			body, err = io.ReadAll(http.MaxBytesReader(w, req.Body, a.maxBodySize))
+			_ = req.Body.Close()
+			if isBodyTooLarge(err) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		if err = verifyRequest(auth, req.Header, body, time.Now()); err != nil {
+			code := http.StatusForbidden
+			if stderr.Is(err, errMissingCredential) {
+				code = http.StatusUnauthorized
+			}
+			metrics.GatewayAuthRejectedCounterVec.WithLabelValues(
+				id.Key(), strings.ToLower(auth.GetType().String()), strconv.Itoa(code)).Inc()
+			http.Error(w, err.Error(), code)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// verifyRequest returns errMissingCredential if the request doesn't carry credential, otherwise
+// errInvalidCredential or errSignatureExpired if the credential is wrong.
+func verifyRequest(auth *metapb.EventbusAuth, header http.Header, body []byte, now time.Time) error {
+	switch auth.GetType() {
+	case metapb.EventbusAuth_NONE:
+		return nil
+	case metapb.EventbusAuth_TOKEN:
+		return verifyToken(auth.Tokens, header)
+	case metapb.EventbusAuth_GITHUB:
+		return verifyGitHubSignature(auth.Secret, header.Get(headerGitHubSignature), body)
+	case metapb.EventbusAuth_STRIPE:
+		tolerance := defaultSignatureTolerance
+		if auth.Tolerance > 0 {
+			tolerance = time.Duration(auth.Tolerance) * time.Second
+		}
+		return verifyStripeSignature(auth.Secret, header.Get(headerStripeSignature), body, tolerance, now)
+	}
+	return errInvalidCredential
+}
+
+func verifyToken(tokens []string, header http.Header) error {
+	token := header.Get(headerAPIKey)
+	if token == "" {
+		authorization := header.Get("Authorization")
+		if len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+			token = authorization[len(bearerPrefix):]
+		}
+	}
+	if token == "" {
+		return errMissingCredential
+	}
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return nil
+		}
+	}
+	return errInvalidCredential
+}
+
+func verifyGitHubSignature(secret, signature string, body []byte) error {
+	if signature == "" {
+		return errMissingCredential
+	}
+	if !strings.HasPrefix(signature, githubPrefix) {
+		return errInvalidCredential
+	}
+	if !equalSignature(signature[len(githubPrefix):], computeSignature(secret, body)) {
+		return errInvalidCredential
+	}
+	return nil
+}
+
+// verifyStripeSignature verifies the header like "t=1492774577,v1=5257a869...,v1=...", any of v1 signatures
+// matches is accepted.
+func verifyStripeSignature(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	if header == "" {
+		return errMissingCredential
+	}
+	var timestamp string
+	var signatures []string
+	for _, pair := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case stripeTimestamp:
+			timestamp = kv[1]
+		case stripeSignature:
+			signatures = append(signatures, kv[1])
+		}
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return errInvalidCredential
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+		return errSignatureExpired
+	}
+	payload := make([]byte, 0, len(timestamp)+1+len(body))
+	payload = append(payload, timestamp...)
+	payload = append(payload, '.')
+	payload = append(payload, body...)
+	expected := computeSignature(secret, payload)
+	for _, signature := range signatures {
+		if equalSignature(signature, expected) {
+			return nil
+		}
+	}
+	return errInvalidCredential
+}
+
+func computeSignature(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func equalSignature(signature string, expected []byte) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(sig, expected)
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/pkg/errors"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	Convey("test verify request", t, func() {
+		now := time.Now()
+		body := []byte(`{"key":"value"}`)
+		Convey("none", func() {
+			So(verifyRequest(&metapb.EventbusAuth{}, http.Header{}, body, now), ShouldBeNil)
+		})
+		Convey("token", func() {
+			auth := &metapb.EventbusAuth{Type: metapb.EventbusAuth_TOKEN, Tokens: []string{"t1", "t2"}}
+			header := http.Header{}
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errMissingCredential)
+			header.Set("Authorization", "Bearer t2")
+			So(verifyRequest(auth, header, body, now), ShouldBeNil)
+			header.Set("Authorization", "Bearer t3")
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errInvalidCredential)
+			header = http.Header{}
+			header.Set(headerAPIKey, "t1")
+			So(verifyRequest(auth, header, body, now), ShouldBeNil)
+		})
+		Convey("github", func() {
+			auth := &metapb.EventbusAuth{Type: metapb.EventbusAuth_GITHUB, Secret: "secret"}
+			header := http.Header{}
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errMissingCredential)
+			header.Set(headerGitHubSignature, githubPrefix+hex.EncodeToString(computeSignature("secret", body)))
+			So(verifyRequest(auth, header, body, now), ShouldBeNil)
+			So(verifyRequest(auth, header, []byte("modified"), now), ShouldEqual, errInvalidCredential)
+			header.Set(headerGitHubSignature, hex.EncodeToString(computeSignature("secret", body)))
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errInvalidCredential)
+		})
+		Convey("stripe", func() {
+			auth := &metapb.EventbusAuth{Type: metapb.EventbusAuth_STRIPE, Secret: "secret"}
+			sign := func(ts int64) string {
+				payload := fmt.Sprintf("%d.%s", ts, body)
+				return fmt.Sprintf("t=%d,v1=%s,v1=invalid", ts,
+					hex.EncodeToString(computeSignature("secret", []byte(payload))))
+			}
+			header := http.Header{}
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errMissingCredential)
+			header.Set(headerStripeSignature, sign(now.Unix()))
+			So(verifyRequest(auth, header, body, now), ShouldBeNil)
+			So(verifyRequest(auth, header, []byte("modified"), now), ShouldEqual, errInvalidCredential)
+			header.Set(headerStripeSignature, sign(now.Add(-10*time.Minute).Unix()))
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errSignatureExpired)
+			auth.Tolerance = 3600
+			So(verifyRequest(auth, header, body, now), ShouldBeNil)
+			header.Set(headerStripeSignature, "v1=abc")
+			So(verifyRequest(auth, header, body, now), ShouldEqual, errInvalidCredential)
+		})
+	})
+}
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	Convey("test authenticator middleware", t, func() {
+		id := vanus.NewTestID()
+		fetched := 0
+		a := newAuthenticator(func(_ context.Context, eventbusID vanus.ID) (*metapb.EventbusAuth, error) {
+			fetched++
+			if eventbusID != id {
+				return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+			}
+			return &metapb.EventbusAuth{Type: metapb.EventbusAuth_TOKEN, Tokens: []string{"token"}}, nil
+		}, (&ceGateway{}).getEventbusFromPath, 8)
+		var received string
+		handler := a.middleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			data, _ := io.ReadAll(req.Body)
+			received = string(data)
+			w.WriteHeader(http.StatusOK)
+		}))
+		serve := func(path, token string, body ...string) int {
+			data := "body"
+			if len(body) > 0 {
+				data = body[0]
+			}
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(data))
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			return w.Code
+		}
+		path := fmt.Sprintf("%s/%s", httpRequestPrefix, id.String())
+
+		So(serve(path, ""), ShouldEqual, http.StatusUnauthorized)
+		So(serve(path, "invalid"), ShouldEqual, http.StatusForbidden)
+		So(serve(path, "token"), ShouldEqual, http.StatusOK)
+		So(received, ShouldEqual, "body")
+		So(fetched, ShouldEqual, 1)
+		So(serve(fmt.Sprintf("%s/%s", httpRequestPrefix, vanus.NewTestID().String()), "token"),
+			ShouldEqual, http.StatusNotFound)
+
+		// the body is limited before authentication.
+		received = ""
+		So(serve(path, "token", "large body"), ShouldEqual, http.StatusRequestEntityTooLarge)
+		So(received, ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	stderr "errors"
+	"io"
+	"mime"
+	"net/http"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	"github.com/vanus-labs/vanus/proto/pkg/codec"
+	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	contentTypeNDJSON  = "application/x-ndjson"
+	contentTypeNDJSON2 = "application/ndjson"
+	contentTypeJSON    = "application/json"
+)
+
+var (
+	errEmptyBatch    = stderr.New("the batch is empty")
+	errInvalidBatch  = stderr.New("the batch isn't a JSON array")
+	errTooManyEvents = stderr.New("too many events in the batch")
+)
+
+// isBatchContentType returns true if the request is CloudEvents batched content mode or NDJSON
+// that each line is a structured CloudEvent.
+func isBatchContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch mediaType {
+	case v2.ApplicationCloudEventsBatchJSON, contentTypeNDJSON, contentTypeNDJSON2:
+		return true
+	}
+	return false
+}
+
+// batchMiddleware publishes batched events by one Publish, the single event is passed to CloudEvents receiver.
+// The batch is rejected with 413 if the body or the number of events exceeds the limit.
+func (ga *ceGateway) batchMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || !isBatchContentType(req.Header.Get("Content-Type")) {
+			next.ServeHTTP(w, req)
+			return
+		}
+		eventbusID, err := ga.getEventbusFromPath(req.Context(), &cehttp.RequestData{URL: req.URL})
+		if err != nil {
+			http.Error(w, err.Error(), eventbusErrorCode(err))
+			return
+		}
+		req.Body = http.MaxBytesReader(w, req.Body, ga.config.GetMaxBodySize())
+		raws, err := splitBatch(req, ga.config.GetMaxBatchEvents())
+		if err != nil {
+			code := http.StatusBadRequest
+			if isBodyTooLarge(err) || stderr.Is(err, errTooManyEvents) {
+				code = http.StatusRequestEntityTooLarge
+			}
+			http.Error(w, err.Error(), code)
+			return
+		}
+		code, results := ga.publishBatch(req, eventbusID, raws)
+		data, _ := json.Marshal(results)
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.WriteHeader(code)
+		_, _ = w.Write(data)
+	})
+}
+
+// splitBatch returns the raw JSON of each event in the request, errTooManyEvents is returned once the number of
+// events exceeds maxEvents, so the rest of body isn't decoded.
+func splitBatch(req *http.Request, maxEvents int) ([]json.RawMessage, error) {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	var raws []json.RawMessage
+	if mediaType == v2.ApplicationCloudEventsBatchJSON {
+		dec := json.NewDecoder(req.Body)
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if token != json.Delim('[') {
+			return nil, errInvalidBatch
+		}
+		for dec.More() {
+			if len(raws) == maxEvents {
+				return nil, errTooManyEvents
+			}
+			var raw json.RawMessage
+			if err = dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			raws = append(raws, raw)
+		}
+		if _, err = dec.Token(); err != nil {
+			return nil, err
+		}
+	} else {
+		reader := bufio.NewReader(req.Body)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				if len(raws) == maxEvents {
+					return nil, errTooManyEvents
+				}
+				raws = append(raws, line)
+			}
+			if stderr.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(raws) == 0 {
+		return nil, errEmptyBatch
+	}
+	return raws, nil
+}
+
+// publishBatch returns the results in the same order of events, the response code is 200 if all events
+// are published, 207 if part of them are published, otherwise the code of failure.
+func (ga *ceGateway) publishBatch(
+	req *http.Request, eventbusID vanus.ID, raws []json.RawMessage,
+) (int, []*EventData) {
+	ctx := req.Context()
+	results := make([]*EventData, len(raws))
+	// the index of result for each published event
+	indexes := make([]int, 0, len(raws))
+	events := make([]*cloudevents.CloudEvent, 0, len(raws))
+	for idx, raw := range raws {
+		results[idx] = &EventData{}
+		event := v2.NewEvent()
+		if err := json.Unmarshal(raw, &event); err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		results[idx].ID = event.ID()
+		if err := event.Validate(); err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		e, err := codec.ToProto(&event)
+		if err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		indexes = append(indexes, idx)
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return http.StatusBadRequest, results
+	}
+
+	res, err := ga.proxySrv.Publish(ctx, &proxypb.PublishRequest{
+		Events:     &cloudevents.CloudEventBatch{Events: events},
+		EventbusId: eventbusID.Uint64(),
+	})
+	if err != nil {
+		log.Warning(ctx, "failed to publish batch", map[string]interface{}{
+			log.KeyError:      err,
+			log.KeyEventbusID: eventbusID,
+			"size":            len(events),
+		})
+		for _, idx := range indexes {
+			results[idx].Error = err.Error()
+		}
+		return resultCode(err), results
+	}
+	for i, idx := range indexes {
+		results[idx].BusID = eventbusID
+		if i < len(res.GetEventIds()) {
+			results[idx].EventID = res.GetEventIds()[i]
+		}
+	}
+	if len(indexes) < len(raws) {
+		return http.StatusMultiStatus, results
+	}
+	return http.StatusOK, results
+}
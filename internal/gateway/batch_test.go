@@ -0,0 +1,137 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/client"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestGateway_Batch(t *testing.T) {
+	Convey("test publish batch", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer(Any()).AnyTimes().Return(mockBusWriter)
+
+		ga := NewGateway(Config{ControllerAddr: []string{"127.0.0.1:2048"}, MaxBodySize: 1024, MaxBatchEvents: 3})
+		ga.proxySrv.SetClient(mockClient)
+		next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		})
+		handler := ga.batchMiddleware(next)
+		busID := vanus.NewTestID()
+		serve := func(contentType, body string) (int, []*EventData) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/gateway/%s", busID), strings.NewReader(body))
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			var results []*EventData
+			_ = json.Unmarshal(w.Body.Bytes(), &results)
+			return w.Code, results
+		}
+		event := func(id string) string {
+			return fmt.Sprintf(`{"specversion":"1.0","id":"%s","source":"source","type":"type","data":{"k":"v"}}`, id)
+		}
+
+		Convey("single event is passed to receiver", func() {
+			code, _ := serve(ce.ApplicationCloudEventsJSON, event("1"))
+			So(code, ShouldEqual, http.StatusAccepted)
+		})
+
+		Convey("batched content mode", func() {
+			mockBusWriter.EXPECT().Append(Any(), Any()).Times(1).DoAndReturn(
+				func(_ interface{}, events *cloudevents.CloudEventBatch, _ ...api.WriteOption) ([]string, error) {
+					So(events.Events, ShouldHaveLength, 2)
+					return []string{"AA", "BB"}, nil
+				})
+			code, results := serve(ce.ApplicationCloudEventsBatchJSON,
+				fmt.Sprintf("[%s,%s]", event("1"), event("2")))
+			So(code, ShouldEqual, http.StatusOK)
+			So(results, ShouldHaveLength, 2)
+			So(results[0].ID, ShouldEqual, "1")
+			So(results[0].EventID, ShouldEqual, "AA")
+			So(results[0].BusID, ShouldEqual, busID)
+			So(results[1].ID, ShouldEqual, "2")
+			So(results[1].EventID, ShouldEqual, "BB")
+		})
+
+		Convey("ndjson with invalid event", func() {
+			mockBusWriter.EXPECT().Append(Any(), Any()).Times(1).Return([]string{"AA", "CC"}, nil)
+			code, results := serve("application/x-ndjson; charset=utf-8",
+				event("1")+"\n"+`{"specversion":"1.0","id":"2"}`+"\n\n"+event("3")+"\n")
+			So(code, ShouldEqual, http.StatusMultiStatus)
+			So(results, ShouldHaveLength, 3)
+			So(results[0].EventID, ShouldEqual, "AA")
+			So(results[1].ID, ShouldEqual, "2")
+			So(results[1].Error, ShouldNotBeEmpty)
+			So(results[1].EventID, ShouldBeEmpty)
+			So(results[2].EventID, ShouldEqual, "CC")
+		})
+
+		Convey("publish failed", func() {
+			mockBusWriter.EXPECT().Append(Any(), Any()).Times(1).Return(nil, errors.New("test"))
+			code, results := serve(ce.ApplicationCloudEventsBatchJSON, fmt.Sprintf("[%s]", event("1")))
+			So(code, ShouldEqual, http.StatusInternalServerError)
+			So(results[0].Error, ShouldNotBeEmpty)
+		})
+
+		Convey("invalid batch", func() {
+			code, _ := serve(ce.ApplicationCloudEventsBatchJSON, "[]")
+			So(code, ShouldEqual, http.StatusBadRequest)
+			code, _ = serve(ce.ApplicationCloudEventsBatchJSON, "{")
+			So(code, ShouldEqual, http.StatusBadRequest)
+			code, results := serve(contentTypeNDJSON, `{"id":"1"}`)
+			So(code, ShouldEqual, http.StatusBadRequest)
+			So(results[0].Error, ShouldNotBeEmpty)
+		})
+
+		Convey("too large batch", func() {
+			many := make([]string, 4)
+			for i := range many {
+				many[i] = event(fmt.Sprintf("%d", i))
+			}
+			code, _ := serve(ce.ApplicationCloudEventsBatchJSON, fmt.Sprintf("[%s]", strings.Join(many, ",")))
+			So(code, ShouldEqual, http.StatusRequestEntityTooLarge)
+			code, _ = serve(contentTypeNDJSON, strings.Join(many, "\n"))
+			So(code, ShouldEqual, http.StatusRequestEntityTooLarge)
+
+			large := fmt.Sprintf(`{"specversion":"1.0","id":"1","source":"source","type":"type","data":"%s"}`,
+				strings.Repeat("a", 1024))
+			code, _ = serve(ce.ApplicationCloudEventsBatchJSON, fmt.Sprintf("[%s]", large))
+			So(code, ShouldEqual, http.StatusRequestEntityTooLarge)
+			code, _ = serve(contentTypeNDJSON, large)
+			So(code, ShouldEqual, http.StatusRequestEntityTooLarge)
+		})
+	})
+}
@@ -23,12 +23,26 @@ import (
 	"github.com/vanus-labs/vanus/internal/primitive"
 )
 
+const (
+	defaultMaxBodySize    = 16 * 1024 * 1024
+	defaultMaxBatchEvents = 1000
+)
+
 type Config struct {
 	Port                 int                  `yaml:"port"`
 	SinkPort             int                  `yaml:"sink_port"`
 	Observability        observability.Config `yaml:"observability"`
 	ControllerAddr       []string             `yaml:"controllers"`
 	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
+	// PartitionKey is the attribute of CloudEvent used to route events to eventlog,
+	// events with the same key are written into the same eventlog.
+	PartitionKey string `yaml:"partition_key"`
+	// MaxBodySize is the max size in bytes of request body received by the CloudEvents receiver, the request
+	// exceeding it is rejected with 413. The default is 16 MB.
+	MaxBodySize int64 `yaml:"max_body_size"`
+	// MaxBatchEvents is the max number of events in a batched request, the request exceeding it is rejected
+	// with 413. The default is 1000.
+	MaxBatchEvents int `yaml:"max_batch_events"`
 }
 
 func (c Config) GetProxyConfig() proxy.Config {
@@ -38,6 +52,7 @@ func (c Config) GetProxyConfig() proxy.Config {
 		ProxyPort:              c.Port,
 		CloudEventReceiverPort: c.GetCloudEventReceiverPort(),
 		GRPCReflectionEnable:   c.GRPCReflectionEnable,
+		PartitionKey:           c.PartitionKey,
 		Credentials:            insecure.NewCredentials(),
 	}
 }
@@ -46,6 +61,20 @@ func (c Config) GetCloudEventReceiverPort() int {
 	return c.Port + 1
 }
 
+func (c Config) GetMaxBodySize() int64 {
+	if c.MaxBodySize <= 0 {
+		return defaultMaxBodySize
+	}
+	return c.MaxBodySize
+}
+
+func (c Config) GetMaxBatchEvents() int {
+	if c.MaxBatchEvents <= 0 {
+		return defaultMaxBatchEvents
+	}
+	return c.MaxBatchEvents
+}
+
 func InitConfig(filename string) (*Config, error) {
 	c := new(Config)
 	err := primitive.LoadConfig(filename, c)
@@ -16,7 +16,7 @@ package gateway
 
 import (
 	"context"
-	"errors"
+	stderr "errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -32,6 +32,7 @@ import (
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 	"github.com/vanus-labs/vanus/observability/log"
 	"github.com/vanus-labs/vanus/observability/tracing"
+	"github.com/vanus-labs/vanus/pkg/errors"
 	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
 	"github.com/vanus-labs/vanus/proto/pkg/codec"
 	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
@@ -41,11 +42,27 @@ const (
 	httpRequestPrefix = "/gateway"
 )
 
-var requestDataFromContext = cehttp.RequestDataFromContext
+var (
+	requestDataFromContext = cehttp.RequestDataFromContext
+	errInvalidEventbusPath = stderr.New("invalid eventbus path")
+)
+
+// isBodyTooLarge returns true if the error is returned by http.MaxBytesReader since the limit is exceeded, the
+// typed error http.MaxBytesError isn't available in go 1.18.
+func isBodyTooLarge(err error) bool {
+	return err != nil && strings.HasSuffix(err.Error(), "request body too large")
+}
 
+type eventbusNameResolver func(ctx context.Context, namespace, name string) (vanus.ID, error)
+
+// EventData is the result of each event published in batch.
 type EventData struct {
-	EventID string   `json:"event_id"`
-	BusID   vanus.ID `json:"eventbus_id"`
+	// ID is the id attribute of CloudEvent.
+	ID string `json:"id,omitempty"`
+	// EventID is generated by vanus, which is encoded with the offset of event.
+	EventID string   `json:"event_id,omitempty"`
+	BusID   vanus.ID `json:"eventbus_id,omitempty"`
+	Error   string   `json:"error,omitempty"`
 }
 
 type ceGateway struct {
@@ -54,14 +71,21 @@ type ceGateway struct {
 	proxySrv   *proxy.ControllerProxy
 	tracer     *tracing.Tracer
 	ceListener net.Listener
+	auth       *authenticator
+	// resolveName resolves the eventbus addressed by "/gateway/<namespace>/<eventbus-name>".
+	resolveName eventbusNameResolver
 }
 
 func NewGateway(config Config) *ceGateway {
-	return &ceGateway{
-		config:   config,
-		proxySrv: proxy.NewControllerProxy(config.GetProxyConfig()),
-		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+	proxySrv := proxy.NewControllerProxy(config.GetProxyConfig())
+	ga := &ceGateway{
+		config:      config,
+		proxySrv:    proxySrv,
+		tracer:      tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		resolveName: proxySrv.GetEventbusIDByName,
 	}
+	ga.auth = newAuthenticator(proxySrv.GetEventbusAuth, ga.getEventbusFromPath, config.GetMaxBodySize())
+	return ga
 }
 
 func (ga *ceGateway) Start(ctx context.Context) error {
@@ -89,7 +113,16 @@ func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
 		return err
 	}
 
-	c, err := client.NewHTTP(cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware())
+	// the middleware added later runs earlier, so the requests are authenticated before publishing batch.
+	opts := []cehttp.Option{
+		cehttp.WithListener(ls),
+		cehttp.WithRequestDataAtContextMiddleware(),
+		cehttp.WithMiddleware(ga.batchMiddleware),
+	}
+	if ga.auth != nil {
+		opts = append(opts, cehttp.WithMiddleware(ga.auth.middleware))
+	}
+	c, err := client.NewHTTP(opts...)
 	if err != nil {
 		return err
 	}
@@ -104,9 +137,9 @@ func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
 }
 
 func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (re *v2.Event, result protocol.Result) {
-	eventbusID, err := getEventbusFromPath(requestDataFromContext(ctx))
+	eventbusID, err := ga.getEventbusFromPath(ctx, requestDataFromContext(ctx))
 	if err != nil {
-		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+		return nil, v2.NewHTTPResult(eventbusErrorCode(err), err.Error())
 	}
 
 	e, err := codec.ToProto(&event)
@@ -122,22 +155,65 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (re *v2.Event,
 	})
 
 	if err != nil {
-		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+		return nil, v2.NewHTTPResult(resultCode(err), err.Error())
 	}
 
 	return re, v2.ResultACK
 }
 
-func getEventbusFromPath(reqData *cehttp.RequestData) (vanus.ID, error) {
-	// TODO validate
-	reqPathStr := reqData.URL.String()
-	if !strings.HasPrefix(reqPathStr, httpRequestPrefix) {
-		return vanus.EmptyID(), errors.New("invalid eventbus id")
-	}
-	id, err := vanus.NewIDFromString(strings.TrimLeft(reqPathStr[len(httpRequestPrefix):], "/"))
+// getEventbusFromPath returns the eventbus of path "/gateway/<eventbus-id>" or
+// "/gateway/<namespace>/<eventbus-name>".
+func (ga *ceGateway) getEventbusFromPath(ctx context.Context, reqData *cehttp.RequestData) (vanus.ID, error) {
+	namespace, name, err := parseEventbusPath(reqData)
 	if err != nil {
 		return vanus.EmptyID(), err
 	}
+	if namespace == "" {
+		id, err := vanus.NewIDFromString(name)
+		if err != nil {
+			return vanus.EmptyID(), fmt.Errorf("%w: %s", errInvalidEventbusPath, err.Error())
+		}
+		return id, nil
+	}
+	return ga.resolveName(ctx, namespace, name)
+}
+
+// parseEventbusPath returns the eventbus name with namespace, the namespace is empty if the eventbus
+// is addressed by id.
+func parseEventbusPath(reqData *cehttp.RequestData) (string, string, error) {
+	reqPathStr := strings.SplitN(reqData.URL.String(), "?", 2)[0]
+	if !strings.HasPrefix(reqPathStr, httpRequestPrefix+"/") {
+		return "", "", errInvalidEventbusPath
+	}
+	parts := strings.Split(strings.TrimSuffix(reqPathStr[len(httpRequestPrefix)+1:], "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+	return "", "", errInvalidEventbusPath
+}
 
-	return id, nil
+// eventbusErrorCode returns 400 if the path is invalid, 404 if the eventbus doesn't exist.
+func eventbusErrorCode(err error) int {
+	switch {
+	case stderr.Is(err, errInvalidEventbusPath):
+		return http.StatusBadRequest
+	case errors.Is(err, errors.ErrResourceNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// resultCode returns the code of HTTP result, or 500 if the error isn't a HTTP result.
+func resultCode(err error) int {
+	var httpResult *cehttp.Result
+	if protocol.ResultAs(err, &httpResult) {
+		return httpResult.StatusCode
+	}
+	if errors.Is(err, errors.ErrQuotaExceeded) {
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
 }
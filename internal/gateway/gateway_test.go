@@ -17,6 +17,7 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
@@ -29,6 +30,9 @@ import (
 
 	"github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
 	"github.com/vanus-labs/vanus/internal/primitive"
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
@@ -111,25 +115,51 @@ func TestGateway_receive(t *testing.T) {
 }
 
 func TestGateway_getEventbusFromPath(t *testing.T) {
-	Convey("test get eventbus from path return nil ", t, func() {
-		reqData := &cehttp.RequestData{
+	ctx := context.Background()
+	vid := vanus.NewTestID()
+	ga := &ceGateway{
+		resolveName: func(_ context.Context, namespace, name string) (vanus.ID, error) {
+			if namespace == "default" && name == "test" {
+				return vid, nil
+			}
+			return vanus.EmptyID(), errors.ErrResourceNotFound.WithMessage("eventbus not found")
+		},
+	}
+	newRequestData := func(path string) *cehttp.RequestData {
+		return &cehttp.RequestData{
 			URL: &url.URL{
-				Opaque: "/test",
+				Opaque: path,
 			},
 		}
-		_, err := getEventbusFromPath(reqData)
-		So(err.Error(), ShouldEqual, "invalid eventbus id")
+	}
+	Convey("test get eventbus from path return nil ", t, func() {
+		for _, path := range []string{"/test", "/gateway", "/gateway/", "/gateway/a/b/c", "/gateway//b"} {
+			_, err := ga.getEventbusFromPath(ctx, newRequestData(path))
+			So(err, ShouldEqual, errInvalidEventbusPath)
+			So(eventbusErrorCode(err), ShouldEqual, http.StatusBadRequest)
+		}
+		_, err := ga.getEventbusFromPath(ctx, newRequestData("/gateway/test"))
+		So(eventbusErrorCode(err), ShouldEqual, http.StatusBadRequest)
 	})
 	Convey("test get eventbus from path return path ", t, func() {
-		vid := vanus.NewTestID()
-		reqData := &cehttp.RequestData{
-			URL: &url.URL{
-				Opaque: fmt.Sprintf("/gateway/%s", vid),
-			},
-		}
-		id, err := getEventbusFromPath(reqData)
+		id, err := ga.getEventbusFromPath(ctx, newRequestData(fmt.Sprintf("/gateway/%s", vid)))
+		So(err, ShouldBeNil)
+		So(id, ShouldEqual, vid)
+	})
+	Convey("test get eventbus from path with name", t, func() {
+		id, err := ga.getEventbusFromPath(ctx, newRequestData("/gateway/default/test?key=value"))
 		So(err, ShouldBeNil)
 		So(id, ShouldEqual, vid)
+		_, err = ga.getEventbusFromPath(ctx, newRequestData("/gateway/default/unknown"))
+		So(eventbusErrorCode(err), ShouldEqual, http.StatusNotFound)
+	})
+}
+
+func TestGateway_resultCode(t *testing.T) {
+	Convey("test result code", t, func() {
+		So(resultCode(ce.NewHTTPResult(http.StatusBadRequest, "invalid")), ShouldEqual, http.StatusBadRequest)
+		So(resultCode(errors.ErrQuotaExceeded.WithMessage("exceeded")), ShouldEqual, http.StatusTooManyRequests)
+		So(resultCode(errors.ErrInternal), ShouldEqual, http.StatusInternalServerError)
 	})
 }
 
@@ -146,7 +176,7 @@ func TestGateway_EventID(t *testing.T) {
 	mockEventbus := api.NewMockEventbus(ctrl)
 	mockBusWriter := api.NewMockBusWriter(ctrl)
 	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
-	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+	mockEventbus.EXPECT().Writer(Any()).AnyTimes().Return(mockBusWriter)
 	mockBusWriter.EXPECT().Append(Any(), Any()).AnyTimes().Return([]string{"AABBCC"}, nil)
 
 	cfg := Config{
@@ -154,6 +184,9 @@ func TestGateway_EventID(t *testing.T) {
 		ControllerAddr: controllers,
 	}
 	ga := NewGateway(cfg)
+	ga.auth = newAuthenticator(func(_ context.Context, _ vanus.ID) (*metapb.EventbusAuth, error) {
+		return &metapb.EventbusAuth{}, nil
+	}, ga.getEventbusFromPath, cfg.GetMaxBodySize())
 
 	ga.proxySrv.SetClient(mockClient)
 	ctx, cancel := context.WithCancel(context.Background())
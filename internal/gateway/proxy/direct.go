@@ -16,15 +16,24 @@ package proxy
 
 import (
 	"context"
-	"errors"
+	stdtime "time"
 
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
 
-var errMethodNotImplemented = errors.New("the method hasn't implemented")
+// eventbusIDCacheTTL is how long the id of eventbus is cached, the eventbus may be deleted and recreated with the
+// same name from other gateways.
+const eventbusIDCacheTTL = 30 * stdtime.Second
+
+type cachedEventbusID struct {
+	id       vanus.ID
+	expireAt stdtime.Time
+}
 
 func (cp *ControllerProxy) CreateEventbus(
 	ctx context.Context, req *ctrlpb.CreateEventbusRequest,
@@ -35,7 +44,12 @@ func (cp *ControllerProxy) CreateEventbus(
 func (cp *ControllerProxy) DeleteEventbus(
 	ctx context.Context, id *wrapperspb.UInt64Value,
 ) (*emptypb.Empty, error) {
-	return cp.eventbusCtrl.DeleteEventbus(ctx, id)
+	res, err := cp.eventbusCtrl.DeleteEventbus(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cp.invalidateEventbus(vanus.NewIDFromUint64(id.GetValue()))
+	return res, nil
 }
 
 func (cp *ControllerProxy) GetEventbus(
@@ -55,10 +69,89 @@ func (cp *ControllerProxy) GetEventbusWithHumanFriendly(ctx context.Context,
 	return cp.eventbusCtrl.GetEventbusWithHumanFriendly(ctx, request)
 }
 
+func (cp *ControllerProxy) SetEventbusAuth(
+	ctx context.Context, req *ctrlpb.SetEventbusAuthRequest,
+) (*metapb.Eventbus, error) {
+	return cp.eventbusCtrl.SetEventbusAuth(ctx, req)
+}
+
+func (cp *ControllerProxy) CreateNamespace(
+	ctx context.Context, req *ctrlpb.CreateNamespaceRequest,
+) (*metapb.Namespace, error) {
+	return cp.eventbusCtrl.CreateNamespace(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteNamespace(
+	ctx context.Context, req *ctrlpb.DeleteNamespaceRequest,
+) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteNamespace(ctx, req)
+}
+
+func (cp *ControllerProxy) ListNamespace(
+	ctx context.Context, req *emptypb.Empty,
+) (*ctrlpb.ListNamespaceResponse, error) {
+	return cp.eventbusCtrl.ListNamespace(ctx, req)
+}
+
+func (cp *ControllerProxy) GetNamespace(
+	ctx context.Context, req *ctrlpb.GetNamespaceRequest,
+) (*metapb.Namespace, error) {
+	return cp.eventbusCtrl.GetNamespace(ctx, req)
+}
+
+func (cp *ControllerProxy) SetNamespaceQuota(
+	ctx context.Context, req *ctrlpb.SetNamespaceQuotaRequest,
+) (*metapb.Namespace, error) {
+	ns, err := cp.eventbusCtrl.SetNamespaceQuota(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cp.storeNamespaceQuota(ns)
+	return ns, nil
+}
+
+// GetEventbusAuth returns the auth of eventbus with secrets, it isn't exposed by proxy.
+func (cp *ControllerProxy) GetEventbusAuth(ctx context.Context, id vanus.ID) (*metapb.EventbusAuth, error) {
+	return cp.eventbusCtrl.GetEventbusAuth(ctx, wrapperspb.UInt64(id.Uint64()))
+}
+
+// GetEventbusIDByName returns the id of eventbus, the result is cached for eventbusIDCacheTTL, or until the
+// eventbus is deleted or not found when publishing.
+func (cp *ControllerProxy) GetEventbusIDByName(ctx context.Context, namespace, name string) (vanus.ID, error) {
+	key := namespace + "/" + name
+	if v, ok := cp.eventbusIDs.Load(key); ok {
+		if c, _ := v.(cachedEventbusID); stdtime.Now().Before(c.expireAt) {
+			return c.id, nil
+		}
+	}
+	eb, err := cp.eventbusCtrl.GetEventbusWithHumanFriendly(ctx, &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+		Namespace:    namespace,
+		EventbusName: name,
+	})
+	if err != nil {
+		return vanus.EmptyID(), err
+	}
+	id := vanus.NewIDFromUint64(eb.GetId())
+	cp.eventbusIDs.Store(key, cachedEventbusID{id: id, expireAt: stdtime.Now().Add(eventbusIDCacheTTL)})
+	return id, nil
+}
+
+// invalidateEventbus removes the caches of deleted eventbus.
+func (cp *ControllerProxy) invalidateEventbus(id vanus.ID) {
+	cp.writerMap.Delete(id)
+	cp.namespaces.Delete(id)
+	cp.eventbusIDs.Range(func(key, value interface{}) bool {
+		if value.(cachedEventbusID).id == id {
+			cp.eventbusIDs.Delete(key)
+		}
+		return true
+	})
+}
+
 func (cp *ControllerProxy) UpdateEventbus(
-	_ context.Context, _ *ctrlpb.UpdateEventbusRequest,
+	ctx context.Context, req *ctrlpb.UpdateEventbusRequest,
 ) (*metapb.Eventbus, error) {
-	return nil, errMethodNotImplemented
+	return cp.eventbusCtrl.UpdateEventbus(ctx, req)
 }
 
 func (cp *ControllerProxy) ListSegment(
@@ -67,6 +160,24 @@ func (cp *ControllerProxy) ListSegment(
 	return cp.eventlogCtrl.ListSegment(ctx, req)
 }
 
+func (cp *ControllerProxy) ListSegmentReplication(
+	ctx context.Context, req *emptypb.Empty,
+) (*ctrlpb.ListSegmentReplicationResponse, error) {
+	return cp.eventlogCtrl.ListSegmentReplication(ctx, req)
+}
+
+func (cp *ControllerProxy) RebalanceLeaders(
+	ctx context.Context, req *ctrlpb.RebalanceLeadersRequest,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	return cp.eventlogCtrl.RebalanceLeaders(ctx, req)
+}
+
+func (cp *ControllerProxy) DecommissionVolume(
+	ctx context.Context, req *ctrlpb.DecommissionVolumeRequest,
+) (*ctrlpb.DecommissionVolumeResponse, error) {
+	return cp.ctrl.SegmentService().RawClient().DecommissionVolume(ctx, req)
+}
+
 func (cp *ControllerProxy) CreateSubscription(
 	ctx context.Context, req *ctrlpb.CreateSubscriptionRequest,
 ) (*metapb.Subscription, error) {
@@ -3,12 +3,19 @@ package proxy
 import (
 	stdCtx "context"
 	"testing"
+	stdtime "time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/vanus-labs/vanus/pkg/errors"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
 
 func TestControllerProxy_ProxyMethod(t *testing.T) {
@@ -30,12 +37,12 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		eventbusCtrl.EXPECT().DeleteEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().GetEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().ListEventbus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().UpdateEventbus(gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateEventbus(stdCtx.Background(), &ctrlpb.CreateEventbusRequest{})
 		_, _ = cp.DeleteEventbus(stdCtx.Background(), &wrapperspb.UInt64Value{})
 		_, _ = cp.GetEventbus(stdCtx.Background(), &wrapperspb.UInt64Value{})
 		_, _ = cp.ListEventbus(stdCtx.Background(), &ctrlpb.ListEventbusRequest{})
-		_, err := cp.UpdateEventbus(stdCtx.Background(), &ctrlpb.UpdateEventbusRequest{})
-		So(err, ShouldEqual, errMethodNotImplemented)
+		_, _ = cp.UpdateEventbus(stdCtx.Background(), &ctrlpb.UpdateEventbusRequest{})
 
 		eventlogCtrl := ctrlpb.NewMockEventlogControllerClient(ctrl)
 		cp.eventlogCtrl = eventlogCtrl
@@ -56,3 +63,50 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		_, _ = cp.ListSubscription(stdCtx.Background(), &ctrlpb.ListSubscriptionRequest{})
 	})
 }
+
+func TestControllerProxy_GetEventbusIDByName(t *testing.T) {
+	Convey("test get eventbus id by name", t, func() {
+		cp := NewControllerProxy(Config{Endpoints: []string{"127.0.0.1:20001"}})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		eventbusCtrl := ctrlpb.NewMockEventbusControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+		ctx := stdCtx.Background()
+		id := vanus.NewTestID()
+
+		eventbusCtrl.EXPECT().GetEventbusWithHumanFriendly(gomock.Any(), &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+			Namespace:    "default",
+			EventbusName: "unknown",
+		}).Times(1).Return(nil, errors.ErrResourceNotFound.WithMessage("eventbus not found"))
+		_, err := cp.GetEventbusIDByName(ctx, "default", "unknown")
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+		eventbusCtrl.EXPECT().GetEventbusWithHumanFriendly(gomock.Any(), &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+			Namespace:    "default",
+			EventbusName: "test",
+		}).Times(2).Return(&metapb.Eventbus{Id: id.Uint64()}, nil)
+		for i := 0; i < 3; i++ {
+			res, err := cp.GetEventbusIDByName(ctx, "default", "test")
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, id)
+		}
+
+		// the cache is invalidated after the eventbus is deleted
+		eventbusCtrl.EXPECT().DeleteEventbus(gomock.Any(), wrapperspb.UInt64(id.Uint64())).Times(1).
+			Return(&emptypb.Empty{}, nil)
+		_, err = cp.DeleteEventbus(ctx, wrapperspb.UInt64(id.Uint64()))
+		So(err, ShouldBeNil)
+		res, err := cp.GetEventbusIDByName(ctx, "default", "test")
+		So(err, ShouldBeNil)
+		So(res, ShouldEqual, id)
+
+		// the eventbus is recreated from another gateway, the cache is refreshed after it expires
+		newID := vanus.NewTestID()
+		eventbusCtrl.EXPECT().GetEventbusWithHumanFriendly(gomock.Any(), gomock.Any()).Times(1).
+			Return(&metapb.Eventbus{Id: newID.Uint64()}, nil)
+		cp.eventbusIDs.Store("default/test", cachedEventbusID{id: id, expireAt: stdtime.Now()})
+		res, err = cp.GetEventbusIDByName(ctx, "default", "test")
+		So(err, ShouldBeNil)
+		So(res, ShouldEqual, newID)
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/cloudevents/sdk-go/v2/protocol"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/trace"
@@ -74,6 +75,8 @@ import (
 const (
 	maximumNumberPerGetRequest = 64
 	eventChanCache             = 10
+	defaultSubscribeCredits    = 64
+	defaultAckTimeout          = 5 * stdtime.Second
 	readSize                   = 5
 	ContentTypeProtobuf        = "application/protobuf"
 	httpRequestPrefix          = "/gatewaysink"
@@ -96,6 +99,7 @@ type Config struct {
 	CloudEventReceiverPort int
 	Credentials            credentials.TransportCredentials
 	GRPCReflectionEnable   bool
+	PartitionKey           string
 }
 
 var _ proxypb.StoreProxyServer = &ControllerProxy{}
@@ -113,15 +117,38 @@ type subscribeCache struct {
 	subscribeStream proxypb.StoreProxy_SubscribeServer
 	acks            sync.Map
 	eventc          chan message
+	// credits is the number of events can be sent to the stream.
+	credits      int64
+	creditc      chan struct{}
+	maxBatchSize int
+	ackTimeout   stdtime.Duration
+	closed       chan struct{}
 }
 
-func newSubscribeCache(subscriptionID string, stream proxypb.StoreProxy_SubscribeServer) *subscribeCache {
+func newSubscribeCache(req *proxypb.SubscribeRequest, stream proxypb.StoreProxy_SubscribeServer) *subscribeCache {
+	credits := int64(req.GetCredits())
+	if credits == 0 {
+		credits = defaultSubscribeCredits
+	}
+	maxBatchSize := int(req.GetMaxBatchSize())
+	if maxBatchSize == 0 {
+		maxBatchSize = 1
+	}
+	ackTimeout := defaultAckTimeout
+	if req.GetAckTimeoutMs() > 0 {
+		ackTimeout = stdtime.Duration(req.GetAckTimeoutMs()) * stdtime.Millisecond
+	}
 	return &subscribeCache{
 		sequenceID:      0,
-		subscriptionID:  subscriptionID,
+		subscriptionID:  req.SubscriptionId,
 		subscribeStream: stream,
 		acks:            sync.Map{},
 		eventc:          make(chan message, eventChanCache),
+		credits:         credits,
+		creditc:         make(chan struct{}, 1),
+		maxBatchSize:    maxBatchSize,
+		ackTimeout:      ackTimeout,
+		closed:          make(chan struct{}),
 	}
 }
 
@@ -133,6 +160,113 @@ func (s *subscribeCache) stream() proxypb.StoreProxy_SubscribeServer {
 	return s.subscribeStream
 }
 
+func (s *subscribeCache) grant(n int64) {
+	if n <= 0 {
+		return
+	}
+	atomic.AddInt64(&s.credits, n)
+	select {
+	case s.creditc <- struct{}{}:
+	default:
+	}
+}
+
+// waitCredit blocks until the stream has credits.
+func (s *subscribeCache) waitCredit(ctx context.Context) error {
+	for atomic.LoadInt64(&s.credits) <= 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.creditc:
+		}
+	}
+	return nil
+}
+
+// next returns a batch of messages, the size is limited by maxBatchSize and credits.
+func (s *subscribeCache) next(ctx context.Context) ([]message, error) {
+	if err := s.waitCredit(ctx); err != nil {
+		return nil, err
+	}
+	var msgs []message
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg := <-s.eventc:
+		msgs = append(msgs, msg)
+	}
+	limit := s.maxBatchSize
+	if credits := int(atomic.LoadInt64(&s.credits)); credits < limit {
+		limit = credits
+	}
+	for len(msgs) < limit {
+		select {
+		case msg := <-s.eventc:
+			msgs = append(msgs, msg)
+		default:
+			return msgs, nil
+		}
+	}
+	return msgs, nil
+}
+
+func (s *subscribeCache) ack(sequenceID uint64, success bool) {
+	if cb, ok := s.acks.LoadAndDelete(sequenceID); ok {
+		cb.(ackCallback)(success)
+	}
+}
+
+// close fails all events which are waiting for ack, it's called after the stream is broken.
+func (s *subscribeCache) close() {
+	close(s.closed)
+	s.acks.Range(func(key, _ interface{}) bool {
+		s.ack(key.(uint64), false)
+		return true
+	})
+}
+
+// deliver sends the event to the stream and waits for the ack, it returns 504 if the event isn't acked
+// in time, so that trigger will retry it.
+func (s *subscribeCache) deliver(event *v2.Event) (int, string) {
+	sequenceID := atomic.AddUint64(&s.sequenceID, 1)
+	var success bool
+	donec := make(chan struct{})
+	s.acks.Store(sequenceID, ackCallback(func(result bool) {
+		success = result
+		close(donec)
+	}))
+	timer := stdtime.NewTimer(s.ackTimeout)
+	defer timer.Stop()
+
+	code, msg := http.StatusGatewayTimeout, "event ack timeout"
+	select {
+	case s.eventc <- message{sequenceID: sequenceID, event: event}:
+		select {
+		case <-donec:
+			return ackResult(success)
+		case <-s.closed:
+			code, msg = http.StatusServiceUnavailable, "subscribe stream closed"
+		case <-timer.C:
+		}
+	case <-s.closed:
+		code, msg = http.StatusServiceUnavailable, "subscribe stream closed"
+	case <-timer.C:
+	}
+	if _, ok := s.acks.LoadAndDelete(sequenceID); ok {
+		return code, msg
+	}
+	// the ack callback is being called
+	<-donec
+	return ackResult(success)
+}
+
+func ackResult(success bool) (int, string) {
+	if !success {
+		return http.StatusInternalServerError, "event processing failed"
+	}
+	return http.StatusOK, ""
+}
+
 type ControllerProxy struct {
 	cfg          Config
 	tracer       *tracing.Tracer
@@ -143,10 +277,17 @@ type ControllerProxy struct {
 	grpcSrv      *grpc.Server
 	ctrl         cluster.Cluster
 	writerMap    sync.Map
+	eventbusIDs  sync.Map
 	cache        sync.Map
+	pulls        sync.Map
+	pullMutex    sync.Mutex
+	pullHolder   string
+	namespaces   sync.Map
+	quotas       sync.Map
+	cancel       context.CancelFunc
 }
 
-func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequest) (*emptypb.Empty, error) {
+func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequest) (*proxypb.PublishResponse, error) {
 	eventbusID := vanus.NewIDFromUint64(req.EventbusId)
 	responseCode := 200
 	_ctx, span := cp.tracer.Start(ctx, "Publish")
@@ -168,6 +309,11 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequ
 		).Observe(used)
 	}()
 
+	if err := cp.checkQuota(_ctx, eventbusID, req.Events); err != nil {
+		responseCode = http.StatusTooManyRequests
+		return nil, err
+	}
+
 	// todo  common event with delay event mixture
 	for idx := range req.Events.Events {
 		e := req.Events.Events[idx]
@@ -200,36 +346,41 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *proxypb.PublishRequ
 		}
 	}
 
-	err := cp.writeEvents(ctx, vanus.NewIDFromUint64(req.EventbusId), req.Events)
+	eventIDs, err := cp.writeEvents(ctx, vanus.NewIDFromUint64(req.EventbusId), req.Events)
 	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &proxypb.PublishResponse{EventIds: eventIDs}, nil
 }
 
 func (cp *ControllerProxy) writeEvents(ctx context.Context,
 	eventbusID vanus.ID,
 	events *cloudevents.CloudEventBatch,
-) error {
+) ([]string, error) {
 	val, exist := cp.writerMap.Load(eventbusID)
 	if !exist {
+		bus := cp.client.Eventbus(ctx, api.WithID(eventbusID.Uint64()))
 		val, _ = cp.writerMap.LoadOrStore(eventbusID,
-			cp.client.Eventbus(ctx, api.WithID(eventbusID.Uint64())).Writer())
+			bus.Writer(option.WithWritePolicy(policy.NewKeyHashWritePolicy(bus, cp.cfg.PartitionKey))))
 	}
 	w, _ := val.(api.BusWriter)
-	_, err := w.Append(ctx, events)
+	eventIDs, err := w.Append(ctx, events)
 	if err != nil {
 		log.Warning(ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
 			"eventbus":   eventbusID.Key(),
 		})
-		return v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+		if errors.Is(err, errors.ErrResourceNotFound) {
+			cp.invalidateEventbus(eventbusID)
+			return nil, v2.NewHTTPResult(http.StatusNotFound, err.Error())
+		}
+		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
-	return nil
+	return eventIDs, nil
 }
 
 func (cp *ControllerProxy) Subscribe(req *proxypb.SubscribeRequest, stream proxypb.StoreProxy_SubscribeServer) error {
-	_ctx, span := cp.tracer.Start(context.Background(), "Subscribe")
+	_ctx, span := cp.tracer.Start(stream.Context(), "Subscribe")
 	defer span.End()
 
 	// 1. modify subscription sink
@@ -290,49 +441,58 @@ func (cp *ControllerProxy) Subscribe(req *proxypb.SubscribeRequest, stream proxy
 	}
 
 	// 2. cache subscribe info
-	subscribe := newSubscribeCache(req.SubscriptionId, stream)
+	subscribe := newSubscribeCache(req, stream)
 	cp.cache.Store(req.SubscriptionId, subscribe)
+	defer func() {
+		// the subscription may be subscribed by another stream
+		if v, ok := cp.cache.Load(req.SubscriptionId); ok && v == subscribe {
+			cp.cache.Delete(req.SubscriptionId)
+		}
+		subscribe.close()
+	}()
 
 	// 3. receive and forward events
 	for {
-		select {
-		case <-_ctx.Done():
+		msgs, err := subscribe.next(_ctx)
+		if err != nil {
 			return errors.ErrInternal.WithMessage("subscribe stream context done")
-		case msg := <-subscribe.ch():
+		}
+		res := &proxypb.SubscribeResponse{
+			Events: &cloudevents.CloudEventBatch{},
+		}
+		for _, msg := range msgs {
+			// the event has been failed because of ack timeout
+			if _, ok := subscribe.acks.Load(msg.sequenceID); !ok {
+				continue
+			}
 			eventpb, err := ToProto(msg.event)
 			if err != nil {
-				// TODO(jiangkai): err check
 				log.Error(_ctx, "to eventpb failed", map[string]interface{}{
 					log.KeyError: err,
 					"event":      msg.event,
 				})
-				break
-			}
-			log.Debug(_ctx, "subscribe stream send event", map[string]interface{}{
-				"eventpb": eventpb.String(),
-			})
-			err = subscribe.stream().Send(&proxypb.SubscribeResponse{
-				SequenceId: msg.sequenceID,
-				Events: &cloudevents.CloudEventBatch{
-					Events: []*cloudevents.CloudEvent{eventpb},
-				},
-			})
-			if err != nil {
-				cache, _ := cp.cache.LoadAndDelete(subscribe.subscriptionID)
-				if cache != nil {
-					cache.(*subscribeCache).acks.Range(func(key, value interface{}) bool {
-						value.(ackCallback)(false)
-						return true
-					})
-				}
-				return err
+				subscribe.ack(msg.sequenceID, false)
+				continue
 			}
+			res.SequenceIds = append(res.SequenceIds, msg.sequenceID)
+			res.Events.Events = append(res.Events.Events, eventpb)
+		}
+		if len(res.SequenceIds) == 0 {
+			continue
+		}
+		res.SequenceId = res.SequenceIds[0]
+		log.Debug(_ctx, "subscribe stream send events", map[string]interface{}{
+			"sequence_ids": res.SequenceIds,
+		})
+		atomic.AddInt64(&subscribe.credits, -int64(len(res.SequenceIds)))
+		if err = subscribe.stream().Send(res); err != nil {
+			return err
 		}
 	}
 }
 
 func (cp *ControllerProxy) Ack(stream proxypb.StoreProxy_AckServer) error {
-	_ctx, span := cp.tracer.Start(context.Background(), "Ack")
+	_ctx, span := cp.tracer.Start(stream.Context(), "Ack")
 	defer span.End()
 	for {
 		rsp, err := stream.Recv()
@@ -355,10 +515,17 @@ func (cp *ControllerProxy) Ack(stream proxypb.StoreProxy_AckServer) error {
 			})
 			continue
 		}
-		cb, _ := cache.(*subscribeCache).acks.LoadAndDelete(rsp.SequenceId)
-		if cb != nil {
-			cb.(ackCallback)(rsp.Success)
+		subscribe, _ := cache.(*subscribeCache)
+		// each acked event returns a credit, even though it has been failed because of ack timeout
+		credits := int64(rsp.Credits)
+		for _, sequenceID := range append([]uint64{rsp.SequenceId}, rsp.SequenceIds...) {
+			if sequenceID == 0 {
+				continue
+			}
+			subscribe.ack(sequenceID, rsp.Success)
+			credits++
 		}
+		subscribe.grant(credits)
 	}
 }
 
@@ -526,6 +693,7 @@ func NewControllerProxy(cfg Config) *ControllerProxy {
 		eventbusCtrl: ctrl.EventbusService().RawClient(),
 		eventlogCtrl: ctrl.EventlogService().RawClient(),
 		triggerCtrl:  ctrl.TriggerService().RawClient(),
+		pullHolder:   uuid.NewString(),
 	}
 }
 
@@ -582,6 +750,11 @@ func (cp *ControllerProxy) Start() error {
 	}()
 	log.Info(context.Background(), "the grpc proxy ready to work", nil)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	cp.cancel = cancel
+	go cp.runPullCommitter(ctx)
+	go cp.runQuotaRefresher(ctx)
+
 	sinkListen, err := net.Listen("tcp", fmt.Sprintf(":%d", cp.cfg.SinkPort))
 	if err != nil {
 		return err
@@ -619,24 +792,9 @@ func (cp *ControllerProxy) receive(ctx context.Context, event v2.Event) (*v2.Eve
 	log.Debug(_ctx, "sink proxy received a event", map[string]interface{}{
 		"event": event.String(),
 	})
-	sequenceID := atomic.AddUint64(&cache.(*subscribeCache).sequenceID, 1)
-	var success bool
-	donec := make(chan struct{})
-	cache.(*subscribeCache).acks.Store(sequenceID, ackCallback(func(result bool) {
-		log.Info(_ctx, "ack callback", map[string]interface{}{
-			"result": result,
-		})
-		success = result
-		close(donec)
-	}))
-	cache.(*subscribeCache).eventc <- message{
-		sequenceID: sequenceID,
-		event:      &event,
-	}
-	<-donec
-
-	if !success {
-		return nil, v2.NewHTTPResult(http.StatusInternalServerError, "event processing failed")
+	code, msg := cache.(*subscribeCache).deliver(&event)
+	if code != http.StatusOK {
+		return nil, v2.NewHTTPResult(code, msg)
 	}
 	return nil, v2.ResultACK
 }
@@ -654,6 +812,9 @@ func (cp *ControllerProxy) Stop() {
 	if cp.grpcSrv != nil {
 		cp.grpcSrv.GracefulStop()
 	}
+	if cp.cancel != nil {
+		cp.cancel()
+	}
 }
 
 func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*proxypb.ClusterInfoResponse, error) {
@@ -20,7 +20,10 @@ import (
 	"encoding/binary"
 	stdJson "encoding/json"
 	"fmt"
+	"net/http"
+	"os"
 	"testing"
+	stdtime "time"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
 	"github.com/golang/mock/gomock"
@@ -399,3 +402,122 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 		})
 	})
 }
+
+type testSubscribeStream struct {
+	grpc.ServerStream
+	ctx   stdCtx.Context
+	sentc chan *proxypb.SubscribeResponse
+}
+
+func (s *testSubscribeStream) Context() stdCtx.Context {
+	return s.ctx
+}
+
+func (s *testSubscribeStream) Send(res *proxypb.SubscribeResponse) error {
+	s.sentc <- res
+	return nil
+}
+
+func TestControllerProxy_Subscribe(t *testing.T) {
+	Convey("test subscribe", t, func() {
+		cp := NewControllerProxy(Config{Endpoints: []string{"127.0.0.1:20001"}, SinkPort: 18081})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockTriggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = mockTriggerCtrl
+		subID := vanus.NewTestID().String()
+		mockTriggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(&metapb.Subscription{
+			Sink: fmt.Sprintf("http://%s:%d%s/%s", os.Getenv("POD_IP"), 18081, httpRequestPrefix, subID),
+		}, nil)
+
+		ctx, cancel := stdCtx.WithCancel(stdCtx.Background())
+		stream := &testSubscribeStream{ctx: ctx, sentc: make(chan *proxypb.SubscribeResponse, 10)}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- cp.Subscribe(&proxypb.SubscribeRequest{
+				SubscriptionId: subID,
+				Credits:        2,
+				MaxBatchSize:   2,
+				AckTimeoutMs:   200,
+			}, stream)
+		}()
+		var cache *subscribeCache
+		So(func() bool {
+			for i := 0; i < 100; i++ {
+				if v, ok := cp.cache.Load(subID); ok {
+					cache, _ = v.(*subscribeCache)
+					return true
+				}
+				stdtime.Sleep(stdtime.Millisecond)
+			}
+			return false
+		}(), ShouldBeTrue)
+
+		deliver := func(id string) chan int {
+			e := v2.NewEvent()
+			e.SetID(id)
+			e.SetSource("test")
+			e.SetType("test")
+			resultc := make(chan int, 1)
+			go func() {
+				code, _ := cache.deliver(&e)
+				resultc <- code
+			}()
+			return resultc
+		}
+
+		Convey("test credits and batch", func() {
+			results := []chan int{deliver("1"), deliver("2"), deliver("3")}
+			res := <-stream.sentc
+			var sequenceIDs []uint64
+			sequenceIDs = append(sequenceIDs, res.SequenceIds...)
+			// no credit for the third event
+			if len(res.SequenceIds) < 2 {
+				res = <-stream.sentc
+				sequenceIDs = append(sequenceIDs, res.SequenceIds...)
+			}
+			So(sequenceIDs, ShouldHaveLength, 2)
+			select {
+			case <-stream.sentc:
+				So("unexpected send", ShouldBeEmpty)
+			case <-stdtime.After(20 * stdtime.Millisecond):
+			}
+
+			cache.ack(sequenceIDs[0], true)
+			cache.ack(sequenceIDs[1], false)
+			cache.grant(2)
+			res = <-stream.sentc
+			So(res.SequenceIds, ShouldHaveLength, 1)
+			cache.ack(res.SequenceIds[0], true)
+
+			codes := make([]int, 0, len(results))
+			for _, c := range results {
+				codes = append(codes, <-c)
+			}
+			So(codes, ShouldContain, http.StatusOK)
+			So(codes, ShouldContain, http.StatusInternalServerError)
+			cancel()
+			So(<-errc, ShouldNotBeNil)
+		})
+
+		Convey("test ack timeout", func() {
+			result := deliver("1")
+			res := <-stream.sentc
+			So(<-result, ShouldEqual, http.StatusGatewayTimeout)
+			// the late ack is ignored
+			cache.ack(res.SequenceId, true)
+			cancel()
+			So(<-errc, ShouldNotBeNil)
+		})
+
+		Convey("test stream closed", func() {
+			result := deliver("1")
+			<-stream.sentc
+			cancel()
+			So(<-errc, ShouldNotBeNil)
+			So(<-result, ShouldEqual, http.StatusInternalServerError)
+			_, ok := cp.cache.Load(subID)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
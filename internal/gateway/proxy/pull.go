@@ -0,0 +1,606 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	stdtime "time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/client/pkg/eventlog"
+	"github.com/vanus-labs/vanus/client/pkg/option"
+	"github.com/vanus-labs/vanus/client/pkg/policy"
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	"github.com/vanus-labs/vanus/proto/pkg/codec"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
+
+	"github.com/vanus-labs/vanus/internal/convert"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/trigger/filter"
+	"github.com/vanus-labs/vanus/internal/trigger/transform"
+)
+
+const (
+	defaultFetchSize     = 16
+	maximumFetchSize     = 256
+	defaultLeaseTimeout  = 30 * stdtime.Second
+	maximumWaitTimeout   = 30 * stdtime.Second
+	fetchPollingInterval = 100 * stdtime.Millisecond
+	pullCommitInterval   = stdtime.Second
+	pullIdleTimeout      = 5 * stdtime.Minute
+	// pullLeaseTimeout is the lease timeout of pulling a subscription from this gateway, it's renewed when
+	// the offsets are committed.
+	pullLeaseTimeout       = 30 * stdtime.Second
+	pullLogRefreshInterval = 10 * stdtime.Second
+)
+
+type pullMessage struct {
+	sequenceID uint64
+	log        *pullEventlog
+	offset     int64
+	event      *cloudevents.CloudEvent
+	attempts   uint32
+	// deadline is the lease timeout of an inflight message, or the time to redeliver a nacked message.
+	deadline stdtime.Time
+}
+
+type pullEventlog struct {
+	id     uint64
+	policy api.ReadPolicy
+	reader api.BusReader
+	// next is the offset of next event to read.
+	next int64
+	// pending holds the offsets of events which are read but not acked.
+	pending map[int64]struct{}
+}
+
+// committedOffset returns the offset before which all events have been acked.
+func (l *pullEventlog) committedOffset() uint64 {
+	off := l.next
+	for o := range l.pending {
+		if o < off {
+			off = o
+		}
+	}
+	return uint64(off)
+}
+
+// pullSubscription is shared by all consumers which fetch the same subscription from this gateway,
+// each event is leased to only one consumer at a time. The subscription is pulled from only one gateway
+// at a time, which holds the pull lease from controller.
+type pullSubscription struct {
+	id          vanus.ID
+	filter      filter.Filter
+	transformer *transform.Transformer
+	bus         api.Eventbus
+	// logs is appended with both readMu and mu held.
+	logs []*pullEventlog
+
+	readMu sync.Mutex
+	cursor int
+
+	mu          sync.Mutex
+	sequenceID  uint64
+	inflight    map[uint64]*pullMessage
+	redelivery  []*pullMessage
+	committed   map[uint64]uint64
+	activeAt    stdtime.Time
+	refreshedAt stdtime.Time
+	// leaseExpireAt is computed before the lease is acquired, so it's not later than the one in controller.
+	leaseExpireAt stdtime.Time
+}
+
+func newPullSubscription(
+	sub *metapb.Subscription, bus api.Eventbus, logs []api.Eventlog, leaseExpireAt stdtime.Time,
+) *pullSubscription {
+	md := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{
+		Filters:     sub.Filters,
+		Transformer: sub.Transformer,
+	})
+	s := &pullSubscription{
+		id:            vanus.NewIDFromUint64(sub.Id),
+		filter:        filter.GetFilter(md.Filters),
+		transformer:   transform.NewTransformer(md.Transformer),
+		bus:           bus,
+		refreshedAt:   stdtime.Now(),
+		inflight:      make(map[uint64]*pullMessage),
+		committed:     make(map[uint64]uint64),
+		activeAt:      stdtime.Now(),
+		leaseExpireAt: leaseExpireAt,
+	}
+	offsets := make(map[uint64]uint64, len(sub.Offsets))
+	for _, o := range sub.Offsets {
+		offsets[o.EventlogId] = o.Offset
+	}
+	for _, l := range logs {
+		offset, ok := offsets[l.ID()]
+		if !ok {
+			log.Warning(context.TODO(), "offset no exist, will use 0", map[string]interface{}{
+				log.KeySubscriptionID: s.id,
+				log.KeyEventlogID:     l.ID(),
+			})
+		}
+		s.addLog(l, offset)
+	}
+	return s
+}
+
+func (s *pullSubscription) addLog(l api.Eventlog, offset uint64) {
+	p := policy.NewManuallyReadPolicy(l, int64(offset))
+	s.logs = append(s.logs, &pullEventlog{
+		id:      l.ID(),
+		policy:  p,
+		reader:  s.bus.Reader(option.WithReadPolicy(p), option.WithDisablePolling()),
+		next:    int64(offset),
+		pending: make(map[int64]struct{}),
+	})
+	s.committed[l.ID()] = offset
+}
+
+// refreshLogs adds the eventlogs which are created after the subscription is loaded, they are read from
+// the beginning.
+func (s *pullSubscription) refreshLogs(ctx context.Context) error {
+	logs, err := s.bus.ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	s.readMu.Lock()
+	defer s.readMu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshedAt = stdtime.Now()
+	known := make(map[uint64]struct{}, len(s.logs))
+	for _, l := range s.logs {
+		known[l.id] = struct{}{}
+	}
+	for _, l := range logs {
+		if _, ok := known[l.ID()]; !ok {
+			s.addLog(l, 0)
+		}
+	}
+	return nil
+}
+
+func (s *pullSubscription) needRefreshLogs(now stdtime.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Sub(s.refreshedAt) >= pullLogRefreshInterval
+}
+
+// fetch leases at most n events, the redelivered events are preferred to new events.
+func (s *pullSubscription) fetch(
+	ctx context.Context, n int, lease stdtime.Duration,
+) ([]*proxypb.FetchedEvent, error) {
+	now := stdtime.Now()
+	s.mu.Lock()
+	s.activeAt = now
+	s.expire(now)
+	msgs := s.takeRedelivery(n, now)
+	s.mu.Unlock()
+
+	if len(msgs) < n {
+		read, err := s.read(ctx, n-len(msgs))
+		if err != nil && len(msgs) == 0 {
+			return nil, err
+		}
+		msgs = append(msgs, read...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := make([]*proxypb.FetchedEvent, len(msgs))
+	for idx, m := range msgs {
+		s.sequenceID++
+		m.sequenceID = s.sequenceID
+		m.attempts++
+		m.deadline = now.Add(lease)
+		s.inflight[m.sequenceID] = m
+		events[idx] = &proxypb.FetchedEvent{
+			SequenceId:      m.sequenceID,
+			Event:           m.event,
+			DeliveryAttempt: m.attempts,
+		}
+	}
+	return events, nil
+}
+
+// expire moves the messages whose lease is timeout to redelivery, it must be called with mu held.
+func (s *pullSubscription) expire(now stdtime.Time) {
+	for seq, m := range s.inflight {
+		if m.deadline.Before(now) {
+			delete(s.inflight, seq)
+			m.deadline = now
+			s.redelivery = append(s.redelivery, m)
+		}
+	}
+}
+
+// takeRedelivery must be called with mu held.
+func (s *pullSubscription) takeRedelivery(n int, now stdtime.Time) []*pullMessage {
+	msgs := make([]*pullMessage, 0, n)
+	remain := s.redelivery[:0]
+	for _, m := range s.redelivery {
+		if len(msgs) < n && !m.deadline.After(now) {
+			msgs = append(msgs, m)
+		} else {
+			remain = append(remain, m)
+		}
+	}
+	s.redelivery = remain
+	return msgs
+}
+
+// read reads at most n new events from eventlogs in turn, the events which are filtered out are treated
+// as acked.
+func (s *pullSubscription) read(ctx context.Context, n int) ([]*pullMessage, error) {
+	s.readMu.Lock()
+	defer s.readMu.Unlock()
+
+	msgs := make([]*pullMessage, 0, n)
+	var lastErr error
+	for i := 0; i < len(s.logs) && len(msgs) < n; i++ {
+		l := s.logs[s.cursor]
+		s.cursor = (s.cursor + 1) % len(s.logs)
+		batch, off, _, err := l.reader.Read(ctx, option.WithBatchSize(n-len(msgs)))
+		if err != nil {
+			if !errors.Is(err, errors.ErrOffsetOnEnd) && !errors.Is(err, errors.ErrTryAgain) {
+				log.Warning(ctx, "read event error", map[string]interface{}{
+					log.KeySubscriptionID: s.id,
+					log.KeyEventlogID:     l.id,
+					log.KeyError:          err,
+				})
+				lastErr = err
+			}
+			continue
+		}
+		events := batch.GetEvents()
+		read := make([]*pullMessage, 0, len(events))
+		next := off
+		for _, e := range events {
+			// Offsets of compacted events are skipped, so use the offset of event instead of counting.
+			eo, ok := eventlog.EventOffset(e)
+			if !ok {
+				eo = next
+			}
+			next = eo + 1
+			delete(e.Attributes, eventlog.XVanusLogOffset)
+			if e = s.process(ctx, e); e != nil {
+				read = append(read, &pullMessage{log: l, offset: eo, event: e})
+			}
+		}
+		l.policy.Forward(int(next - off))
+		s.mu.Lock()
+		for _, m := range read {
+			l.pending[m.offset] = struct{}{}
+		}
+		l.next = next
+		s.mu.Unlock()
+		msgs = append(msgs, read...)
+	}
+	if len(msgs) == 0 {
+		return nil, lastErr
+	}
+	return msgs, nil
+}
+
+// process applies the filter and transformer of subscription, it returns nil if the event is filtered out.
+func (s *pullSubscription) process(ctx context.Context, e *cloudevents.CloudEvent) *cloudevents.CloudEvent {
+	if s.filter == nil && s.transformer == nil {
+		return e
+	}
+	event, err := codec.FromProto(e)
+	if err != nil {
+		log.Warning(ctx, "event convert failed", map[string]interface{}{
+			log.KeySubscriptionID: s.id,
+			log.KeyError:          err,
+		})
+		return e
+	}
+	if filter.Run(s.filter, *event) == filter.FailFilter {
+		return nil
+	}
+	if s.transformer != nil {
+		if err = s.transformer.Execute(event); err != nil {
+			log.Warning(ctx, "event transform failed, it will be skipped", map[string]interface{}{
+				log.KeySubscriptionID: s.id,
+				log.KeyError:          err,
+			})
+			return nil
+		}
+	}
+	eventpb, err := codec.ToProto(event)
+	if err != nil {
+		log.Warning(ctx, "event convert failed", map[string]interface{}{
+			log.KeySubscriptionID: s.id,
+			log.KeyError:          err,
+		})
+		return e
+	}
+	return eventpb
+}
+
+// ack acknowledges the inflight messages, the sequence ids which are unknown or whose lease is timeout
+// are ignored.
+func (s *pullSubscription) ack(sequenceIDs []uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activeAt = stdtime.Now()
+	for _, seq := range sequenceIDs {
+		m, ok := s.inflight[seq]
+		if !ok {
+			continue
+		}
+		delete(s.inflight, seq)
+		delete(m.log.pending, m.offset)
+	}
+}
+
+// nack releases the inflight messages, they will be redelivered after delay.
+func (s *pullSubscription) nack(sequenceIDs []uint64, delay stdtime.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := stdtime.Now()
+	s.activeAt = now
+	for _, seq := range sequenceIDs {
+		m, ok := s.inflight[seq]
+		if !ok {
+			continue
+		}
+		delete(s.inflight, seq)
+		m.deadline = now.Add(delay)
+		s.redelivery = append(s.redelivery, m)
+	}
+}
+
+// uncommittedOffsets returns the offsets of eventlogs if any of them is changed since last commit.
+func (s *pullSubscription) uncommittedOffsets() ([]*metapb.OffsetInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := false
+	offsets := make([]*metapb.OffsetInfo, len(s.logs))
+	for idx, l := range s.logs {
+		off := l.committedOffset()
+		if off != s.committed[l.id] {
+			changed = true
+		}
+		offsets[idx] = &metapb.OffsetInfo{EventlogId: l.id, Offset: off}
+	}
+	return offsets, changed
+}
+
+func (s *pullSubscription) markCommitted(offsets []*metapb.OffsetInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, o := range offsets {
+		s.committed[o.EventlogId] = o.Offset
+	}
+}
+
+func (s *pullSubscription) idle(now stdtime.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Sub(s.activeAt) > pullIdleTimeout
+}
+
+func (s *pullSubscription) leased(now stdtime.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Before(s.leaseExpireAt)
+}
+
+func (s *pullSubscription) needRenewLease(now stdtime.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leaseExpireAt.Sub(now) < pullLeaseTimeout/2
+}
+
+func (s *pullSubscription) renewLease(expireAt stdtime.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaseExpireAt = expireAt
+}
+
+// acquirePullLease acquires or renews the pull lease of subscription, it returns the expiration time.
+func (cp *ControllerProxy) acquirePullLease(ctx context.Context, id vanus.ID) (stdtime.Time, error) {
+	expireAt := stdtime.Now().Add(pullLeaseTimeout)
+	_, err := cp.triggerCtrl.AcquirePullLease(ctx, &ctrlpb.AcquirePullLeaseRequest{
+		SubscriptionId: id.Uint64(),
+		Holder:         cp.pullHolder,
+		LeaseTimeoutMs: uint32(pullLeaseTimeout.Milliseconds()),
+	})
+	return expireAt, err
+}
+
+func (cp *ControllerProxy) getPullSubscription(ctx context.Context, id vanus.ID) (*pullSubscription, error) {
+	if v, ok := cp.pulls.Load(id); ok && v.(*pullSubscription).leased(stdtime.Now()) {
+		return v.(*pullSubscription), nil
+	}
+	cp.pullMutex.Lock()
+	defer cp.pullMutex.Unlock()
+	if v, ok := cp.pulls.Load(id); ok {
+		if s, _ := v.(*pullSubscription); s.leased(stdtime.Now()) {
+			return s, nil
+		}
+		// The lease is lost, the offsets may have been committed by another gateway, so reload it.
+		cp.pulls.Delete(id)
+	}
+	sub, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: id.Uint64()})
+	if err != nil {
+		return nil, err
+	}
+	// the enabled subscription is dispatched to trigger workers, which commit offset too.
+	if !sub.Disable {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(
+			"the subscription must be disabled before fetching events")
+	}
+	expireAt, err := cp.acquirePullLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	bus := cp.client.Eventbus(ctx, api.WithID(sub.EventbusId))
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	s := newPullSubscription(sub, bus, logs, expireAt)
+	cp.pulls.Store(id, s)
+	return s, nil
+}
+
+func (cp *ControllerProxy) Fetch(ctx context.Context, req *proxypb.FetchRequest) (*proxypb.FetchResponse, error) {
+	if req.GetSubscriptionId() == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("subscription is empty")
+	}
+	size := int(req.GetMaxEvents())
+	if size == 0 {
+		size = defaultFetchSize
+	} else if size > maximumFetchSize {
+		size = maximumFetchSize
+	}
+	lease := defaultLeaseTimeout
+	if req.GetLeaseTimeoutMs() > 0 {
+		lease = stdtime.Duration(req.GetLeaseTimeoutMs()) * stdtime.Millisecond
+	}
+	wait := stdtime.Duration(req.GetWaitTimeoutMs()) * stdtime.Millisecond
+	if wait > maximumWaitTimeout {
+		wait = maximumWaitTimeout
+	}
+
+	s, err := cp.getPullSubscription(ctx, vanus.NewIDFromUint64(req.GetSubscriptionId()))
+	if err != nil {
+		return nil, err
+	}
+	deadline := stdtime.Now().Add(wait)
+	for {
+		events, err := s.fetch(ctx, size, lease)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 || !stdtime.Now().Before(deadline) {
+			return &proxypb.FetchResponse{Events: events}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return &proxypb.FetchResponse{}, nil
+		case <-stdtime.After(fetchPollingInterval):
+		}
+	}
+}
+
+func (cp *ControllerProxy) AckEvents(_ context.Context, req *proxypb.AckEventsRequest) (*emptypb.Empty, error) {
+	v, ok := cp.pulls.Load(vanus.NewIDFromUint64(req.GetSubscriptionId()))
+	if !ok {
+		return nil, errors.ErrResourceNotFound.WithMessage("the subscription isn't fetched")
+	}
+	v.(*pullSubscription).ack(req.GetSequenceIds())
+	return &emptypb.Empty{}, nil
+}
+
+func (cp *ControllerProxy) NackEvents(_ context.Context, req *proxypb.NackEventsRequest) (*emptypb.Empty, error) {
+	v, ok := cp.pulls.Load(vanus.NewIDFromUint64(req.GetSubscriptionId()))
+	if !ok {
+		return nil, errors.ErrResourceNotFound.WithMessage("the subscription isn't fetched")
+	}
+	delay := stdtime.Duration(req.GetDelayMs()) * stdtime.Millisecond
+	v.(*pullSubscription).nack(req.GetSequenceIds(), delay)
+	return &emptypb.Empty{}, nil
+}
+
+// releasePullSubscription deletes the subscription unless it has been reloaded.
+func (cp *ControllerProxy) releasePullSubscription(key interface{}, s *pullSubscription) {
+	cp.pullMutex.Lock()
+	defer cp.pullMutex.Unlock()
+	if v, ok := cp.pulls.Load(key); ok && v == s {
+		cp.pulls.Delete(key)
+	}
+}
+
+// runPullCommitter commits the offsets of pull subscriptions periodically, renews their leases, and releases
+// the idle ones.
+func (cp *ControllerProxy) runPullCommitter(ctx context.Context) {
+	ticker := stdtime.NewTicker(pullCommitInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			cp.commitPullOffsets(context.Background())
+			return
+		case <-ticker.C:
+			cp.commitPullOffsets(ctx)
+		}
+	}
+}
+
+func (cp *ControllerProxy) commitPullOffsets(ctx context.Context) {
+	now := stdtime.Now()
+	cp.pulls.Range(func(key, value interface{}) bool {
+		s, _ := value.(*pullSubscription)
+		if s.needRenewLease(now) {
+			expireAt, err := cp.acquirePullLease(ctx, s.id)
+			if err != nil {
+				log.Warning(ctx, "renew lease of pull subscription failed", map[string]interface{}{
+					log.KeySubscriptionID: s.id,
+					log.KeyError:          err,
+				})
+			} else {
+				s.renewLease(expireAt)
+			}
+		}
+		// Another gateway may pull the subscription after the lease is lost, don't commit the offsets.
+		if !s.leased(now) {
+			cp.releasePullSubscription(key, s)
+			return true
+		}
+		if s.needRefreshLogs(now) {
+			if err := s.refreshLogs(ctx); err != nil {
+				log.Warning(ctx, "refresh eventlogs of pull subscription failed", map[string]interface{}{
+					log.KeySubscriptionID: s.id,
+					log.KeyError:          err,
+				})
+			}
+		}
+		if offsets, changed := s.uncommittedOffsets(); changed {
+			res, err := cp.triggerCtrl.CommitOffset(ctx, &ctrlpb.CommitOffsetRequest{
+				SubscriptionInfo: []*metapb.SubscriptionInfo{{
+					SubscriptionId: s.id.Uint64(),
+					Offsets:        offsets,
+				}},
+				ForceCommit: true,
+			})
+			if err != nil || len(res.GetFailSubscriptionId()) > 0 {
+				log.Warning(ctx, "commit offset of pull subscription failed", map[string]interface{}{
+					log.KeySubscriptionID: s.id,
+					log.KeyError:          err,
+				})
+				return true
+			}
+			s.markCommitted(offsets)
+		}
+		if s.idle(now) {
+			cp.releasePullSubscription(key, s)
+		}
+		return true
+	})
+}
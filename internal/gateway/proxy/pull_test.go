@@ -0,0 +1,231 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vanus-labs/vanus/client"
+	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	"github.com/vanus-labs/vanus/proto/pkg/codec"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
+
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestControllerProxy_Fetch(t *testing.T) {
+	Convey("test fetch", t, func() {
+		cp := NewControllerProxy(Config{Endpoints: []string{"127.0.0.1:20001"}})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		mockTriggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = mockTriggerCtrl
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventlog := api.NewMockEventlog(ctrl)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		logs := []api.Eventlog{mockEventlog}
+		mockEventbus.EXPECT().ListLog(gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, _ ...api.LogOption) ([]api.Eventlog, error) {
+				return logs, nil
+			})
+		mockReader := api.NewMockBusReader(ctrl)
+		mockEventbus.EXPECT().Reader(gomock.Any()).AnyTimes().Return(mockReader)
+
+		ctx := stdCtx.Background()
+		subID := vanus.NewTestID()
+		sub := &metapb.Subscription{
+			Id:         subID.Uint64(),
+			EventbusId: vanus.NewTestID().Uint64(),
+			Disable:    true,
+			Offsets:    []*metapb.OffsetInfo{{EventlogId: 1, Offset: 10}},
+			Filters:    []*metapb.Filter{{Exact: map[string]string{"type": "test"}}},
+		}
+		mockTriggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(sub, nil)
+		var leaseErr error
+		mockTriggerCtrl.EXPECT().AcquirePullLease(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, req *ctrlpb.AcquirePullLeaseRequest, _ ...interface{}) (*emptypb.Empty, error) {
+				So(req.SubscriptionId, ShouldEqual, subID.Uint64())
+				So(req.Holder, ShouldEqual, cp.pullHolder)
+				return &emptypb.Empty{}, leaseErr
+			})
+		newEvent := func(id, eventType string) *cloudevents.CloudEvent {
+			e := v2.NewEvent()
+			e.SetID(id)
+			e.SetSource("test")
+			e.SetType(eventType)
+			epb, _ := codec.ToProto(&e)
+			return epb
+		}
+
+		Convey("test fetch enabled subscription", func() {
+			sub.Disable = false
+			_, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			sub.Disable = true
+		})
+
+		Convey("test fetch, ack and nack", func() {
+			batch := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{
+				newEvent("1", "test"), newEvent("2", "other"), newEvent("3", "test"),
+			}}
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).Times(1).Return(batch, int64(10), uint64(1), nil)
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).AnyTimes().
+				Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+
+			res, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 2)
+			So(res.Events[0].Event.Id, ShouldEqual, "1")
+			So(res.Events[0].DeliveryAttempt, ShouldEqual, 1)
+			So(res.Events[1].Event.Id, ShouldEqual, "3")
+
+			// nothing to fetch, all events are leased
+			res2, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64(), WaitTimeoutMs: 10})
+			So(err, ShouldBeNil)
+			So(res2.Events, ShouldHaveLength, 0)
+
+			_, err = cp.NackEvents(ctx, &proxypb.NackEventsRequest{
+				SubscriptionId: subID.Uint64(),
+				SequenceIds:    []uint64{res.Events[0].SequenceId},
+			})
+			So(err, ShouldBeNil)
+			res2, err = cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			So(res2.Events, ShouldHaveLength, 1)
+			So(res2.Events[0].Event.Id, ShouldEqual, "1")
+			So(res2.Events[0].DeliveryAttempt, ShouldEqual, 2)
+			So(res2.Events[0].SequenceId, ShouldNotEqual, res.Events[0].SequenceId)
+
+			// the offset stays before the first unacked event
+			_, err = cp.AckEvents(ctx, &proxypb.AckEventsRequest{
+				SubscriptionId: subID.Uint64(),
+				SequenceIds:    []uint64{res.Events[1].SequenceId},
+			})
+			So(err, ShouldBeNil)
+			// the offset isn't changed, so it's not committed
+			cp.commitPullOffsets(ctx)
+
+			mockTriggerCtrl.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, req *ctrlpb.CommitOffsetRequest, _ ...interface{}) (*ctrlpb.CommitOffsetResponse, error) {
+					So(req.ForceCommit, ShouldBeTrue)
+					So(req.SubscriptionInfo[0].SubscriptionId, ShouldEqual, subID.Uint64())
+					So(req.SubscriptionInfo[0].Offsets[0].Offset, ShouldEqual, 13)
+					return &ctrlpb.CommitOffsetResponse{}, nil
+				})
+			_, err = cp.AckEvents(ctx, &proxypb.AckEventsRequest{
+				SubscriptionId: subID.Uint64(),
+				SequenceIds:    []uint64{res2.Events[0].SequenceId, res.Events[0].SequenceId},
+			})
+			So(err, ShouldBeNil)
+			cp.commitPullOffsets(ctx)
+			cp.commitPullOffsets(ctx)
+		})
+
+		Convey("test lease timeout", func() {
+			batch := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{newEvent("1", "test")}}
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).Times(1).Return(batch, int64(10), uint64(1), nil)
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).AnyTimes().
+				Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+
+			res, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64(), LeaseTimeoutMs: 10})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+			stdtime.Sleep(20 * stdtime.Millisecond)
+			res2, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			So(res2.Events, ShouldHaveLength, 1)
+			So(res2.Events[0].DeliveryAttempt, ShouldEqual, 2)
+
+			// the expired lease can't be acked
+			_, err = cp.AckEvents(ctx, &proxypb.AckEventsRequest{
+				SubscriptionId: subID.Uint64(),
+				SequenceIds:    []uint64{res.Events[0].SequenceId},
+			})
+			So(err, ShouldBeNil)
+			v, _ := cp.pulls.Load(subID)
+			offsets, changed := v.(*pullSubscription).uncommittedOffsets()
+			So(changed, ShouldBeFalse)
+			So(offsets[0].Offset, ShouldEqual, 10)
+		})
+
+		Convey("test fetch subscription pulled by another gateway", func() {
+			leaseErr = errors.ErrResourceCanNotOp
+			_, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			_, ok := cp.pulls.Load(subID)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("test pull lease lost", func() {
+			batch := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{newEvent("1", "test")}}
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).Times(1).Return(batch, int64(10), uint64(1), nil)
+			res, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			_, err = cp.AckEvents(ctx, &proxypb.AckEventsRequest{
+				SubscriptionId: subID.Uint64(),
+				SequenceIds:    []uint64{res.Events[0].SequenceId},
+			})
+			So(err, ShouldBeNil)
+
+			// the offsets aren't committed after the lease is lost
+			v, _ := cp.pulls.Load(subID)
+			v.(*pullSubscription).renewLease(stdtime.Now())
+			leaseErr = errors.ErrResourceCanNotOp
+			cp.commitPullOffsets(ctx)
+			_, ok := cp.pulls.Load(subID)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("test pull new eventlogs", func() {
+			mockReader.EXPECT().Read(gomock.Any(), gomock.Any()).AnyTimes().
+				Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+			_, err := cp.Fetch(ctx, &proxypb.FetchRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			v, _ := cp.pulls.Load(subID)
+			s, _ := v.(*pullSubscription)
+
+			newEventlog := api.NewMockEventlog(ctrl)
+			newEventlog.EXPECT().ID().AnyTimes().Return(uint64(2))
+			logs = []api.Eventlog{mockEventlog, newEventlog}
+			s.refreshedAt = stdtime.Now().Add(-pullLogRefreshInterval)
+			cp.commitPullOffsets(ctx)
+
+			offsets, changed := s.uncommittedOffsets()
+			So(changed, ShouldBeFalse)
+			So(offsets, ShouldHaveLength, 2)
+			So(offsets[1].EventlogId, ShouldEqual, 2)
+			So(offsets[1].Offset, ShouldEqual, 0)
+		})
+
+		Convey("test ack not fetched subscription", func() {
+			_, err := cp.AckEvents(ctx, &proxypb.AckEventsRequest{SubscriptionId: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
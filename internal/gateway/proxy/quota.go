@@ -0,0 +1,250 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	stdtime "time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/errors"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	quotaRefreshInterval = 10 * stdtime.Second
+	quotaLoadTimeout     = 5 * stdtime.Second
+)
+
+// namespaceQuota limits the ingress of a namespace, the limiters are nil if they are unlimited.
+//
+// NOTE: the rate limiters are local to each gateway instance, the ingress of a namespace is limited to
+// replicas * quota in total.
+type namespaceQuota struct {
+	name             string
+	maxRetainedBytes int64
+	retainedBytes    int64
+	events           *rate.Limiter
+	bytes            *rate.Limiter
+	mutex            sync.Mutex
+}
+
+func newNamespaceQuota(ns *metapb.Namespace) *namespaceQuota {
+	q := &namespaceQuota{
+		name:             ns.GetName(),
+		maxRetainedBytes: ns.GetQuota().GetMaxRetainedBytes(),
+		retainedBytes:    ns.GetUsage().GetRetainedBytes(),
+	}
+	q.events = updateLimiter(nil, ns.GetQuota().GetMaxEventsPerSecond())
+	q.bytes = updateLimiter(nil, ns.GetQuota().GetMaxBytesPerSecond())
+	return q
+}
+
+func (q *namespaceQuota) update(ns *metapb.Namespace) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.maxRetainedBytes = ns.GetQuota().GetMaxRetainedBytes()
+	q.retainedBytes = ns.GetUsage().GetRetainedBytes()
+	q.events = updateLimiter(q.events, ns.GetQuota().GetMaxEventsPerSecond())
+	q.bytes = updateLimiter(q.bytes, ns.GetQuota().GetMaxBytesPerSecond())
+}
+
+func updateLimiter(l *rate.Limiter, limit int64) *rate.Limiter {
+	if limit <= 0 {
+		return nil
+	}
+	if l == nil {
+		return rate.NewLimiter(rate.Limit(limit), int(limit))
+	}
+	if l.Limit() != rate.Limit(limit) {
+		l.SetLimit(rate.Limit(limit))
+		l.SetBurst(int(limit))
+	}
+	return l
+}
+
+// check returns the name of exceeded quota, or empty if the events are allowed.
+func (q *namespaceQuota) check(now stdtime.Time, count, size int) string {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.maxRetainedBytes > 0 && q.retainedBytes >= q.maxRetainedBytes {
+		return primitive.QuotaRetainedBytes
+	}
+	events, ok := reserve(q.events, now, count)
+	if !ok {
+		return primitive.QuotaEventsPerSecond
+	}
+	if _, ok = reserve(q.bytes, now, size); !ok {
+		cancelReservations(events, now)
+		return primitive.QuotaBytesPerSecond
+	}
+	return ""
+}
+
+// reserve takes n tokens from the limiter without waiting. The batch larger than the burst is allowed only if
+// the bucket is full, and the exceeded tokens are borrowed from the future, so the following events are limited
+// until they are paid off.
+func reserve(l *rate.Limiter, now stdtime.Time, n int) ([]*rate.Reservation, bool) {
+	if l == nil {
+		return nil, true
+	}
+	burst := l.Burst()
+	first := n
+	if first > burst {
+		first = burst
+	}
+	r := l.ReserveN(now, first)
+	if !r.OK() || r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil, false
+	}
+	rs := []*rate.Reservation{r}
+	for n -= first; n > 0; n -= burst {
+		if n < burst {
+			burst = n
+		}
+		rs = append(rs, l.ReserveN(now, burst))
+	}
+	return rs, true
+}
+
+// cancelReservations returns the tokens in the reverse order of reserving.
+func cancelReservations(rs []*rate.Reservation, now stdtime.Time) {
+	for i := len(rs) - 1; i >= 0; i-- {
+		rs[i].CancelAt(now)
+	}
+}
+
+// checkQuota returns ErrQuotaExceeded if the namespace of eventbus exceeds its quota, it's mapped to 429 by
+// the HTTP gateway and to the error code of gRPC by the interceptor. The quota is loaded asynchronously, the events
+// are allowed until it's loaded so that the controller isn't in the critical path of publishing.
+func (cp *ControllerProxy) checkQuota(
+	ctx context.Context, eventbusID vanus.ID, events *cloudevents.CloudEventBatch,
+) error {
+	v, loaded := cp.namespaces.LoadOrStore(eventbusID, "")
+	if !loaded {
+		go cp.loadEventbusNamespace(eventbusID)
+		return nil
+	}
+	namespace, _ := v.(string)
+	if namespace == "" {
+		return nil
+	}
+	q, ok := cp.quotas.Load(namespace)
+	if !ok {
+		return nil
+	}
+	size := 0
+	for _, e := range events.GetEvents() {
+		size += proto.Size(e)
+	}
+	exceeded := q.(*namespaceQuota).check(stdtime.Now(), len(events.GetEvents()), size)
+	if exceeded == "" {
+		return nil
+	}
+	metrics.GatewayQuotaRejectedCounterVec.WithLabelValues(namespace, exceeded).Inc()
+	log.Debug(ctx, "the namespace exceeded the quota", map[string]interface{}{
+		log.KeyEventbusID: eventbusID,
+		log.KeyNamespace:  namespace,
+		"quota":           exceeded,
+	})
+	return errors.ErrQuotaExceeded.WithMessage(
+		fmt.Sprintf("namespace %s exceeded the quota of %s", namespace, exceeded))
+}
+
+func (cp *ControllerProxy) loadEventbusNamespace(eventbusID vanus.ID) {
+	ctx, cancel := context.WithTimeout(context.Background(), quotaLoadTimeout)
+	defer cancel()
+	eb, err := cp.eventbusCtrl.GetEventbus(ctx, wrapperspb.UInt64(eventbusID.Uint64()))
+	if err != nil {
+		// retry on next publishing
+		cp.namespaces.Delete(eventbusID)
+		log.Warning(ctx, "failed to get namespace of eventbus", map[string]interface{}{
+			log.KeyError:      err,
+			log.KeyEventbusID: eventbusID,
+		})
+		return
+	}
+	if _, ok := cp.quotas.Load(eb.GetNamespace()); !ok {
+		if err = cp.loadNamespaceQuota(ctx, eb.GetNamespace()); err != nil {
+			cp.namespaces.Delete(eventbusID)
+			log.Warning(ctx, "failed to get quota of namespace", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeyNamespace: eb.GetNamespace(),
+			})
+			return
+		}
+	}
+	cp.namespaces.Store(eventbusID, eb.GetNamespace())
+}
+
+func (cp *ControllerProxy) loadNamespaceQuota(ctx context.Context, name string) error {
+	ns, err := cp.eventbusCtrl.GetNamespace(ctx, &ctrlpb.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	cp.storeNamespaceQuota(ns)
+	return nil
+}
+
+// storeNamespaceQuota keeps the state of limiters if the rate quotas aren't changed.
+func (cp *ControllerProxy) storeNamespaceQuota(ns *metapb.Namespace) {
+	v, loaded := cp.quotas.LoadOrStore(ns.GetName(), newNamespaceQuota(ns))
+	if loaded {
+		v.(*namespaceQuota).update(ns)
+	}
+}
+
+// runQuotaRefresher reloads the quotas and usages of namespaces periodically.
+func (cp *ControllerProxy) runQuotaRefresher(ctx context.Context) {
+	ticker := stdtime.NewTicker(quotaRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cp.refreshQuotas(ctx)
+		}
+	}
+}
+
+func (cp *ControllerProxy) refreshQuotas(ctx context.Context) {
+	cp.quotas.Range(func(key, _ interface{}) bool {
+		name, _ := key.(string)
+		err := cp.loadNamespaceQuota(ctx, name)
+		if errors.Is(err, errors.ErrResourceNotFound) {
+			cp.quotas.Delete(name)
+		} else if err != nil {
+			log.Warning(ctx, "failed to refresh quota of namespace", map[string]interface{}{
+				log.KeyError:     err,
+				log.KeyNamespace: name,
+			})
+		}
+		return true
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	stdtime "time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/pkg/errors"
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+)
+
+func TestNamespaceQuota_Check(t *testing.T) {
+	Convey("test namespace quota check", t, func() {
+		now := stdtime.Now()
+
+		Convey("test unlimited", func() {
+			q := newNamespaceQuota(&metapb.Namespace{Name: "test"})
+			So(q.check(now, 1000, 1<<20), ShouldBeEmpty)
+		})
+
+		Convey("test events per second", func() {
+			q := newNamespaceQuota(&metapb.Namespace{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventsPerSecond: 10},
+			})
+			So(q.check(now, 8, 0), ShouldBeEmpty)
+			So(q.check(now, 3, 0), ShouldEqual, primitive.QuotaEventsPerSecond)
+			So(q.check(now, 2, 0), ShouldBeEmpty)
+			So(q.check(now.Add(stdtime.Second), 10, 0), ShouldBeEmpty)
+		})
+
+		Convey("test batch larger than the burst", func() {
+			q := newNamespaceQuota(&metapb.Namespace{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventsPerSecond: 10},
+			})
+			So(q.check(now, 1, 0), ShouldBeEmpty)
+			// the bucket isn't full
+			So(q.check(now, 25, 0), ShouldEqual, primitive.QuotaEventsPerSecond)
+			So(q.check(now.Add(stdtime.Second), 25, 0), ShouldBeEmpty)
+			// the exceeded 15 events are paid off in 1.5 seconds
+			So(q.check(now.Add(2*stdtime.Second), 1, 0), ShouldEqual, primitive.QuotaEventsPerSecond)
+			So(q.check(now.Add(2600*stdtime.Millisecond), 1, 0), ShouldBeEmpty)
+		})
+
+		Convey("test bytes per second", func() {
+			q := newNamespaceQuota(&metapb.Namespace{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxEventsPerSecond: 10, MaxBytesPerSecond: 100},
+			})
+			So(q.check(now, 1, 50), ShouldBeEmpty)
+			So(q.check(now, 1, 60), ShouldEqual, primitive.QuotaBytesPerSecond)
+			// the reservation of events is canceled
+			So(q.check(now, 9, 50), ShouldBeEmpty)
+		})
+
+		Convey("test retained bytes", func() {
+			ns := &metapb.Namespace{
+				Name:  "test",
+				Quota: &metapb.NamespaceQuota{MaxRetainedBytes: 100},
+				Usage: &metapb.NamespaceUsage{RetainedBytes: 100},
+			}
+			q := newNamespaceQuota(ns)
+			So(q.check(now, 1, 1), ShouldEqual, primitive.QuotaRetainedBytes)
+			ns.Usage.RetainedBytes = 10
+			q.update(ns)
+			So(q.check(now, 1, 1), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestControllerProxy_CheckQuota(t *testing.T) {
+	Convey("test check quota", t, func() {
+		cp := NewControllerProxy(Config{Endpoints: []string{"127.0.0.1:20001"}})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		eventbusCtrl := ctrlpb.NewMockEventbusControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+		ctx := stdCtx.Background()
+
+		ebID := vanus.NewTestID()
+		eventbusCtrl.EXPECT().GetEventbus(gomock.Any(), gomock.Any()).Times(1).
+			Return(&metapb.Eventbus{Id: ebID.Uint64(), Namespace: "test"}, nil)
+		eventbusCtrl.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Times(1).Return(&metapb.Namespace{
+			Name:  "test",
+			Quota: &metapb.NamespaceQuota{MaxEventsPerSecond: 1},
+		}, nil)
+		events := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{{Id: "1"}}}
+
+		// allowed before the quota is loaded
+		So(cp.checkQuota(ctx, ebID, events), ShouldBeNil)
+		So(cp.checkQuota(ctx, ebID, events), ShouldBeNil)
+		So(cp.checkQuota(ctx, ebID, events), ShouldBeNil)
+		for {
+			if v, ok := cp.namespaces.Load(ebID); ok && v.(string) != "" {
+				break
+			}
+			stdtime.Sleep(stdtime.Millisecond)
+		}
+		So(cp.checkQuota(ctx, ebID, events), ShouldBeNil)
+		err := cp.checkQuota(ctx, ebID, events)
+		So(errors.Is(err, errors.ErrQuotaExceeded), ShouldBeTrue)
+
+		cp.invalidateEventbus(ebID)
+		_, ok := cp.namespaces.Load(ebID)
+		So(ok, ShouldBeFalse)
+	})
+}
@@ -19,6 +19,22 @@ const (
 	TimerEventbusName        = SystemEventbusNamePrefix + "Timer_RS"
 	RetryEventbusName        = SystemEventbusNamePrefix + "retry_eb"
 
+	// DefaultNamespace is used when the namespace of an eventbus or subscription isn't specified.
+	DefaultNamespace = "default"
+	// SystemNamespace is the namespace of system eventbuses.
+	SystemNamespace = "system"
+
+	// the names of namespace quota, they are used in the rejected reason and metrics.
+	QuotaEventbuses      = "max_eventbuses"
+	QuotaSubscriptions   = "max_subscriptions"
+	QuotaEventsPerSecond = "max_events_per_second"
+	QuotaBytesPerSecond  = "max_bytes_per_second"
+	QuotaRetainedBytes   = "max_retained_bytes"
+
+	// the states of decommissioning volume, it can be removed once it's drained.
+	VolumeStateDraining = "draining"
+	VolumeStateDrained  = "drained"
+
 	XVanus               = "xvanus"
 	XVanusEventbus       = XVanus + "eventbus"
 	XVanusDeliveryTime   = XVanus + "deliverytime"
@@ -0,0 +1,81 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	KafkaSchema       = "kafka"
+	KafkaSecureSchema = "kafkas"
+
+	// KafkaBinaryMode puts the attributes of event into the headers prefixed with "ce_" and data into the value.
+	KafkaBinaryMode = "binary"
+	// KafkaStructuredMode puts the whole event encoded in JSON into the value.
+	KafkaStructuredMode = "structured"
+)
+
+var ErrInvalidKafkaSink = errors.New("invalid kafka sink, " +
+	"format is kafka://<broker>[,<broker>]/<topic>[?key=<attribute>&mode=binary|structured]")
+
+// KafkaSink is the sink of kafka protocol.
+type KafkaSink struct {
+	Brokers []string
+	Topic   string
+	// Key is the attribute of event which is used as the message key, empty means no key.
+	Key  string
+	Mode string
+	// Secure means the connection is secured by TLS.
+	Secure bool
+}
+
+// ParseKafkaSink parses the sink like kafka://broker1:9092,broker2:9092/topic?key=subject&mode=structured,
+// the connection is secured by TLS if scheme is kafkas.
+func ParseKafkaSink(sink string) (*KafkaSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKafkaSink, err.Error())
+	}
+	s := &KafkaSink{Mode: KafkaBinaryMode}
+	switch u.Scheme {
+	case KafkaSchema:
+	case KafkaSecureSchema:
+		s.Secure = true
+	default:
+		return nil, ErrInvalidKafkaSink
+	}
+	for _, broker := range strings.Split(u.Host, ",") {
+		if broker != "" {
+			s.Brokers = append(s.Brokers, broker)
+		}
+	}
+	s.Topic = strings.Trim(u.Path, "/")
+	if len(s.Brokers) == 0 || s.Topic == "" || strings.Contains(s.Topic, "/") {
+		return nil, ErrInvalidKafkaSink
+	}
+	query := u.Query()
+	s.Key = query.Get("key")
+	if mode := query.Get("mode"); mode != "" {
+		if mode != KafkaBinaryMode && mode != KafkaStructuredMode {
+			return nil, ErrInvalidKafkaSink
+		}
+		s.Mode = mode
+	}
+	return s, nil
+}
@@ -20,6 +20,7 @@ const (
 	Plain  CredentialType = "plain"
 	AWS    CredentialType = "aws"
 	GCloud CredentialType = "gcloud"
+	HTTP   CredentialType = "http"
 
 	SecretsMask = "******"
 )
@@ -60,6 +61,22 @@ func FillSinkCredential(dst, src SinkCredential) {
 		if _dst.CredentialJSON == SecretsMask {
 			_dst.CredentialJSON = _src.CredentialJSON
 		}
+	case HTTP:
+		_dst, _ := dst.(*HTTPSinkCredential)
+		_src, _ := src.(*HTTPSinkCredential)
+		fillMasked(&_dst.Username, _src.Username)
+		fillMasked(&_dst.Password, _src.Password)
+		fillMasked(&_dst.Token, _src.Token)
+		fillMasked(&_dst.HMACSecret, _src.HMACSecret)
+		fillMasked(&_dst.ClientCert, _src.ClientCert)
+		fillMasked(&_dst.ClientKey, _src.ClientKey)
+		fillMasked(&_dst.CACert, _src.CACert)
+	}
+}
+
+func fillMasked(dst *string, src string) {
+	if *dst == SecretsMask {
+		*dst = src
 	}
 }
 
@@ -108,3 +125,29 @@ func NewGCloudSinkCredential(credentialJSON string) SinkCredential {
 func (c *GCloudSinkCredential) GetType() CredentialType {
 	return GCloud
 }
+
+type HTTPAuthType string
+
+const (
+	HTTPAuthNone   HTTPAuthType = ""
+	HTTPAuthBasic  HTTPAuthType = "basic"
+	HTTPAuthBearer HTTPAuthType = "bearer"
+	HTTPAuthHMAC   HTTPAuthType = "hmac"
+)
+
+type HTTPSinkCredential struct {
+	AuthType HTTPAuthType `json:"auth_type,omitempty"`
+	Username string       `json:"username,omitempty"`
+	Password string       `json:"password,omitempty"`
+	Token    string       `json:"token,omitempty"`
+	// the key of HMAC-SHA256 signature
+	HMACSecret string `json:"hmac_secret,omitempty"`
+	// PEM encoded
+	ClientCert string `json:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty"`
+	CACert     string `json:"ca_cert,omitempty"`
+}
+
+func (c *HTTPSinkCredential) GetType() CredentialType {
+	return HTTP
+}
@@ -53,6 +53,7 @@ const (
 	AwsLambdaProtocol Protocol = "aws-lambda"
 	GCloudFunctions   Protocol = "gcloud-functions"
 	GRPC              Protocol = "grpc"
+	KafkaProtocol     Protocol = "kafka"
 )
 
 type ProtocolSetting struct {
@@ -77,6 +78,52 @@ type SubscriptionConfig struct {
 	DisableDeadLetter bool       `json:"disable_dead_letter,omitempty"`
 	// send event with ordered
 	OrderedEvent bool `json:"ordered_event"`
+	// the attribute which ordered events are scoped to, empty means whole subscription
+	OrderedKey  string       `json:"ordered_key,omitempty"`
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+}
+
+type BackoffType string
+
+const (
+	// DefaultBackoff is the built-in backoff curve: 1s, 5s, 10s, 30s, 60s ... 3600s.
+	DefaultBackoff     BackoffType = ""
+	FixedBackoff       BackoffType = "fixed"
+	LinearBackoff      BackoffType = "linear"
+	ExponentialBackoff BackoffType = "exponential"
+)
+
+// IsValid reports whether the backoff type is known.
+func (t BackoffType) IsValid() bool {
+	switch t {
+	case DefaultBackoff, FixedBackoff, LinearBackoff, ExponentialBackoff:
+		return true
+	}
+	return false
+}
+
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed means events are delivered to sink normally.
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+	// CircuitBreakerOpen means sink is considered down, and delivery is paused.
+	CircuitBreakerOpen CircuitBreakerState = "open"
+	// CircuitBreakerHalfOpen means sink is probed with single event.
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+)
+
+type RetryPolicy struct {
+	BackoffType BackoffType `json:"backoff_type,omitempty"`
+	// unit milliseconds
+	InitialDelay uint32  `json:"initial_delay,omitempty"`
+	Multiplier   float64 `json:"multiplier,omitempty"`
+	// unit milliseconds, 0 means no limit
+	MaxDelay uint32 `json:"max_delay,omitempty"`
+	// the random ratio of delay in [0, 1]
+	Jitter            float64 `json:"jitter,omitempty"`
+	RetriableCodes    []int32 `json:"retriable_codes,omitempty"`
+	NonRetriableCodes []int32 `json:"non_retriable_codes,omitempty"`
 }
 
 // GetMaxRetryAttempts return MaxRetryAttempts if nil return -1.
@@ -18,12 +18,18 @@ import (
 	// standard libraries.
 	"context"
 	"errors"
+	"hash/fnv"
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
 
-var ErrSnapshotOutOfOrder = errors.New("the snapshot is out of order")
+var (
+	ErrSnapshotOutOfOrder = errors.New("the snapshot is out of order")
+	ErrNotArchived        = errors.New("the block is not archived")
+	ErrOffloaded          = errors.New("the block is offloaded")
+	ErrSnapshotIncomplete = errors.New("the snapshot is incomplete")
+)
 
 type AppendContext interface {
 	WriteOffset() int64
@@ -44,11 +50,98 @@ type Snapshoter interface {
 	ApplySnapshot(ctx context.Context, snap Fragment) error
 }
 
+// ChunkedSnapshoter transfers the snapshot in chunks, so the whole written region of Block needn't be loaded into
+// memory at once.
+type ChunkedSnapshoter interface {
+	// SnapshotRange returns the range of snapshot data, [start, end).
+	SnapshotRange(ctx context.Context) (start, end int64)
+	// ReadSnapshotAt reads the snapshot data at off.
+	ReadSnapshotAt(ctx context.Context, buf []byte, off int64) (int, error)
+	// WriteSnapshotAt writes the snapshot data received at off, and returns the offset of next data it expects.
+	// The data is ignored if off isn't expected, and it isn't readable until ApplySnapshotRange is called.
+	WriteSnapshotAt(ctx context.Context, data []byte, off int64) (int64, error)
+	// ApplySnapshotRange makes the snapshot data before end readable.
+	ApplySnapshotRange(ctx context.Context, end int64) error
+}
+
+// CompactionPolicy decides which entries are obsolete when a block is compacted.
+type CompactionPolicy struct {
+	// KeyAttribute is the attribute of Entry which is used as the key, entries without it are always retained.
+	KeyAttribute string
+	// TombstoneDeadline is a millisecond timestamp, the tombstones (entries with key but without data) which are
+	// written to Block before it are removed.
+	TombstoneDeadline int64
+	// NewerKeys are the hashes of keys of entries in the Blocks which are newer than this one, the entries with
+	// these keys are obsolete.
+	NewerKeys map[KeyHash]struct{}
+}
+
+// KeyHashSize is the size of KeyHash.
+const KeyHashSize = 16
+
+// KeyHash is the fixed-size hash of the key of Entry, the keys are compared by their hashes across Blocks. It's
+// 128 bits, so that an entry is hardly removed by the collision of keys.
+type KeyHash [KeyHashSize]byte
+
+// HashKey returns the KeyHash of key.
+func HashKey(key []byte) KeyHash {
+	h := fnv.New128a()
+	_, _ = h.Write(key)
+	var kh KeyHash
+	h.Sum(kh[:0])
+	return kh
+}
+
+// Compactor removes the obsolete entries of an archived Block, the sequence numbers of retained entries are kept.
+// It returns the hashes of distinct keys of entries in the Block, which are the newer keys of the older Blocks.
+type Compactor interface {
+	Compact(ctx context.Context, policy CompactionPolicy) (Statistics, []KeyHash, error)
+}
+
+// Offloader moves the entries of an archived Block to object storage, the Block is still readable after offloaded.
+type Offloader interface {
+	// Offload uploads the Block as object key, the upload is skipped if the object has been uploaded by another
+	// replica of the segment.
+	Offload(ctx context.Context, key string) error
+	// DeleteObject deletes the object which the Block is offloaded to. The object is shared by all replicas of the
+	// segment, so it's deleted only when the segment is deleted, rather than when a replica is removed.
+	DeleteObject(ctx context.Context) error
+}
+
+// Compression is the algorithm used to compress the data of entries, the values are the same as
+// meta.CompressAlgorithm.
+type Compression uint16
+
+const (
+	CompressionNone Compression = iota
+	CompressionLZ4
+	CompressionZstd
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionLZ4:
+		return "lz4"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "unknown"
+}
+
+// Compressor compresses the data of entries which are appended to Block later, the entries have been appended
+// are not changed, so the compressed and uncompressed entries may be mixed in a Block.
+type Compressor interface {
+	SetCompression(ctx context.Context, c Compression) error
+}
+
 type Raw interface {
 	Seeker
 	Reader
 	TwoPCAppender
 	Snapshoter
+	ChunkedSnapshoter
 
 	ID() vanus.ID
 
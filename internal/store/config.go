@@ -33,6 +33,7 @@ type Config struct {
 	OffsetStore         config.AsyncStore    `yaml:"offset_store"`
 	Raft                config.Raft          `yaml:"raft"`
 	VSB                 config.VSB           `yaml:"vsb"`
+	ObjectStore         config.ObjectStore   `yaml:"object_store"`
 	Observability       observability.Config `yaml:"observability"`
 }
 
@@ -49,6 +50,9 @@ func (c *Config) Validate() error {
 	if err := c.VSB.Validate(); err != nil {
 		return err
 	}
+	if err := c.ObjectStore.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -56,6 +60,9 @@ type VolumeInfo struct {
 	ID       uint16 `json:"id"`
 	Dir      string `json:"dir"`
 	Capacity uint64 `json:"capacity"`
+	// Labels describe where the volume is located, such as zone and rack, the controller spreads the replicas of
+	// a segment across volumes with different labels.
+	Labels map[string]string `json:"labels"`
 }
 
 func InitConfig(filename string) (*Config, error) {
@@ -0,0 +1,82 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/objstore"
+)
+
+const (
+	objectStoreLocal = "local"
+	objectStoreS3    = "s3"
+)
+
+type S3 struct {
+	Endpoint        string `yaml:"endpoint"`
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	Prefix          string `yaml:"prefix"`
+}
+
+// ObjectStore is the object storage which archived blocks are offloaded to, offloading is disabled if the type
+// is empty.
+type ObjectStore struct {
+	Type string `yaml:"type"`
+	// Dir is the directory of local object storage.
+	Dir string `yaml:"dir"`
+	S3  S3     `yaml:"s3"`
+}
+
+func (c *ObjectStore) Validate() error {
+	switch c.Type {
+	case "":
+	case objectStoreLocal:
+		if c.Dir == "" {
+			return fmt.Errorf("local object store dir must be set")
+		}
+	case objectStoreS3:
+		if c.S3.Endpoint == "" || c.S3.Bucket == "" {
+			return fmt.Errorf("s3 object store endpoint and bucket must be set")
+		}
+	default:
+		return fmt.Errorf("unknown object store type: %s", c.Type)
+	}
+	return nil
+}
+
+// Build returns the object storage, or nil if offloading is disabled.
+func (c *ObjectStore) Build() (objstore.Store, error) {
+	switch c.Type {
+	case objectStoreLocal:
+		return objstore.NewLocal(c.Dir)
+	case objectStoreS3:
+		return objstore.NewS3(objstore.S3Config{
+			Endpoint:        c.S3.Endpoint,
+			Region:          c.S3.Region,
+			Bucket:          c.S3.Bucket,
+			AccessKeyID:     c.S3.AccessKeyID,
+			SecretAccessKey: c.S3.SecretAccessKey,
+			Prefix:          c.S3.Prefix,
+		})
+	default:
+		return nil, nil //nolint:nilnil // nil means offloading is disabled
+	}
+}
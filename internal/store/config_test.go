@@ -56,6 +56,11 @@ raft:
   wal:
     io:
       engine: io_uring
+object_store:
+  type: s3
+  s3:
+    endpoint: http://127.0.0.1:9000
+    bucket: vanus
 `)
 		So(err, ShouldBeNil)
 
@@ -80,6 +85,10 @@ raft:
 		So(cfg.OffsetStore.WAL.IO.Engine, ShouldEqual, "")
 		So(len(cfg.OffsetStore.WAL.Options()), ShouldEqual, 0)
 
+		So(cfg.ObjectStore.Type, ShouldEqual, "s3")
+		So(cfg.ObjectStore.S3.Endpoint, ShouldEqual, "http://127.0.0.1:9000")
+		So(cfg.ObjectStore.S3.Bucket, ShouldEqual, "vanus")
+
 		So(cfg.Raft.WAL.IO.Engine, ShouldEqual, config.Uring)
 		if runtime.GOOS == "linux" {
 			So(len(cfg.Raft.WAL.Options()), ShouldEqual, 1)
@@ -111,6 +120,12 @@ raft:
 		err = cfg.Validate()
 		So(err, ShouldNotBeNil)
 
+		cfg = Config{
+			ObjectStore: config.ObjectStore{Type: "local"},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
+
 		cfg = Config{
 			Raft: config.Raft{
 				WAL: config.WAL{
@@ -0,0 +1,122 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	defaultDirPerm  = 0o755
+	defaultFilePerm = 0o644
+	tmpExt          = ".tmp"
+)
+
+// local is a Store backed by the local filesystem, each object is a file in dir. It's useful for testing and
+// for the deployments with a shared filesystem.
+type local struct {
+	dir string
+}
+
+// Make sure local implements Store.
+var _ Store = (*local)(nil)
+
+func NewLocal(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	return &local{dir: dir}, nil
+}
+
+func (l *local) resolvePath(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+func (l *local) Put(_ context.Context, key string, r io.Reader, size int64) error {
+	path, err := l.resolvePath(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), defaultDirPerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a partial object is never visible.
+	tmp := path + tmpExt
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFilePerm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(f, r, size); err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (l *local) Size(_ context.Context, key string) (int64, error) {
+	path, err := l.resolvePath(key)
+	if err != nil {
+		return 0, err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	return fi.Size(), nil
+}
+
+func (l *local) ReadAt(_ context.Context, key string, p []byte, off int64) (int, error) {
+	path, err := l.resolvePath(key)
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+	defer f.Close()
+	return f.ReadAt(p, off)
+}
+
+func (l *local) Delete(_ context.Context, key string) error {
+	path, err := l.resolvePath(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
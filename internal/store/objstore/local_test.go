@@ -0,0 +1,67 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testStore(ctx context.Context, s Store) {
+	data := []byte("hello, object storage")
+
+	_, err := s.Size(ctx, "a/b")
+	So(err, ShouldEqual, ErrNotFound)
+	So(s.Put(ctx, "../b", bytes.NewReader(data), int64(len(data))), ShouldNotBeNil)
+
+	So(s.Put(ctx, "a/b", bytes.NewReader(data), int64(len(data))), ShouldBeNil)
+	size, err := s.Size(ctx, "a/b")
+	So(err, ShouldBeNil)
+	So(size, ShouldEqual, len(data))
+
+	buf := make([]byte, 6)
+	n, err := s.ReadAt(ctx, "a/b", buf, 7)
+	So(err, ShouldBeNil)
+	So(n, ShouldEqual, 6)
+	So(string(buf), ShouldEqual, "object")
+
+	n, err = s.ReadAt(ctx, "a/b", buf, int64(len(data))-3)
+	So(err, ShouldEqual, io.EOF)
+	So(string(buf[:n]), ShouldEqual, "age")
+
+	So(s.Delete(ctx, "a/b"), ShouldBeNil)
+	So(s.Delete(ctx, "a/b"), ShouldBeNil)
+	_, err = s.ReadAt(ctx, "a/b", buf, 0)
+	So(err, ShouldEqual, ErrNotFound)
+}
+
+func TestLocal(t *testing.T) {
+	Convey("local object storage", t, func() {
+		dir, err := os.MkdirTemp("", "objstore-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		s, err := NewLocal(dir)
+		So(err, ShouldBeNil)
+		testStore(context.Background(), s)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package objstore provides the object storages which archived blocks are offloaded to.
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+var ErrNotFound = errors.New("objstore: object not found")
+
+// Store is an object storage, the objects are immutable once they are put.
+type Store interface {
+	// Put uploads size bytes read from r as the object key, the existing object is overwritten.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Size returns the size of the object key, or ErrNotFound if the object doesn't exist.
+	Size(ctx context.Context, key string) (int64, error)
+	// ReadAt reads len(p) bytes of the object key from offset off, it follows the semantics of io.ReaderAt.
+	ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error)
+	// Delete removes the object key, it's not an error if the object doesn't exist.
+	Delete(ctx context.Context, key string) error
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "..") {
+		return errors.New("objstore: invalid key " + key)
+	}
+	return nil
+}
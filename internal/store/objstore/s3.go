@@ -0,0 +1,207 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	// third-party libraries.
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	s3Service          = "s3"
+	contentSHA256      = "X-Amz-Content-Sha256"
+	unsignedPayload    = "UNSIGNED-PAYLOAD"
+	emptyPayloadSHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	maxErrorBodySize   = 1024
+)
+
+type S3Config struct {
+	// Endpoint is the URL of the S3-compatible service, e.g. https://s3.us-west-2.amazonaws.com.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Prefix is prepended to the keys of objects.
+	Prefix string
+}
+
+// s3 is a Store backed by an S3-compatible service, the objects are addressed in path style, so it works with
+// most of the compatible services, e.g. MinIO.
+type s3 struct {
+	endpoint string
+	region   string
+	bucket   string
+	prefix   string
+	creds    aws.Credentials
+	signer   *v4.Signer
+	client   *http.Client
+}
+
+// Make sure s3 implements Store.
+var _ Store = (*s3)(nil)
+
+func NewS3(cfg S3Config) (Store, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("objstore: invalid endpoint %s", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("objstore: bucket is empty")
+	}
+	return &s3{
+		endpoint: strings.TrimSuffix(cfg.Endpoint, "/"),
+		region:   cfg.Region,
+		bucket:   cfg.Bucket,
+		prefix:   cfg.Prefix,
+		creds: aws.Credentials{
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+		},
+		signer: v4.NewSigner(),
+		client: &http.Client{},
+	}, nil
+}
+
+func (s *s3) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, io.LimitReader(r, size), unsignedPayload)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return responseError(req, resp)
+	}
+	return nil
+}
+
+func (s *s3) Size(ctx context.Context, key string) (int64, error) {
+	req, err := s.newRequest(ctx, http.MethodHead, key, nil, emptyPayloadSHA256)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.ContentLength, nil
+	case http.StatusNotFound:
+		return 0, ErrNotFound
+	default:
+		return 0, responseError(req, resp)
+	}
+}
+
+func (s *s3) ReadAt(ctx context.Context, key string, p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, emptyPayloadSHA256)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The range is ignored by server, skip to offset.
+		if _, err = io.CopyN(io.Discard, resp.Body, off); err != nil {
+			return 0, io.EOF
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		return 0, io.EOF
+	case http.StatusNotFound:
+		return 0, ErrNotFound
+	default:
+		return 0, responseError(req, resp)
+	}
+	n, err := io.ReadFull(resp.Body, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (s *s3) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil, emptyPayloadSHA256)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return responseError(req, resp)
+	}
+}
+
+func (s *s3) newRequest(
+	ctx context.Context, method, key string, body io.Reader, payloadHash string,
+) (*http.Request, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	segments := strings.Split(s.prefix+key, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	u := s.endpoint + "/" + url.PathEscape(s.bucket) + "/" + strings.Join(segments, "/")
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(contentSHA256, payloadHash)
+	if err = s.signer.SignHTTP(ctx, s.creds, req, payloadHash, s3Service, s.region, time.Now()); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func responseError(req *http.Request, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return fmt.Errorf("objstore: %s %s: %s: %s", req.Method, req.URL.Path, resp.Status,
+		strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newS3StandIn returns a minimal S3-compatible server which stores objects in s.
+func newS3StandIn(s Store, bucket string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") ||
+			r.Header.Get(contentSHA256) == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/"+bucket+"/")
+		ctx := r.Context()
+		switch r.Method {
+		case http.MethodPut:
+			if err := s.Put(ctx, key, r.Body, r.ContentLength); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		case http.MethodHead, http.MethodGet:
+			size, err := s.Size(ctx, key)
+			if errors.Is(err, ErrNotFound) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+			if r.Method == http.MethodHead {
+				return
+			}
+			from, to, err := parseRange(r.Header.Get("Range"))
+			if err != nil || from >= size {
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			if to >= size {
+				to = size - 1
+			}
+			buf := make([]byte, to-from+1)
+			_, _ = s.ReadAt(ctx, key, buf, from)
+			w.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(buf)
+		case http.MethodDelete:
+			_ = s.Delete(ctx, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func parseRange(rng string) (from, to int64, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(rng, "bytes="), "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	if from, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return 0, 0, err
+	}
+	if to, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return 0, 0, err
+	}
+	return from, to, nil
+}
+
+func TestS3(t *testing.T) {
+	Convey("s3 object storage", t, func() {
+		dir, err := os.MkdirTemp("", "objstore-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		backend, err := NewLocal(dir)
+		So(err, ShouldBeNil)
+		srv := newS3StandIn(backend, "vanus")
+		defer srv.Close()
+
+		_, err = NewS3(S3Config{Endpoint: "s3.amazonaws.com", Bucket: "vanus"})
+		So(err, ShouldNotBeNil)
+		_, err = NewS3(S3Config{Endpoint: srv.URL})
+		So(err, ShouldNotBeNil)
+
+		s, err := NewS3(S3Config{
+			Endpoint:        srv.URL,
+			Region:          "us-east-1",
+			Bucket:          "vanus",
+			AccessKeyID:     "ak",
+			SecretAccessKey: "sk",
+			Prefix:          "blocks/",
+		})
+		So(err, ShouldBeNil)
+		testStore(context.Background(), s)
+	})
+}
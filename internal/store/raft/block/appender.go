@@ -67,7 +67,11 @@ type Appender interface {
 	Stop(ctx context.Context)
 	Delete(ctx context.Context)
 	Bootstrap(ctx context.Context, blocks []Peer) error
+	AddPeer(ctx context.Context, peer Peer) error
+	RemovePeer(ctx context.Context, id vanus.ID) error
+	TransferLeader(ctx context.Context, transferee vanus.ID) error
 	Status() ClusterStatus
+	ReplicationStatus() (ReplicationStatus, error)
 }
 
 type appender struct {
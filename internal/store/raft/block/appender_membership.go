@@ -0,0 +1,199 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/raft"
+	"github.com/vanus-labs/vanus/raft/raftpb"
+	"github.com/vanus-labs/vanus/raft/tracker"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+)
+
+// ErrNotMember is returned if the leadership is transferred to a peer which isn't in the raft group.
+var ErrNotMember = errors.New("the peer is not a member of raft group")
+
+type PeerState string
+
+const (
+	PeerStateProbe     = PeerState("probe")
+	PeerStateReplicate = PeerState("replicate")
+	PeerStateSnapshot  = PeerState("snapshot")
+)
+
+type PeerProgress struct {
+	ID vanus.ID
+	// Match is the index of raft log which has been replicated to the peer.
+	Match uint64
+	State PeerState
+}
+
+type ReplicationStatus struct {
+	Commit uint64
+	Peers  []PeerProgress
+}
+
+// AddPeer adds peer to the raft group, the peer catches up with the leader by log entries, or by snapshot if the
+// log has been compacted. It returns after the change is committed, or immediately if peer is already a member.
+func (a *appender) AddPeer(ctx context.Context, peer Peer) error {
+	_ = a.e.RegisterNodeRecord(peer.ID.Uint64(), peer.Endpoint)
+	return a.proposeConfChange(ctx, raftpb.ConfChange{
+		Type:    raftpb.ConfChangeAddNode,
+		NodeID:  peer.ID.Uint64(),
+		Context: []byte(peer.Endpoint),
+	})
+}
+
+// RemovePeer removes peer from the raft group. It returns after the change is committed, or immediately if peer is
+// not a member.
+func (a *appender) RemovePeer(ctx context.Context, id vanus.ID) error {
+	return a.proposeConfChange(ctx, raftpb.ConfChange{
+		Type:   raftpb.ConfChangeRemoveNode,
+		NodeID: id.Uint64(),
+	})
+}
+
+func (a *appender) proposeConfChange(ctx context.Context, cc raftpb.ConfChange) error {
+	data, err := cc.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan error, 1)
+	ok := a.raftExecutor.Execute(func() {
+		if !a.isLeader() {
+			ch <- block.ErrNotLeader
+			return
+		}
+
+		_, member := a.node.Status().Config.Voters.IDs()[cc.NodeID]
+		if member == (cc.Type == raftpb.ConfChangeAddNode) {
+			ch <- nil
+			return
+		}
+
+		log.Info(ctx, "Propose conf change.", map[string]interface{}{
+			"node_id": a.ID(),
+			"type":    cc.Type.String(),
+			"peer":    vanus.NewIDFromUint64(cc.NodeID),
+		})
+
+		// NOTE: the conf change is replaced by an empty entry if there is another one pending, so the caller
+		// should check the membership by ReplicationStatus.
+		a.node.Propose(raft.ProposeData{
+			Type: raftpb.EntryConfChange,
+			Data: data,
+			Callback: func(err error) {
+				ch <- err
+			},
+		})
+	})
+	if !ok {
+		return raft.ErrStopped
+	}
+
+	select {
+	case err = <-ch:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TransferLeader transfers the leadership to transferee, it returns before the transfer is done. The transfer is
+// aborted if transferee can't catch up with the leader in an election timeout.
+func (a *appender) TransferLeader(ctx context.Context, transferee vanus.ID) error {
+	ch := make(chan error, 1)
+	ok := a.raftExecutor.Execute(func() {
+		if !a.isLeader() {
+			ch <- block.ErrNotLeader
+			return
+		}
+
+		if _, member := a.node.Status().Config.Voters.IDs()[transferee.Uint64()]; !member {
+			ch <- ErrNotMember
+			return
+		}
+
+		log.Info(ctx, "Transfer leadership.", map[string]interface{}{
+			"node_id":    a.ID(),
+			"transferee": transferee,
+		})
+
+		a.node.TransferLeader(transferee.Uint64())
+		ch <- nil
+	})
+	if !ok {
+		return raft.ErrStopped
+	}
+
+	select {
+	case err := <-ch:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ReplicationStatus returns the replication progress of all peers, it only works on the leader.
+func (a *appender) ReplicationStatus() (ReplicationStatus, error) {
+	ch := make(chan ReplicationStatus, 1)
+	ok := a.raftExecutor.Execute(func() {
+		if !a.isLeader() {
+			close(ch)
+			return
+		}
+
+		st := ReplicationStatus{
+			Commit: a.node.BasicStatus().Commit,
+		}
+		a.node.WithProgress(func(id uint64, _ raft.ProgressType, pr tracker.Progress) {
+			st.Peers = append(st.Peers, PeerProgress{
+				ID:    vanus.NewIDFromUint64(id),
+				Match: pr.Match,
+				State: toPeerState(pr.State),
+			})
+		})
+		ch <- st
+	})
+	if !ok {
+		return ReplicationStatus{}, raft.ErrStopped
+	}
+
+	st, ok := <-ch
+	if !ok {
+		return ReplicationStatus{}, block.ErrNotLeader
+	}
+	return st, nil
+}
+
+func toPeerState(st tracker.StateType) PeerState {
+	switch st {
+	case tracker.StateReplicate:
+		return PeerStateReplicate
+	case tracker.StateSnapshot:
+		return PeerStateSnapshot
+	default:
+		return PeerStateProbe
+	}
+}
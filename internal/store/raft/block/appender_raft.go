@@ -59,6 +59,12 @@ func (a *appender) reportUnreachable(id uint64) {
 	})
 }
 
+func (a *appender) reportSnapshot(id uint64, status raft.SnapshotStatus) {
+	a.raftExecutor.Execute(func() {
+		a.node.ReportSnapshot(id, status)
+	})
+}
+
 func (a *appender) tick() bool {
 	return a.raftExecutor.Execute(func() {
 		a.node.Tick()
@@ -17,29 +17,81 @@ package block
 import (
 	// standard libraries.
 	"context"
+	"encoding/binary"
+
+	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/store/block"
 	"github.com/vanus-labs/vanus/internal/store/raft/storage"
+	"github.com/vanus-labs/vanus/internal/store/raft/transport"
 )
 
-// Make sure appender implements storage.SnapshotOperator.
-var _ storage.SnapshotOperator = (*appender)(nil)
+const (
+	// snapshotDescriptorMagic marks the snapshot whose data is transferred in chunks. The snapshot in legacy format
+	// starts with the data offset of block, which never collides with it.
+	snapshotDescriptorMagic = uint64(0x5653425f534e4150)
+	snapshotDescriptorSize  = 24
+)
 
-func (a *appender) GetSnapshot(index uint64) ([]byte, error) {
-	ctx := context.Background()
-	snap, err := a.raw.Snapshot(ctx)
-	if err != nil {
-		return nil, err
-	}
-	data, err := block.MarshalFragment(snap)
-	if err != nil {
-		return nil, err
+// Make sure appender implements storage.SnapshotOperator and transport.SnapshotReceiver.
+var (
+	_ storage.SnapshotOperator   = (*appender)(nil)
+	_ transport.SnapshotReceiver = (*appender)(nil)
+)
+
+// snapshotDescriptor is the data of raft snapshot, the data of block in [start, end) is transferred in chunks
+// before the raft snapshot is sent.
+type snapshotDescriptor struct {
+	start int64
+	end   int64
+}
+
+func (d snapshotDescriptor) marshal() []byte {
+	data := make([]byte, snapshotDescriptorSize)
+	binary.LittleEndian.PutUint64(data, snapshotDescriptorMagic)
+	binary.LittleEndian.PutUint64(data[8:], uint64(d.start))
+	binary.LittleEndian.PutUint64(data[16:], uint64(d.end))
+	return data
+}
+
+func unmarshalSnapshotDescriptor(data []byte) (snapshotDescriptor, bool) {
+	if len(data) != snapshotDescriptorSize || binary.LittleEndian.Uint64(data) != snapshotDescriptorMagic {
+		return snapshotDescriptor{}, false
 	}
-	return data, nil
+	return snapshotDescriptor{
+		start: int64(binary.LittleEndian.Uint64(data[8:])),
+		end:   int64(binary.LittleEndian.Uint64(data[16:])),
+	}, true
+}
+
+func (a *appender) GetSnapshot(index uint64) ([]byte, error) {
+	start, end := a.raw.SnapshotRange(context.Background())
+	return snapshotDescriptor{start: start, end: end}.marshal(), nil
 }
 
 func (a *appender) ApplySnapshot(data []byte) error {
+	if desc, ok := unmarshalSnapshotDescriptor(data); ok {
+		return a.raw.ApplySnapshotRange(context.Background(), desc.end)
+	}
+
 	snap := block.NewFragment(data)
 	return a.raw.ApplySnapshot(context.Background(), snap)
 }
+
+// ReceiveSnapshot implements transport.SnapshotReceiver.
+func (a *appender) ReceiveSnapshot(ctx context.Context, chunk *vsraftpb.SnapshotChunk) (uint64, error) {
+	next, err := a.raw.WriteSnapshotAt(ctx, chunk.Data, int64(chunk.Offset))
+	return uint64(next), err
+}
+
+// snapshotReader reads the snapshot data of block, it's used by transport to send chunks.
+type snapshotReader struct {
+	ctx context.Context
+	raw block.Raw
+}
+
+func (r *snapshotReader) ReadAt(p []byte, off int64) (int, error) {
+	return r.raw.ReadSnapshotAt(r.ctx, p, off)
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
+	"github.com/vanus-labs/vanus/raft/raftpb"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/executor"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/raft/transport"
+)
+
+// memRaw keeps the snapshot data in memory, only the methods of block.ChunkedSnapshoter are implemented.
+type memRaw struct {
+	block.Raw
+	mu      sync.Mutex
+	data    []byte
+	start   int64
+	offset  int64
+	staged  int64
+	applied int
+}
+
+func (r *memRaw) SnapshotRange(ctx context.Context) (int64, int64) {
+	return r.start, r.offset
+}
+
+func (r *memRaw) ReadSnapshotAt(ctx context.Context, buf []byte, off int64) (int, error) {
+	return copy(buf, r.data[off:r.offset]), nil
+}
+
+func (r *memRaw) WriteSnapshotAt(ctx context.Context, data []byte, off int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	next := r.offset
+	if r.staged > next {
+		next = r.staged
+	}
+	end := off + int64(len(data))
+	if off > next || end <= next {
+		return next, nil
+	}
+	copy(r.data[next:], data[next-off:])
+	r.staged = end
+	return end, nil
+}
+
+func (r *memRaw) ApplySnapshotRange(ctx context.Context, end int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if end <= r.offset {
+		return nil
+	}
+	if r.staged < end {
+		return block.ErrSnapshotIncomplete
+	}
+	r.offset = end
+	r.applied++
+	return nil
+}
+
+// taskRecorder records the tasks instead of executing them.
+type taskRecorder struct {
+	tasks chan executor.Task
+}
+
+func (e *taskRecorder) Execute(t executor.Task) bool {
+	e.tasks <- t
+	return true
+}
+
+func (e *taskRecorder) Close() {}
+
+// msgReceiver forwards the chunks of snapshot to appender, and records the raft messages.
+type msgReceiver struct {
+	*appender
+	msgs chan *raftpb.Message
+}
+
+func (r *msgReceiver) Receive(ctx context.Context, msg *raftpb.Message, from uint64, endpoint string) {
+	r.msgs <- msg
+}
+
+func TestAppender_SendSnapshot(t *testing.T) {
+	Convey("test send snapshot in chunks by loopback", t, func() {
+		ctx := context.Background()
+		endpoint := "127.0.0.1:12000"
+		resolver := transport.NewSimpleResolver()
+		host := transport.NewHost(resolver, endpoint)
+
+		size := 5*1024*1024 + 321
+		leaderRaw := &memRaw{data: make([]byte, size), start: 4096, offset: int64(size)}
+		for i := range leaderRaw.data {
+			leaderRaw.data[i] = byte(i * 7)
+		}
+		followerRaw := &memRaw{data: make([]byte, size), start: 4096, offset: 4096}
+
+		reports := &taskRecorder{tasks: make(chan executor.Task, 1)}
+		leader := &appender{raw: leaderRaw, host: host, hint: map[uint64]string{}, raftExecutor: reports}
+		follower := &msgReceiver{
+			appender: &appender{raw: followerRaw, host: host},
+			msgs:     make(chan *raftpb.Message, 1),
+		}
+		host.Register(2, follower)
+		resolver.Register(2, endpoint)
+
+		data, err := leader.GetSnapshot(10)
+		So(err, ShouldBeNil)
+		desc, ok := unmarshalSnapshotDescriptor(data)
+		So(ok, ShouldBeTrue)
+		So(desc, ShouldResemble, snapshotDescriptor{start: 4096, end: int64(size)})
+
+		// The data which has been received is skipped.
+		_, err = follower.ReceiveSnapshot(ctx, &vsraftpb.SnapshotChunk{Offset: 4096, Data: leaderRaw.data[4096:10000]})
+		So(err, ShouldBeNil)
+
+		msg := raftpb.Message{
+			Type:     raftpb.MsgSnap,
+			From:     1,
+			To:       2,
+			Snapshot: raftpb.Snapshot{Data: data, Metadata: raftpb.SnapshotMetadata{Index: 10, Term: 1}},
+		}
+		leader.send(ctx, &msg)
+
+		var received *raftpb.Message
+		select {
+		case received = <-follower.msgs:
+		case <-time.After(3 * time.Second):
+		}
+		So(received, ShouldNotBeNil)
+		So(received.Type, ShouldEqual, raftpb.MsgSnap)
+		So(followerRaw.staged, ShouldEqual, size)
+		So(bytes.Equal(followerRaw.data[4096:], leaderRaw.data[4096:]), ShouldBeTrue)
+
+		// The snapshot status is reported to raft.
+		select {
+		case <-reports.tasks:
+		case <-time.After(3 * time.Second):
+			So(false, ShouldBeTrue)
+		}
+
+		So(follower.ApplySnapshot(received.Snapshot.Data), ShouldBeNil)
+		So(followerRaw.offset, ShouldEqual, size)
+		So(followerRaw.applied, ShouldEqual, 1)
+		So(follower.ApplySnapshot(received.Snapshot.Data), ShouldBeNil)
+		So(followerRaw.applied, ShouldEqual, 1)
+	})
+}
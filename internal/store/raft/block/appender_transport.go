@@ -17,9 +17,11 @@ package block
 import (
 	// standard libraries.
 	"context"
+	"time"
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/raft"
 	"github.com/vanus-labs/vanus/raft/raftpb"
 
 	// this project.
@@ -29,9 +31,17 @@ import (
 // Make sure appender implements transport.Receiver.
 var _ transport.Receiver = (*appender)(nil)
 
+const defaultSnapshotTimeout = 10 * time.Minute
+
 func (a *appender) send(ctx context.Context, msg *raftpb.Message) {
 	to := msg.To
 	endpoint := a.hint[to]
+	if msg.Type == raftpb.MsgSnap {
+		if desc, ok := unmarshalSnapshotDescriptor(msg.Snapshot.Data); ok {
+			go a.sendSnapshot(*msg, desc, endpoint)
+			return
+		}
+	}
 	a.host.Send(ctx, msg, to, endpoint, func(err error) {
 		if err != nil {
 			log.Warning(ctx, "send message failed", map[string]interface{}{
@@ -44,6 +54,47 @@ func (a *appender) send(ctx context.Context, msg *raftpb.Message) {
 	})
 }
 
+// sendSnapshot transfers the snapshot data in chunks, then sends the raft message to make the receiver apply it.
+func (a *appender) sendSnapshot(msg raftpb.Message, desc snapshotDescriptor, endpoint string) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultSnapshotTimeout)
+	defer cancel()
+
+	to := msg.To
+	snap := &transport.Snapshot{
+		From:   msg.From,
+		To:     to,
+		Start:  uint64(desc.start),
+		End:    uint64(desc.end),
+		Reader: &snapshotReader{ctx: ctx, raw: a.raw},
+	}
+	if err := a.host.SendSnapshot(ctx, snap, endpoint); err != nil {
+		log.Warning(ctx, "send snapshot failed", map[string]interface{}{
+			log.KeyError: err,
+			"to":         to,
+			"endpoint":   endpoint,
+			"start":      desc.start,
+			"end":        desc.end,
+		})
+		a.reportSnapshot(to, raft.SnapshotFailure)
+		return
+	}
+
+	sendCtx, sendCancel := context.WithTimeout(ctx, defaultSendTimeout)
+	defer sendCancel()
+	a.host.Send(sendCtx, &msg, to, endpoint, func(err error) {
+		if err != nil {
+			log.Warning(ctx, "send snapshot message failed", map[string]interface{}{
+				log.KeyError: err,
+				"to":         to,
+				"endpoint":   endpoint,
+			})
+			a.reportSnapshot(to, raft.SnapshotFailure)
+			return
+		}
+		a.reportSnapshot(to, raft.SnapshotFinish)
+	})
+}
+
 // Receive implements transport.Receiver.
 func (a *appender) Receive(ctx context.Context, msg *raftpb.Message, from uint64, endpoint string) {
 	a.transportExecutor.Execute(func() {
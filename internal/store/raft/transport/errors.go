@@ -18,3 +18,5 @@ import "errors"
 
 var ErrNotReachable = errors.New("raft node unreachable")
 var ErrPeerClosed = errors.New("peer closed")
+var ErrSnapshotRejected = errors.New("snapshot chunks are rejected repeatedly")
+var ErrSnapshotNotSupported = errors.New("receiver doesn't support snapshot")
@@ -17,10 +17,12 @@ package transport
 import (
 	// standard libraries.
 	"context"
+	"hash/crc32"
 	"sync"
 
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/observability/log"
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
 	"github.com/vanus-labs/vanus/raft/raftpb"
 )
 
@@ -28,6 +30,7 @@ type SendCallback func(error)
 
 type Host interface {
 	Sender
+	SnapshotSender
 	Demultiplexer
 
 	Stop()
@@ -79,6 +82,24 @@ func (h *host) Send(ctx context.Context, msg *raftpb.Message, to uint64, endpoin
 	mux.Send(ctx, msg, cb)
 }
 
+// SendSnapshot implements SnapshotSender.
+func (h *host) SendSnapshot(ctx context.Context, snap *Snapshot, endpoint string) error {
+	mux := h.resolveMultiplexer(ctx, snap.To, endpoint)
+	if mux == nil {
+		return ErrNotReachable
+	}
+
+	stream, err := mux.OpenSnapshotStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	return sendSnapshot(ctx, stream, snap, defaultSnapshotChunkSize, defaultSnapshotWindow)
+}
+
 func (h *host) resolveMultiplexer(ctx context.Context, to uint64, endpoint string) Multiplexer {
 	if endpoint == "" {
 		if endpoint = h.resolver.Resolve(to); endpoint == "" {
@@ -112,6 +133,35 @@ func (h *host) Receive(ctx context.Context, msg *raftpb.Message, endpoint string
 	return nil
 }
 
+// ReceiveSnapshot implements Demultiplexer.
+func (h *host) ReceiveSnapshot(ctx context.Context, chunk *vsraftpb.SnapshotChunk) (uint64, error) {
+	receiver, ok := h.receivers.Load(chunk.To)
+	if !ok {
+		return 0, ErrNotReachable
+	}
+	r, ok := receiver.(SnapshotReceiver)
+	if !ok {
+		return 0, ErrSnapshotNotSupported
+	}
+
+	if len(chunk.Data) != 0 && crc32.Checksum(chunk.Data, crc32q) != chunk.Checksum {
+		log.Warning(ctx, "the checksum of snapshot chunk mismatched", map[string]interface{}{
+			"from":   chunk.From,
+			"to":     chunk.To,
+			"offset": chunk.Offset,
+		})
+		// Query the offset expected by the receiver, so the chunk is resent.
+		chunk = &vsraftpb.SnapshotChunk{
+			From:   chunk.From,
+			To:     chunk.To,
+			Start:  chunk.Start,
+			End:    chunk.End,
+			Offset: chunk.Offset,
+		}
+	}
+	return r.ReceiveSnapshot(ctx, chunk)
+}
+
 func (h *host) Register(node uint64, r Receiver) {
 	// TODO(james.yin): Handles the case where the receiver already exists.
 	h.receivers.LoadOrStore(node, r)
@@ -17,8 +17,10 @@ package transport
 import (
 	// standard libraries.
 	"context"
+	"io"
 
 	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
 	"github.com/vanus-labs/vanus/raft/raftpb"
 )
 
@@ -33,3 +35,41 @@ func (lo *loopback) Send(ctx context.Context, msg *raftpb.Message, cb SendCallba
 	cb(nil)
 	_ = lo.dmu.Receive(ctx, msg, lo.addr)
 }
+
+func (lo *loopback) OpenSnapshotStream(ctx context.Context) (SnapshotStream, error) {
+	return &loopbackSnapshotStream{
+		ctx: ctx,
+		dmu: lo.dmu,
+	}, nil
+}
+
+// loopbackSnapshotStream delivers the chunks to Demultiplexer synchronously, and queues the acknowledgements.
+type loopbackSnapshotStream struct {
+	ctx  context.Context
+	dmu  Demultiplexer
+	acks []*vsraftpb.SnapshotAck
+}
+
+var _ SnapshotStream = (*loopbackSnapshotStream)(nil)
+
+func (s *loopbackSnapshotStream) Send(chunk *vsraftpb.SnapshotChunk) error {
+	off, err := s.dmu.ReceiveSnapshot(s.ctx, chunk)
+	if err != nil {
+		return err
+	}
+	s.acks = append(s.acks, &vsraftpb.SnapshotAck{Offset: off})
+	return nil
+}
+
+func (s *loopbackSnapshotStream) Recv() (*vsraftpb.SnapshotAck, error) {
+	if len(s.acks) == 0 {
+		return nil, io.EOF
+	}
+	ack := s.acks[0]
+	s.acks = s.acks[1:]
+	return ack, nil
+}
+
+func (s *loopbackSnapshotStream) Close() error {
+	return nil
+}
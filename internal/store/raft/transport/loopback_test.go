@@ -24,6 +24,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
 	"github.com/vanus-labs/vanus/raft/raftpb"
 )
 
@@ -36,6 +37,10 @@ func (d *dmu) Receive(ctx context.Context, msg *raftpb.Message, endpoint string)
 	return nil
 }
 
+func (d *dmu) ReceiveSnapshot(ctx context.Context, chunk *vsraftpb.SnapshotChunk) (uint64, error) {
+	return 0, ErrSnapshotNotSupported
+}
+
 var _ Demultiplexer = (*dmu)(nil)
 
 func TestLoopBack(t *testing.T) {
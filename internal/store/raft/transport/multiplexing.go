@@ -24,8 +24,11 @@ import (
 
 type Multiplexer interface {
 	Send(ctx context.Context, msg *raftpb.Message, cb SendCallback)
+	OpenSnapshotStream(ctx context.Context) (SnapshotStream, error)
 }
 
 type Demultiplexer interface {
+	SnapshotReceiver
+
 	Receive(ctx context.Context, msg *raftpb.Message, endpoint string) error
 }
@@ -149,17 +149,7 @@ func (p *peer) Send(ctx context.Context, msg *raftpb.Message, cb SendCallback) {
 }
 
 func (p *peer) connect(ctx context.Context, opts ...grpc.DialOption) (vsraftpb.RaftServer_SendMessageClient, error) {
-	if dl, ok := ctx.Deadline(); !ok {
-		cancelCtx, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
-		defer cancel()
-		ctx = cancelCtx
-	} else if time.Until(dl) < minConnectTimeout {
-		cancelCtx, cancel := context.WithTimeout(context.Background(), minConnectTimeout)
-		defer cancel()
-		ctx = cancelCtx
-	}
-
-	conn, err := grpc.DialContext(ctx, p.addr, opts...)
+	conn, err := dial(ctx, p.addr, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,3 +161,48 @@ func (p *peer) connect(ctx context.Context, opts ...grpc.DialOption) (vsraftpb.R
 	}
 	return stream, nil
 }
+
+// OpenSnapshotStream opens a stream on a dedicated connection, so the transfer of snapshot doesn't block the
+// messages sent to peer.
+func (p *peer) OpenSnapshotStream(ctx context.Context) (SnapshotStream, error) {
+	conn, err := dial(ctx, p.addr, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	client := vsraftpb.NewRaftServerClient(conn)
+	stream, err := client.SendSnapshot(ctx)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &peerSnapshotStream{
+		RaftServer_SendSnapshotClient: stream,
+		conn:                          conn,
+	}, nil
+}
+
+type peerSnapshotStream struct {
+	vsraftpb.RaftServer_SendSnapshotClient
+	conn *grpc.ClientConn
+}
+
+var _ SnapshotStream = (*peerSnapshotStream)(nil)
+
+func (s *peerSnapshotStream) Close() error {
+	_ = s.CloseSend()
+	return s.conn.Close()
+}
+
+func dial(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	if dl, ok := ctx.Deadline(); !ok {
+		cancelCtx, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
+		defer cancel()
+		ctx = cancelCtx
+	} else if time.Until(dl) < minConnectTimeout {
+		cancelCtx, cancel := context.WithTimeout(context.Background(), minConnectTimeout)
+		defer cancel()
+		ctx = cancelCtx
+	}
+	return grpc.DialContext(ctx, addr, opts...)
+}
@@ -53,6 +53,10 @@ func (s *TestRaftSrv) SendMessage(stream RaftServer_SendMessageServer) error {
 	}
 }
 
+func (s *TestRaftSrv) SendSnapshot(stream RaftServer_SendSnapshotServer) error {
+	return nil
+}
+
 func TestPeer(t *testing.T) {
 	serverIP, serverPort := "127.0.0.1", 12040
 	nodeID := uint64(2)
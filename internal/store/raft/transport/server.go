@@ -71,6 +71,30 @@ func (s *server) SendMessage(stream raftpb.RaftServer_SendMessageServer) error {
 	}
 }
 
+// SendSnapshot implements raftpb.RaftServerServer.
+func (s *server) SendSnapshot(stream raftpb.RaftServer_SendSnapshotServer) error {
+	ctx := stream.Context()
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			// close by client
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		off, err := s.dmx.ReceiveSnapshot(ctx, chunk)
+		if err != nil {
+			return err
+		}
+
+		if err = stream.Send(&raftpb.SnapshotAck{Offset: off}); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *server) closeStream(stream raftpb.RaftServer_SendMessageServer) error {
 	empty := &emptypb.Empty{}
 	return stream.SendAndClose(empty)
@@ -16,6 +16,7 @@ package transport
 
 import (
 	// standard libraries.
+	"bytes"
 	"context"
 	"fmt"
 	"net"
@@ -98,6 +99,29 @@ func TestServer(t *testing.T) {
 			So(false, ShouldBeTrue)
 		})
 
+		Convey("test SendSnapshot", func() {
+			data := make([]byte, 3*defaultSnapshotChunkSize+123)
+			for i := range data {
+				data[i] = byte(i)
+			}
+			sr := &snapshotReceiver{data: make([]byte, len(data))}
+			receiveHost.Register(nodeID+1, sr)
+			snap := &Snapshot{
+				From:   100,
+				To:     nodeID + 1,
+				Start:  4096,
+				End:    uint64(len(data)),
+				Reader: bytes.NewReader(data),
+			}
+			timeoutCtx, cannel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cannel()
+
+			err := sendHost.SendSnapshot(timeoutCtx, snap, fmt.Sprintf("%s:%d", serverIP, serverPort))
+			So(err, ShouldBeNil)
+			So(sr.next, ShouldEqual, snap.End)
+			So(bytes.Equal(sr.data[snap.Start:], data[snap.Start:]), ShouldBeTrue)
+		})
+
 		Reset(func() {
 			sendHost.Stop()
 			srv.GracefulStop()
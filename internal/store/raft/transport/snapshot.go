@@ -0,0 +1,156 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	// standard libraries.
+	"context"
+	"hash/crc32"
+	"io"
+
+	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
+)
+
+const (
+	defaultSnapshotChunkSize = 1024 * 1024
+	// defaultSnapshotWindow is the max number of chunks which have been sent but not acknowledged, so the sender
+	// waits for the receiver if it can't keep up.
+	defaultSnapshotWindow = 4
+	// defaultSnapshotMaxRetries is the max number of consecutive rejected chunks before the transfer fails.
+	defaultSnapshotMaxRetries = 3
+)
+
+var crc32q = crc32.MakeTable(crc32.Castagnoli)
+
+// Snapshot is the data of snapshot which is sent in chunks.
+type Snapshot struct {
+	From uint64
+	To   uint64
+	// Start and End are the range of data, [Start, End).
+	Start uint64
+	End   uint64
+	// Reader reads the data in [Start, End).
+	Reader io.ReaderAt
+}
+
+type SnapshotSender interface {
+	// SendSnapshot sends the data of snapshot in chunks, and returns after all chunks are acknowledged.
+	SendSnapshot(ctx context.Context, snap *Snapshot, endpoint string) error
+}
+
+type SnapshotReceiver interface {
+	// ReceiveSnapshot writes the data of chunk, and returns the offset of next chunk it expects. The chunk is
+	// ignored if its offset isn't expected. The data of chunk must not be retained after returning.
+	ReceiveSnapshot(ctx context.Context, chunk *vsraftpb.SnapshotChunk) (uint64, error)
+}
+
+type SnapshotStream interface {
+	Send(chunk *vsraftpb.SnapshotChunk) error
+	Recv() (*vsraftpb.SnapshotAck, error)
+	Close() error
+}
+
+func sendSnapshot(ctx context.Context, stream SnapshotStream, snap *Snapshot, chunkSize, window int) error {
+	// Query the offset expected by the receiver, the data before it has been received by previous transfer.
+	next, err := querySnapshotOffset(stream, snap)
+	if err != nil {
+		return err
+	}
+
+	// The data of chunk is copied when it's sent, so the buffer is reused.
+	buf := make([]byte, chunkSize)
+	off, retries := next, 0
+	inflight := make([]uint64, 0, window)
+	for next < snap.End {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		for len(inflight) < window && off < snap.End {
+			n := snap.End - off
+			if n > uint64(chunkSize) {
+				n = uint64(chunkSize)
+			}
+			data := buf[:n]
+			if _, err = snap.Reader.ReadAt(data, int64(off)); err != nil {
+				return err
+			}
+			chunk := &vsraftpb.SnapshotChunk{
+				From:     snap.From,
+				To:       snap.To,
+				Start:    snap.Start,
+				End:      snap.End,
+				Offset:   off,
+				Data:     data,
+				Checksum: crc32.Checksum(data, crc32q),
+			}
+			if err = stream.Send(chunk); err != nil {
+				return err
+			}
+			off += uint64(len(data))
+			inflight = append(inflight, off)
+		}
+
+		ack, err2 := stream.Recv()
+		if err2 != nil {
+			return err2
+		}
+		end := inflight[0]
+		inflight = inflight[1:]
+
+		if ack.Offset >= end {
+			next, retries = ack.Offset, 0
+			if off < next {
+				off = next
+			}
+			continue
+		}
+
+		// The chunk is rejected, drain the chunks in flight and resume from the offset expected by the receiver.
+		if retries++; retries > defaultSnapshotMaxRetries {
+			return ErrSnapshotRejected
+		}
+		for range inflight {
+			if ack, err2 = stream.Recv(); err2 != nil {
+				return err2
+			}
+		}
+		inflight = inflight[:0]
+		next, off = ack.Offset, ack.Offset
+	}
+	return nil
+}
+
+func querySnapshotOffset(stream SnapshotStream, snap *Snapshot) (uint64, error) {
+	query := &vsraftpb.SnapshotChunk{
+		From:   snap.From,
+		To:     snap.To,
+		Start:  snap.Start,
+		End:    snap.End,
+		Offset: snap.Start,
+	}
+	if err := stream.Send(query); err != nil {
+		return 0, err
+	}
+	ack, err := stream.Recv()
+	if err != nil {
+		return 0, err
+	}
+	if ack.Offset < snap.Start {
+		return snap.Start, nil
+	}
+	return ack.Offset, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	vsraftpb "github.com/vanus-labs/vanus/proto/pkg/raft"
+	"github.com/vanus-labs/vanus/raft/raftpb"
+)
+
+type snapshotReceiver struct {
+	data []byte
+	next uint64
+	// rejects is the number of chunks with data which are rejected.
+	rejects int
+}
+
+var (
+	_ Receiver         = (*snapshotReceiver)(nil)
+	_ SnapshotReceiver = (*snapshotReceiver)(nil)
+)
+
+func (r *snapshotReceiver) Receive(ctx context.Context, msg *raftpb.Message, from uint64, endpoint string) {
+}
+
+func (r *snapshotReceiver) ReceiveSnapshot(ctx context.Context, chunk *vsraftpb.SnapshotChunk) (uint64, error) {
+	if r.next < chunk.Start {
+		r.next = chunk.Start
+	}
+	if r.rejects > 0 && len(chunk.Data) != 0 {
+		r.rejects--
+		return r.next, nil
+	}
+	end := chunk.Offset + uint64(len(chunk.Data))
+	if chunk.Offset > r.next || end <= r.next {
+		return r.next, nil
+	}
+	copy(r.data[r.next:], chunk.Data[r.next-chunk.Offset:])
+	r.next = end
+	return r.next, nil
+}
+
+// observedStream counts the chunks in flight, and corrupts the data of some chunks.
+type observedStream struct {
+	SnapshotStream
+	sent        int
+	inflight    int
+	maxInflight int
+	corrupt     map[int]bool
+}
+
+func (s *observedStream) Send(chunk *vsraftpb.SnapshotChunk) error {
+	s.sent++
+	if s.corrupt[s.sent] {
+		data := append([]byte{}, chunk.Data...)
+		data[0] ^= 0xff
+		chunk = &vsraftpb.SnapshotChunk{
+			From: chunk.From, To: chunk.To, Start: chunk.Start, End: chunk.End,
+			Offset: chunk.Offset, Data: data, Checksum: chunk.Checksum,
+		}
+	}
+	s.inflight++
+	if s.inflight > s.maxInflight {
+		s.maxInflight = s.inflight
+	}
+	return s.SnapshotStream.Send(chunk)
+}
+
+func (s *observedStream) Recv() (*vsraftpb.SnapshotAck, error) {
+	s.inflight--
+	return s.SnapshotStream.Recv()
+}
+
+func TestHost_SendSnapshot(t *testing.T) {
+	Convey("test send snapshot by loopback", t, func() {
+		resolver := NewSimpleResolver()
+		nodeID := uint64(3)
+		localaddr := "127.0.0.1:12000"
+		resolver.Register(nodeID, localaddr)
+		h := NewHost(resolver, localaddr)
+		ctx := context.Background()
+
+		data := make([]byte, 10*1024*1024+123)
+		rand.Read(data)
+		start := uint64(4096)
+		snap := &Snapshot{
+			From:   1,
+			To:     nodeID,
+			Start:  start,
+			End:    uint64(len(data)),
+			Reader: bytes.NewReader(data),
+		}
+		r := &snapshotReceiver{data: make([]byte, len(data))}
+		h.Register(nodeID, r)
+
+		Convey("send all chunks", func() {
+			err := h.SendSnapshot(ctx, snap, "")
+			So(err, ShouldBeNil)
+			So(r.next, ShouldEqual, snap.End)
+			So(bytes.Equal(r.data[start:], data[start:]), ShouldBeTrue)
+		})
+
+		Convey("resume from the offset expected by receiver", func() {
+			resumed := start + 3*defaultSnapshotChunkSize + 100
+			copy(r.data[start:resumed], data[start:resumed])
+			r.next = resumed
+
+			stream, _ := h.(*host).lo.OpenSnapshotStream(ctx)
+			os := &observedStream{SnapshotStream: stream}
+			err := sendSnapshot(ctx, os, snap, defaultSnapshotChunkSize, defaultSnapshotWindow)
+			So(err, ShouldBeNil)
+			So(bytes.Equal(r.data[start:], data[start:]), ShouldBeTrue)
+			// 1 query and 7 chunks
+			So(os.sent, ShouldEqual, 8)
+		})
+
+		Convey("resend corrupted chunks", func() {
+			stream, _ := h.(*host).lo.OpenSnapshotStream(ctx)
+			os := &observedStream{SnapshotStream: stream, corrupt: map[int]bool{3: true, 9: true}}
+			err := sendSnapshot(ctx, os, snap, defaultSnapshotChunkSize, defaultSnapshotWindow)
+			So(err, ShouldBeNil)
+			So(bytes.Equal(r.data[start:], data[start:]), ShouldBeTrue)
+			So(os.maxInflight, ShouldBeLessThanOrEqualTo, defaultSnapshotWindow)
+		})
+
+		Convey("fail if chunks are rejected repeatedly", func() {
+			r.rejects = (defaultSnapshotMaxRetries + 1) * defaultSnapshotWindow
+			err := h.SendSnapshot(ctx, snap, "")
+			So(errors.Is(err, ErrSnapshotRejected), ShouldBeTrue)
+			So(r.next, ShouldEqual, start)
+		})
+
+		Convey("receiver not found", func() {
+			snap.To = nodeID + 1
+			err := h.SendSnapshot(ctx, snap, localaddr)
+			So(errors.Is(err, ErrNotReachable), ShouldBeTrue)
+		})
+	})
+}
@@ -34,3 +34,26 @@ func SequenceNumber(entry block.Entry) int64 {
 func Stime(entry block.Entry) int64 {
 	return entry.GetInt64(StimeOrdinal)
 }
+
+// Attribute returns the value of attribute by its name, the extension attributes are also supported.
+func Attribute(entry block.Entry, name string) []byte {
+	switch name {
+	case "id":
+		return stringValue(entry.GetString(IDOrdinal))
+	case "source":
+		return stringValue(entry.GetString(SourceOrdinal))
+	case "type":
+		return stringValue(entry.GetString(TypeOrdinal))
+	case "subject":
+		return stringValue(entry.GetString(SubjectOrdinal))
+	default:
+		return entry.GetExtensionAttribute([]byte(name))
+	}
+}
+
+func stringValue(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return []byte(s)
+}
@@ -36,10 +36,16 @@ func TestField(t *testing.T) {
 	entry.EXPECT().GetUint16(EntryTypeOrdinal).Return(CloudEvent)
 	entry.EXPECT().GetInt64(SequenceNumberOrdinal).Return(int64(1))
 	entry.EXPECT().GetInt64(StimeOrdinal).Return(now.UnixMilli())
+	entry.EXPECT().GetString(SubjectOrdinal).Return("key")
+	entry.EXPECT().GetString(IDOrdinal).Return("")
+	entry.EXPECT().GetExtensionAttribute([]byte("xkey")).Return([]byte("ext"))
 
 	Convey("get fields", t, func() {
 		So(EntryType(entry), ShouldEqual, CloudEvent)
 		So(SequenceNumber(entry), ShouldEqual, int64(1))
 		So(Stime(entry), ShouldEqual, now.UnixMilli())
+		So(Attribute(entry, "subject"), ShouldResemble, []byte("key"))
+		So(Attribute(entry, "id"), ShouldBeNil)
+		So(Attribute(entry, "xkey"), ShouldResemble, []byte("ext"))
 	})
 }
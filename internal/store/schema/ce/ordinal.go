@@ -33,4 +33,6 @@ const (
 	DataSchemaOrdinal
 	SubjectOrdinal
 	TimeOrdinal
+	// CompressionOrdinal is the algorithm used to compress data, it must be the last field.
+	CompressionOrdinal
 )
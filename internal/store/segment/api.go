@@ -17,16 +17,20 @@ package segment
 import (
 	// standard libraries.
 	"context"
+	"io"
 
 	// third-party libraries.
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	// first-party libraries.
+	"github.com/vanus-labs/vanus/pkg/errors"
 	cepb "github.com/vanus-labs/vanus/proto/pkg/cloudevents"
 	segpb "github.com/vanus-labs/vanus/proto/pkg/segment"
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	raft "github.com/vanus-labs/vanus/internal/store/raft/block"
 )
 
 type segmentServer struct {
@@ -71,7 +75,81 @@ func (s *segmentServer) CreateBlock(ctx context.Context, req *segpb.CreateBlockR
 
 func (s *segmentServer) RemoveBlock(ctx context.Context, req *segpb.RemoveBlockRequest) (*emptypb.Empty, error) {
 	blockID := vanus.NewIDFromUint64(req.Id)
-	if err := s.srv.RemoveBlock(ctx, blockID); err != nil {
+	if err := s.srv.RemoveBlock(ctx, blockID, req.DeleteObject); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CompactBlock receives the hashes of newer keys until the request stream is closed, then compacts the block and
+// sends the hashes of keys in the block in batches, the size of block is set in the last message.
+func (s *segmentServer) CompactBlock(stream segpb.SegmentServer_CompactBlockServer) error {
+	var (
+		blockID  vanus.ID
+		policy   block.CompactionPolicy
+		received bool
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !received {
+			blockID = vanus.NewIDFromUint64(req.Id)
+			policy.KeyAttribute = req.KeyAttribute
+			policy.TombstoneDeadline = req.TombstoneDeadline
+			received = true
+		}
+		for _, h := range req.NewerKeyHashes {
+			if len(h) != block.KeyHashSize {
+				return errors.ErrInvalidRequest.WithMessage("the size of key hash is invalid")
+			}
+			if policy.NewerKeys == nil {
+				policy.NewerKeys = make(map[block.KeyHash]struct{})
+			}
+			var kh block.KeyHash
+			copy(kh[:], h)
+			policy.NewerKeys[kh] = struct{}{}
+		}
+	}
+	if !received {
+		return errors.ErrInvalidRequest.WithMessage("the compaction request is empty")
+	}
+
+	size, keys, err := s.srv.CompactBlock(stream.Context(), blockID, policy)
+	if err != nil {
+		return err
+	}
+
+	for {
+		n := len(keys)
+		if n > segpb.CompactKeyHashBatchSize {
+			n = segpb.CompactKeyHashBatchSize
+		}
+		resp := &segpb.CompactBlockResponse{KeyHashes: make([][]byte, n)}
+		for i := range resp.KeyHashes {
+			resp.KeyHashes[i] = keys[i][:]
+		}
+		keys = keys[n:]
+		if len(keys) == 0 {
+			resp.Size = size
+			return stream.Send(resp)
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *segmentServer) OffloadBlock(
+	ctx context.Context, req *segpb.OffloadBlockRequest,
+) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.Id)
+	if err := s.srv.OffloadBlock(ctx, blockID, req.Key); err != nil {
 		return nil, err
 	}
 
@@ -81,12 +159,60 @@ func (s *segmentServer) RemoveBlock(ctx context.Context, req *segpb.RemoveBlockR
 func (s *segmentServer) GetBlockInfo(
 	ctx context.Context, req *segpb.GetBlockInfoRequest,
 ) (*segpb.GetBlockInfoResponse, error) {
-	// TODO(james.yin): implements GetBlockInfo()
-	// if err := s.srv.GetBlockInfo(ctx, 0); err != nil {
-	// 	return nil, err
-	// }
+	blockID := vanus.NewIDFromUint64(req.Id)
+	info, st, err := s.srv.GetBlockInfo(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make([]*segpb.ReplicaProgress, 0, len(st.Peers))
+	for _, p := range st.Peers {
+		replicas = append(replicas, &segpb.ReplicaProgress{
+			Id:         p.ID.Uint64(),
+			MatchIndex: p.Match,
+			State:      string(p.State),
+		})
+	}
+	return &segpb.GetBlockInfoResponse{
+		Info:        info,
+		CommitIndex: st.Commit,
+		Replicas:    replicas,
+	}, nil
+}
+
+func (s *segmentServer) AddReplica(ctx context.Context, req *segpb.AddReplicaRequest) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.Id)
+	replica := raft.Peer{
+		ID:       vanus.NewIDFromUint64(req.ReplicaId),
+		Endpoint: req.Endpoint,
+	}
+	if err := s.srv.AddReplica(ctx, blockID, replica); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
 
-	return &segpb.GetBlockInfoResponse{}, nil
+func (s *segmentServer) RemoveReplica(ctx context.Context, req *segpb.RemoveReplicaRequest) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.Id)
+	replicaID := vanus.NewIDFromUint64(req.ReplicaId)
+	if err := s.srv.RemoveReplica(ctx, blockID, replicaID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *segmentServer) TransferLeader(
+	ctx context.Context, req *segpb.TransferLeaderRequest,
+) (*emptypb.Empty, error) {
+	blockID := vanus.NewIDFromUint64(req.Id)
+	transfereeID := vanus.NewIDFromUint64(req.TransfereeId)
+	if err := s.srv.TransferLeader(ctx, blockID, transfereeID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 func (s *segmentServer) ActivateSegment(
@@ -100,7 +226,8 @@ func (s *segmentServer) ActivateSegment(
 		replicas[blockID] = endpoint
 	}
 
-	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas); err != nil {
+	compression := block.Compression(req.Compression)
+	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas, compression); err != nil {
 		return nil, err
 	}
 
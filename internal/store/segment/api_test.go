@@ -17,6 +17,8 @@ package segment
 import (
 	// standard libraries.
 	"context"
+	"fmt"
+	"io"
 	"testing"
 
 	// third-party libraries.
@@ -27,11 +29,14 @@ import (
 	// first-party libraries.
 	"github.com/vanus-labs/vanus/pkg/errors"
 	cepb "github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
 	segpb "github.com/vanus-labs/vanus/proto/pkg/segment"
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/primitive"
 	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	raft "github.com/vanus-labs/vanus/internal/store/raft/block"
 )
 
 func TestSegmentServer(t *testing.T) {
@@ -102,11 +107,12 @@ func TestSegmentServer(t *testing.T) {
 		})
 
 		Convey("RemoveBlock()", func() {
-			srv.EXPECT().RemoveBlock(Any(), Not(vanus.EmptyID())).Return(nil)
-			srv.EXPECT().RemoveBlock(Any(), Eq(vanus.EmptyID())).Return(errors.ErrInvalidRequest)
+			srv.EXPECT().RemoveBlock(Any(), Not(vanus.EmptyID()), true).Return(nil)
+			srv.EXPECT().RemoveBlock(Any(), Eq(vanus.EmptyID()), false).Return(errors.ErrInvalidRequest)
 
 			req := &segpb.RemoveBlockRequest{
-				Id: vanus.NewTestID().Uint64(),
+				Id:           vanus.NewTestID().Uint64(),
+				DeleteObject: true,
 			}
 			resp, err := ss.RemoveBlock(context.Background(), req)
 			So(err, ShouldBeNil)
@@ -119,17 +125,119 @@ func TestSegmentServer(t *testing.T) {
 			So(err, ShouldEqual, errors.ErrInvalidRequest)
 		})
 
-		Convey("GetBlockInfo()", func() {
-			// FIXME: not implement.
-			req := &segpb.GetBlockInfoRequest{}
-			resp, err := ss.GetBlockInfo(context.Background(), req)
+		Convey("CompactBlock()", func() {
+			stream := segpb.NewMockSegmentServer_CompactBlockServer(ctrl)
+			stream.EXPECT().Context().AnyTimes().Return(context.Background())
+
+			// the key hashes are more than a message can hold.
+			newerKeys := make(map[block.KeyHash]struct{})
+			reqs := []*segpb.CompactBlockRequest{{
+				Id:                vanus.NewTestID().Uint64(),
+				KeyAttribute:      "subject",
+				TombstoneDeadline: 1000,
+			}, {}, {}}
+			for i := 0; i < 2*segpb.CompactKeyHashBatchSize+1; i++ {
+				kh := block.HashKey([]byte(fmt.Sprintf("newer-%d", i)))
+				newerKeys[kh] = struct{}{}
+				req := reqs[i/segpb.CompactKeyHashBatchSize]
+				req.NewerKeyHashes = append(req.NewerKeyHashes, kh[:])
+			}
+			keys := make([]block.KeyHash, segpb.CompactKeyHashBatchSize+1)
+			for i := range keys {
+				keys[i] = block.HashKey([]byte(fmt.Sprintf("key-%d", i)))
+			}
+			for _, req := range reqs {
+				stream.EXPECT().Recv().Return(req, nil)
+			}
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+			srv.EXPECT().CompactBlock(Any(), vanus.NewIDFromUint64(reqs[0].Id), block.CompactionPolicy{
+				KeyAttribute:      "subject",
+				TombstoneDeadline: 1000,
+				NewerKeys:         newerKeys,
+			}).Return(int64(1024), keys, nil)
+			var resps []*segpb.CompactBlockResponse
+			stream.EXPECT().Send(Any()).Times(2).DoAndReturn(func(resp *segpb.CompactBlockResponse) error {
+				resps = append(resps, resp)
+				return nil
+			})
+
+			err := ss.CompactBlock(stream)
 			So(err, ShouldBeNil)
-			So(resp, ShouldNotBeNil)
+			So(resps[0].KeyHashes, ShouldHaveLength, segpb.CompactKeyHashBatchSize)
+			So(resps[0].Size, ShouldEqual, 0)
+			So(resps[1].KeyHashes, ShouldResemble, [][]byte{keys[len(keys)-1][:]})
+			So(resps[1].Size, ShouldEqual, 1024)
+
+			// the key hash of invalid size.
+			stream.EXPECT().Recv().Return(&segpb.CompactBlockRequest{NewerKeyHashes: [][]byte{[]byte("a")}}, nil)
+			err = ss.CompactBlock(stream)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("OffloadBlock()", func() {
+			id := vanus.NewTestID()
+			srv.EXPECT().OffloadBlock(Any(), id, "segments/1").Return(nil)
+
+			req := &segpb.OffloadBlockRequest{
+				Id:  id.Uint64(),
+				Key: "segments/1",
+			}
+			_, err := ss.OffloadBlock(context.Background(), req)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("GetBlockInfo()", func() {
+			id := vanus.NewTestID()
+			req := &segpb.GetBlockInfoRequest{
+				Id: id.Uint64(),
+			}
+
+			Convey("leader", func() {
+				replica1, replica2 := vanus.NewTestID(), vanus.NewTestID()
+				info := &metapb.SegmentHealthInfo{Id: id.Uint64(), Leader: id.Uint64()}
+				srv.EXPECT().GetBlockInfo(Any(), id).Return(info, raft.ReplicationStatus{
+					Commit: 10,
+					Peers: []raft.PeerProgress{
+						{ID: replica1, Match: 10, State: raft.PeerStateReplicate},
+						{ID: replica2, Match: 3, State: raft.PeerStateSnapshot},
+					},
+				}, nil)
+
+				resp, err := ss.GetBlockInfo(context.Background(), req)
+				So(err, ShouldBeNil)
+				So(resp.Info, ShouldEqual, info)
+				So(resp.CommitIndex, ShouldEqual, 10)
+				So(resp.Replicas, ShouldHaveLength, 2)
+				So(resp.Replicas[0].Id, ShouldEqual, replica1.Uint64())
+				So(resp.Replicas[0].MatchIndex, ShouldEqual, 10)
+				So(resp.Replicas[0].State, ShouldEqual, "replicate")
+				So(resp.Replicas[1].Id, ShouldEqual, replica2.Uint64())
+				So(resp.Replicas[1].MatchIndex, ShouldEqual, 3)
+				So(resp.Replicas[1].State, ShouldEqual, "snapshot")
+			})
+
+			Convey("not leader", func() {
+				info := &metapb.SegmentHealthInfo{Id: id.Uint64()}
+				srv.EXPECT().GetBlockInfo(Any(), id).Return(info, raft.ReplicationStatus{}, nil)
+
+				resp, err := ss.GetBlockInfo(context.Background(), req)
+				So(err, ShouldBeNil)
+				So(resp.Info, ShouldEqual, info)
+				So(resp.CommitIndex, ShouldEqual, 0)
+				So(resp.Replicas, ShouldBeEmpty)
+			})
+
+			Convey("not found", func() {
+				srv.EXPECT().GetBlockInfo(Any(), id).Return(nil, raft.ReplicationStatus{}, errors.ErrResourceNotFound)
+
+				_, err := ss.GetBlockInfo(context.Background(), req)
+				So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			})
 		})
 
 		Convey("ActivateSegment()", func() {
 			// TODO(james.yin):
-			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any()).Return(nil)
+			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any(), block.CompressionLZ4).Return(nil)
 
 			req := &segpb.ActivateSegmentRequest{
 				EventlogId:     vanus.NewTestID().Uint64(),
@@ -137,6 +245,7 @@ func TestSegmentServer(t *testing.T) {
 				Replicas: map[uint64]string{
 					1: "127.0.0.1:11811",
 				},
+				Compression: metapb.CompressAlgorithm_LZ4,
 			}
 			resp, err := ss.ActivateSegment(context.Background(), req)
 			So(err, ShouldBeNil)
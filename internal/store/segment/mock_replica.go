@@ -38,6 +38,20 @@ func (m *MockReplica) EXPECT() *MockReplicaMockRecorder {
 	return m.recorder
 }
 
+// AddPeer mocks base method.
+func (m *MockReplica) AddPeer(ctx context.Context, peer block0.Peer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPeer", ctx, peer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPeer indicates an expected call of AddPeer.
+func (mr *MockReplicaMockRecorder) AddPeer(ctx, peer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockReplica)(nil).AddPeer), ctx, peer)
+}
+
 // Append mocks base method.
 func (m *MockReplica) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback) {
 	m.ctrl.T.Helper()
@@ -78,6 +92,22 @@ func (mr *MockReplicaMockRecorder) Close(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReplica)(nil).Close), ctx)
 }
 
+// Compact mocks base method.
+func (m *MockReplica) Compact(ctx context.Context, policy block.CompactionPolicy) (block.Statistics, []block.KeyHash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compact", ctx, policy)
+	ret0, _ := ret[0].(block.Statistics)
+	ret1, _ := ret[1].([]block.KeyHash)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Compact indicates an expected call of Compact.
+func (mr *MockReplicaMockRecorder) Compact(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compact", reflect.TypeOf((*MockReplica)(nil).Compact), ctx, policy)
+}
+
 // Delete mocks base method.
 func (m *MockReplica) Delete(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -92,6 +122,20 @@ func (mr *MockReplicaMockRecorder) Delete(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReplica)(nil).Delete), ctx)
 }
 
+// DeleteObject mocks base method.
+func (m *MockReplica) DeleteObject(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockReplicaMockRecorder) DeleteObject(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockReplica)(nil).DeleteObject), ctx)
+}
+
 // ID mocks base method.
 func (m *MockReplica) ID() vanus.ID {
 	m.ctrl.T.Helper()
@@ -120,6 +164,20 @@ func (mr *MockReplicaMockRecorder) IDStr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDStr", reflect.TypeOf((*MockReplica)(nil).IDStr))
 }
 
+// Offload mocks base method.
+func (m *MockReplica) Offload(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offload", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Offload indicates an expected call of Offload.
+func (mr *MockReplicaMockRecorder) Offload(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offload", reflect.TypeOf((*MockReplica)(nil).Offload), ctx, key)
+}
+
 // Read mocks base method.
 func (m *MockReplica) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +193,35 @@ func (mr *MockReplicaMockRecorder) Read(ctx, seq, num interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReplica)(nil).Read), ctx, seq, num)
 }
 
+// RemovePeer mocks base method.
+func (m *MockReplica) RemovePeer(ctx context.Context, id vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePeer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePeer indicates an expected call of RemovePeer.
+func (mr *MockReplicaMockRecorder) RemovePeer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeer", reflect.TypeOf((*MockReplica)(nil).RemovePeer), ctx, id)
+}
+
+// ReplicationStatus mocks base method.
+func (m *MockReplica) ReplicationStatus() (block0.ReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicationStatus")
+	ret0, _ := ret[0].(block0.ReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicationStatus indicates an expected call of ReplicationStatus.
+func (mr *MockReplicaMockRecorder) ReplicationStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicationStatus", reflect.TypeOf((*MockReplica)(nil).ReplicationStatus))
+}
+
 // Seek mocks base method.
 func (m *MockReplica) Seek(ctx context.Context, index int64, key block.Entry, flag block.SeekKeyFlag) (int64, error) {
 	m.ctrl.T.Helper()
@@ -150,6 +237,20 @@ func (mr *MockReplicaMockRecorder) Seek(ctx, index, key, flag interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockReplica)(nil).Seek), ctx, index, key, flag)
 }
 
+// SetCompression mocks base method.
+func (m *MockReplica) SetCompression(ctx context.Context, c block.Compression) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockReplicaMockRecorder) SetCompression(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockReplica)(nil).SetCompression), ctx, c)
+}
+
 // Status mocks base method.
 func (m *MockReplica) Status() *meta.SegmentHealthInfo {
 	m.ctrl.T.Helper()
@@ -163,3 +264,17 @@ func (mr *MockReplicaMockRecorder) Status() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockReplica)(nil).Status))
 }
+
+// TransferLeader mocks base method.
+func (m *MockReplica) TransferLeader(ctx context.Context, transferee vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", ctx, transferee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockReplicaMockRecorder) TransferLeader(ctx, transferee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockReplica)(nil).TransferLeader), ctx, transferee)
+}
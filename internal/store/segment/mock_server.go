@@ -12,7 +12,10 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	primitive "github.com/vanus-labs/vanus/internal/primitive"
 	vanus "github.com/vanus-labs/vanus/internal/primitive/vanus"
+	block "github.com/vanus-labs/vanus/internal/store/block"
+	block0 "github.com/vanus-labs/vanus/internal/store/raft/block"
 	cloudevents "github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+	meta "github.com/vanus-labs/vanus/proto/pkg/meta"
 )
 
 // MockServer is a mock of Server interface.
@@ -39,17 +42,31 @@ func (m *MockServer) EXPECT() *MockServerMockRecorder {
 }
 
 // ActivateSegment mocks base method.
-func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string) error {
+func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string, compression block.Compression) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas)
+	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas, compression)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ActivateSegment indicates an expected call of ActivateSegment.
-func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas, compression interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas, compression)
+}
+
+// AddReplica mocks base method.
+func (m *MockServer) AddReplica(ctx context.Context, id vanus.ID, replica block0.Peer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReplica", ctx, id, replica)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReplica indicates an expected call of AddReplica.
+func (mr *MockServerMockRecorder) AddReplica(ctx, id, replica interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockServer)(nil).AddReplica), ctx, id, replica)
 }
 
 // AppendToBlock mocks base method.
@@ -67,6 +84,22 @@ func (mr *MockServerMockRecorder) AppendToBlock(ctx, id, events interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockServer)(nil).AppendToBlock), ctx, id, events)
 }
 
+// CompactBlock mocks base method.
+func (m *MockServer) CompactBlock(ctx context.Context, id vanus.ID, policy block.CompactionPolicy) (int64, []block.KeyHash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactBlock", ctx, id, policy)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]block.KeyHash)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompactBlock indicates an expected call of CompactBlock.
+func (mr *MockServerMockRecorder) CompactBlock(ctx, id, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlock", reflect.TypeOf((*MockServer)(nil).CompactBlock), ctx, id, policy)
+}
+
 // CreateBlock mocks base method.
 func (m *MockServer) CreateBlock(ctx context.Context, id vanus.ID, size int64) error {
 	m.ctrl.T.Helper()
@@ -81,6 +114,22 @@ func (mr *MockServerMockRecorder) CreateBlock(ctx, id, size interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockServer)(nil).CreateBlock), ctx, id, size)
 }
 
+// GetBlockInfo mocks base method.
+func (m *MockServer) GetBlockInfo(ctx context.Context, id vanus.ID) (*meta.SegmentHealthInfo, block0.ReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockInfo", ctx, id)
+	ret0, _ := ret[0].(*meta.SegmentHealthInfo)
+	ret1, _ := ret[1].(block0.ReplicationStatus)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBlockInfo indicates an expected call of GetBlockInfo.
+func (mr *MockServerMockRecorder) GetBlockInfo(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockServer)(nil).GetBlockInfo), ctx, id)
+}
+
 // InactivateSegment mocks base method.
 func (m *MockServer) InactivateSegment(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -124,6 +173,20 @@ func (mr *MockServerMockRecorder) LookupOffsetInBlock(ctx, id, stime interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockServer)(nil).LookupOffsetInBlock), ctx, id, stime)
 }
 
+// OffloadBlock mocks base method.
+func (m *MockServer) OffloadBlock(ctx context.Context, id vanus.ID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadBlock", ctx, id, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OffloadBlock indicates an expected call of OffloadBlock.
+func (mr *MockServerMockRecorder) OffloadBlock(ctx, id, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlock", reflect.TypeOf((*MockServer)(nil).OffloadBlock), ctx, id, key)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cloudevents.CloudEvent, error) {
 	m.ctrl.T.Helper()
@@ -140,17 +203,31 @@ func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeou
 }
 
 // RemoveBlock mocks base method.
-func (m *MockServer) RemoveBlock(ctx context.Context, id vanus.ID) error {
+func (m *MockServer) RemoveBlock(ctx context.Context, id vanus.ID, deleteObject bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveBlock", ctx, id)
+	ret := m.ctrl.Call(m, "RemoveBlock", ctx, id, deleteObject)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveBlock indicates an expected call of RemoveBlock.
-func (mr *MockServerMockRecorder) RemoveBlock(ctx, id interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) RemoveBlock(ctx, id, deleteObject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockServer)(nil).RemoveBlock), ctx, id, deleteObject)
+}
+
+// RemoveReplica mocks base method.
+func (m *MockServer) RemoveReplica(ctx context.Context, id, replicaID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReplica", ctx, id, replicaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReplica indicates an expected call of RemoveReplica.
+func (mr *MockServerMockRecorder) RemoveReplica(ctx, id, replicaID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockServer)(nil).RemoveBlock), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplica", reflect.TypeOf((*MockServer)(nil).RemoveReplica), ctx, id, replicaID)
 }
 
 // Serve mocks base method.
@@ -208,3 +285,17 @@ func (mr *MockServerMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockServer)(nil).Stop), ctx)
 }
+
+// TransferLeader mocks base method.
+func (m *MockServer) TransferLeader(ctx context.Context, id, transfereeID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", ctx, id, transfereeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockServerMockRecorder) TransferLeader(ctx, id, transfereeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockServer)(nil).TransferLeader), ctx, id, transfereeID)
+}
@@ -34,9 +34,17 @@ type Replica interface {
 
 	IDStr() string
 	Bootstrap(ctx context.Context, blocks []raft.Peer) error
+	AddPeer(ctx context.Context, peer raft.Peer) error
+	RemovePeer(ctx context.Context, id vanus.ID) error
+	TransferLeader(ctx context.Context, transferee vanus.ID) error
 	Close(ctx context.Context) error
 	Delete(ctx context.Context) error
+	Compact(ctx context.Context, policy block.CompactionPolicy) (block.Statistics, []block.KeyHash, error)
+	Offload(ctx context.Context, key string) error
+	DeleteObject(ctx context.Context) error
+	SetCompression(ctx context.Context, c block.Compression) error
 	Status() *metapb.SegmentHealthInfo
+	ReplicationStatus() (raft.ReplicationStatus, error)
 }
 
 type replica struct {
@@ -60,6 +68,18 @@ func (r *replica) Bootstrap(ctx context.Context, peers []raft.Peer) error {
 	return r.appender.Bootstrap(ctx, peers)
 }
 
+func (r *replica) AddPeer(ctx context.Context, peer raft.Peer) error {
+	return r.appender.AddPeer(ctx, peer)
+}
+
+func (r *replica) RemovePeer(ctx context.Context, id vanus.ID) error {
+	return r.appender.RemovePeer(ctx, id)
+}
+
+func (r *replica) TransferLeader(ctx context.Context, transferee vanus.ID) error {
+	return r.appender.TransferLeader(ctx, transferee)
+}
+
 func (r *replica) Close(ctx context.Context) error {
 	r.appender.Stop(ctx)
 	return r.raw.Close(ctx)
@@ -70,6 +90,40 @@ func (r *replica) Delete(ctx context.Context) error {
 	return r.raw.Delete(ctx)
 }
 
+func (r *replica) Compact(
+	ctx context.Context, policy block.CompactionPolicy,
+) (block.Statistics, []block.KeyHash, error) {
+	c, ok := r.raw.(block.Compactor)
+	if !ok {
+		return block.Statistics{}, nil, block.ErrNotSupported
+	}
+	return c.Compact(ctx, policy)
+}
+
+func (r *replica) Offload(ctx context.Context, key string) error {
+	o, ok := r.raw.(block.Offloader)
+	if !ok {
+		return block.ErrNotSupported
+	}
+	return o.Offload(ctx, key)
+}
+
+func (r *replica) SetCompression(ctx context.Context, c block.Compression) error {
+	cp, ok := r.raw.(block.Compressor)
+	if !ok {
+		return block.ErrNotSupported
+	}
+	return cp.SetCompression(ctx, c)
+}
+
+func (r *replica) DeleteObject(ctx context.Context) error {
+	o, ok := r.raw.(block.Offloader)
+	if !ok {
+		return nil
+	}
+	return o.DeleteObject(ctx)
+}
+
 func (r *replica) Seek(ctx context.Context, index int64, key block.Entry, flag block.SeekKeyFlag) (int64, error) {
 	return r.raw.Seek(ctx, index, key, flag)
 }
@@ -103,6 +157,10 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 	return info
 }
 
+func (r *replica) ReplicationStatus() (raft.ReplicationStatus, error) {
+	return r.appender.ReplicationStatus()
+}
+
 func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64) (Replica, error) {
 	// Create block.
 	e, _ := raw.ResolveEngine(raw.VSB)
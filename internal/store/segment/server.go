@@ -80,10 +80,16 @@ type Server interface {
 	Status() primitive.ServerState
 
 	CreateBlock(ctx context.Context, id vanus.ID, size int64) error
-	RemoveBlock(ctx context.Context, id vanus.ID) error
-	// GetBlockInfo(ctx context.Context, id vanus.ID) error
-
-	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string) error
+	RemoveBlock(ctx context.Context, id vanus.ID, deleteObject bool) error
+	CompactBlock(ctx context.Context, id vanus.ID, policy block.CompactionPolicy) (int64, []block.KeyHash, error)
+	OffloadBlock(ctx context.Context, id vanus.ID, key string) error
+	GetBlockInfo(ctx context.Context, id vanus.ID) (*metapb.SegmentHealthInfo, raft.ReplicationStatus, error)
+	AddReplica(ctx context.Context, id vanus.ID, replica raft.Peer) error
+	RemoveReplica(ctx context.Context, id vanus.ID, replicaID vanus.ID) error
+	TransferLeader(ctx context.Context, id vanus.ID, transfereeID vanus.ID) error
+
+	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+		compression block.Compression) error
 	InactivateSegment(ctx context.Context) error
 
 	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error)
@@ -288,17 +294,29 @@ func (s *server) runHeartbeat(_ context.Context) error {
 
 	f := func() interface{} {
 		infos := make([]*metapb.SegmentHealthInfo, 0)
+		var used uint64
+		var leaders uint32
 		s.replicas.Range(func(key, value interface{}) bool {
 			b, _ := value.(Replica)
-			infos = append(infos, b.Status())
+			info := b.Status()
+			infos = append(infos, info)
+			used += uint64(info.Capacity)
+			if info.Leader == info.Id {
+				leaders++
+			}
 			return true
 		})
 		return &ctrlpb.SegmentHeartbeatRequest{
-			ServerId:   s.id.Uint64(),
-			VolumeId:   s.volumeID,
-			HealthInfo: infos,
-			ReportTime: util.FormatTime(time.Now()),
-			ServerAddr: s.localAddr,
+			ServerId:     s.id.Uint64(),
+			VolumeId:     s.volumeID,
+			HealthInfo:   infos,
+			ReportTime:   util.FormatTime(time.Now()),
+			ServerAddr:   s.localAddr,
+			Capacity:     s.cfg.Volume.Capacity,
+			Used:         used,
+			BlockNumber:  uint32(len(infos)),
+			LeaderNumber: leaders,
+			Labels:       s.cfg.Volume.Labels,
 		}
 	}
 
@@ -409,7 +427,8 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 	return nil
 }
 
-func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
+// RemoveBlock deletes the block, and the object which the block is offloaded to if deleteObject is true.
+func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID, deleteObject bool) error {
 	ctx, span := s.tracer.Start(ctx, "RemoveBlock")
 	defer span.End()
 
@@ -417,12 +436,24 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 		return err
 	}
 
+	if deleteObject {
+		// The object is deleted before the block, so the deletion can be retried if it failed.
+		v, exist := s.replicas.Load(blockID)
+		if !exist {
+			return errors.ErrResourceNotFound.WithMessage("the block not found")
+		}
+		if err := v.(Replica).DeleteObject(ctx); err != nil {
+			return errors.ErrInternal.Wrap(err)
+		}
+	}
+
 	v, exist := s.replicas.LoadAndDelete(blockID)
 	if !exist {
 		return errors.ErrResourceNotFound.WithMessage("the block not found")
 	}
 
 	b, _ := v.(Replica)
+
 	// TODO(james.yin): s.host.Unregister
 	if err := b.Delete(ctx); err != nil {
 		return err
@@ -438,17 +469,218 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 	return nil
 }
 
-// TODO(james.yin): implements GetBlockInfo.
-// func (s *server) GetBlockInfo(ctx context.Context, id vanus.ID) error {
-// 	if err := s.checkState(); err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
+func (s *server) CompactBlock(
+	ctx context.Context, blockID vanus.ID, policy block.CompactionPolicy,
+) (int64, []block.KeyHash, error) {
+	ctx, span := s.tracer.Start(ctx, "CompactBlock")
+	defer span.End()
+
+	if policy.KeyAttribute == "" {
+		return 0, nil, errors.ErrInvalidRequest.WithMessage("the key attribute is empty")
+	}
+
+	if err := s.checkState(); err != nil {
+		return 0, nil, err
+	}
+
+	v, exist := s.replicas.Load(blockID)
+	if !exist {
+		return 0, nil, errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	stat, keys, err := b.Compact(ctx, policy)
+	if err != nil {
+		if stderr.Is(err, block.ErrNotArchived) {
+			return 0, nil, errors.ErrResourceCanNotOp.WithMessage("the block isn't archived")
+		}
+		if stderr.Is(err, block.ErrOffloaded) {
+			return 0, nil, errors.ErrResourceCanNotOp.WithMessage("the block has been offloaded")
+		}
+		return 0, nil, errors.ErrInternal.Wrap(err)
+	}
+
+	log.Info(ctx, "The block has been compacted.", map[string]interface{}{
+		"block_id":      blockID,
+		"key_attribute": policy.KeyAttribute,
+		"size":          stat.EntrySize,
+		"newer_keys":    len(policy.NewerKeys),
+	})
+
+	return int64(stat.EntrySize), keys, nil
+}
+
+func (s *server) OffloadBlock(ctx context.Context, blockID vanus.ID, key string) error {
+	ctx, span := s.tracer.Start(ctx, "OffloadBlock")
+	defer span.End()
+
+	if key == "" {
+		return errors.ErrInvalidRequest.WithMessage("the object key is empty")
+	}
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	v, exist := s.replicas.Load(blockID)
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	if err := b.Offload(ctx, key); err != nil {
+		switch {
+		case stderr.Is(err, block.ErrNotSupported):
+			return errors.ErrResourceCanNotOp.WithMessage("the object store isn't configured")
+		case stderr.Is(err, block.ErrNotArchived):
+			return errors.ErrResourceCanNotOp.WithMessage("the block isn't archived")
+		case stderr.Is(err, block.ErrOffloaded):
+			return errors.ErrResourceCanNotOp.WithMessage("the block has been offloaded to another object")
+		}
+		return errors.ErrInternal.Wrap(err)
+	}
+
+	log.Info(ctx, "The block has been offloaded.", map[string]interface{}{
+		"block_id":   blockID,
+		"object_key": key,
+	})
+
+	return nil
+}
+
+// GetBlockInfo returns the health info of block, and the replication status of its raft group if it's the leader.
+func (s *server) GetBlockInfo(
+	ctx context.Context, id vanus.ID,
+) (*metapb.SegmentHealthInfo, raft.ReplicationStatus, error) {
+	if err := s.checkState(); err != nil {
+		return nil, raft.ReplicationStatus{}, err
+	}
+
+	v, exist := s.replicas.Load(id)
+	if !exist {
+		return nil, raft.ReplicationStatus{}, errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	st, err := b.ReplicationStatus()
+	if err != nil && !stderr.Is(err, block.ErrNotLeader) {
+		return nil, raft.ReplicationStatus{}, errors.ErrInternal.Wrap(err)
+	}
+	return b.Status(), st, nil
+}
+
+// AddReplica adds a replica to the raft group of block, the block must be the leader.
+func (s *server) AddReplica(ctx context.Context, id vanus.ID, replica raft.Peer) error {
+	ctx, span := s.tracer.Start(ctx, "AddReplica")
+	defer span.End()
+
+	if replica.ID == 0 || replica.Endpoint == "" {
+		return errors.ErrInvalidRequest.WithMessage("the replica is invalid")
+	}
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	v, exist := s.replicas.Load(id)
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	if err := b.AddPeer(ctx, replica); err != nil {
+		return s.processMembershipError(ctx, b, err)
+	}
+
+	log.Info(ctx, "The replica has been added.", map[string]interface{}{
+		"block_id":   id,
+		"replica_id": replica.ID,
+		"endpoint":   replica.Endpoint,
+	})
+
+	return nil
+}
+
+// RemoveReplica removes a replica from the raft group of block, the block must be the leader.
+func (s *server) RemoveReplica(ctx context.Context, id vanus.ID, replicaID vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "RemoveReplica")
+	defer span.End()
+
+	if replicaID == id {
+		return errors.ErrInvalidRequest.WithMessage("the leader can not be removed")
+	}
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	v, exist := s.replicas.Load(id)
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	if err := b.RemovePeer(ctx, replicaID); err != nil {
+		return s.processMembershipError(ctx, b, err)
+	}
+
+	log.Info(ctx, "The replica has been removed.", map[string]interface{}{
+		"block_id":   id,
+		"replica_id": replicaID,
+	})
+
+	return nil
+}
+
+// TransferLeader transfers the leadership of raft group to a replica, the block must be the leader.
+func (s *server) TransferLeader(ctx context.Context, id vanus.ID, transfereeID vanus.ID) error {
+	ctx, span := s.tracer.Start(ctx, "TransferLeader")
+	defer span.End()
+
+	if transfereeID == id {
+		return nil
+	}
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	v, exist := s.replicas.Load(id)
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the block not found")
+	}
+
+	b, _ := v.(Replica)
+	if err := b.TransferLeader(ctx, transfereeID); err != nil {
+		if stderr.Is(err, raft.ErrNotMember) {
+			return errors.ErrInvalidRequest.WithMessage("the transferee is not a replica of block")
+		}
+		return s.processMembershipError(ctx, b, err)
+	}
+
+	log.Info(ctx, "The leadership is being transferred.", map[string]interface{}{
+		"block_id":      id,
+		"transferee_id": transfereeID,
+	})
+
+	return nil
+}
+
+func (s *server) processMembershipError(ctx context.Context, b Replica, err error) error {
+	if stderr.Is(err, block.ErrNotLeader) {
+		return errors.ErrNotLeader
+	}
+
+	log.Warning(ctx, "Change membership failed.", map[string]interface{}{
+		"block_id":   b.ID(),
+		log.KeyError: err,
+	})
+	return errors.ErrInternal.WithMessage("change membership failed").Wrap(err)
+}
 
 // ActivateSegment mark a block ready to using and preparing to initializing a replica group.
 func (s *server) ActivateSegment(
-	ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+	ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string, compression block.Compression,
 ) error {
 	ctx, span := s.tracer.Start(ctx, "ActivateSegment")
 	defer span.End()
@@ -469,6 +701,7 @@ func (s *server) ActivateSegment(
 		"replicas":    replicas,
 		"segment_id":  segID,
 		"eventlog_id": logID,
+		"compression": compression,
 	})
 
 	var myID vanus.ID
@@ -504,8 +737,17 @@ func (s *server) ActivateSegment(
 		"peers":    peers,
 	})
 
-	// Bootstrap raft.
 	b, _ := v.(Replica)
+	if compression != block.CompressionNone {
+		if err := b.SetCompression(ctx, compression); err != nil {
+			if stderr.Is(err, block.ErrNotSupported) {
+				return errors.ErrInvalidRequest.WithMessage("the compression isn't supported")
+			}
+			return errors.ErrInternal.Wrap(err)
+		}
+	}
+
+	// Bootstrap raft.
 	if err := b.Bootstrap(ctx, peers); err != nil {
 		return err
 	}
@@ -81,6 +81,13 @@ func (s *server) loadVSBEngine(ctx context.Context, cfg config.VSB) error {
 	opts := append([]vsb.Option{
 		vsb.WithArchivedListener(block.ArchivedCallback(s.onBlockArchived)),
 	}, cfg.Options()...)
+	store, err := s.cfg.ObjectStore.Build()
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		opts = append(opts, vsb.WithObjectStore(store))
+	}
 	return vsb.Initialize(dir, opts...)
 }
 
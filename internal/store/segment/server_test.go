@@ -48,7 +48,7 @@ func TestServer_RemoveBlock(t *testing.T) {
 		}
 
 		Convey("state checking", func() {
-			err := srv.RemoveBlock(context.Background(), vanus.NewTestID())
+			err := srv.RemoveBlock(context.Background(), vanus.NewTestID(), false)
 			et := err.(*errors.ErrorType)
 			So(et.Description, ShouldEqual, "service state error")
 			So(et.Code, ShouldEqual, errors.ErrorCode_SERVICE_STATE_ERROR)
@@ -60,7 +60,7 @@ func TestServer_RemoveBlock(t *testing.T) {
 		Convey("not found block", func() {
 			srv.state = primitive.ServerStateRunning
 
-			err := srv.RemoveBlock(context.Background(), vanus.NewTestID())
+			err := srv.RemoveBlock(context.Background(), vanus.NewTestID(), false)
 			et := err.(*errors.ErrorType)
 			So(et.Description, ShouldEqual, "resource not found")
 			So(et.Code, ShouldEqual, errors.ErrorCode_RESOURCE_NOT_FOUND)
@@ -79,10 +79,109 @@ func TestServer_RemoveBlock(t *testing.T) {
 
 			srv.state = primitive.ServerStateRunning
 
-			err := srv.RemoveBlock(context.Background(), id)
+			err := srv.RemoveBlock(context.Background(), id, false)
 			So(err, ShouldBeNil)
 			So(util.MapLen(&srv.replicas), ShouldEqual, 0)
 		})
+
+		Convey("delete block with offloaded object", func() {
+			ctrl := NewController(t)
+			defer ctrl.Finish()
+
+			id := vanus.NewTestID()
+			b := NewMockReplica(ctrl)
+			b.EXPECT().ID().AnyTimes().Return(id)
+			srv.replicas.Store(id, b)
+
+			srv.state = primitive.ServerStateRunning
+
+			b.EXPECT().DeleteObject(Any()).Return(errors.ErrInternal)
+			err := srv.RemoveBlock(context.Background(), id, true)
+			So(errors.Is(err, errors.ErrInternal), ShouldBeTrue)
+			So(util.MapLen(&srv.replicas), ShouldEqual, 1)
+
+			InOrder(
+				b.EXPECT().DeleteObject(Any()).Return(nil),
+				b.EXPECT().Delete(Any()).Return(nil),
+			)
+			err = srv.RemoveBlock(context.Background(), id, true)
+			So(err, ShouldBeNil)
+			So(util.MapLen(&srv.replicas), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestServer_CompactBlock(t *testing.T) {
+	Convey("compact block", t, func() {
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+		policy := block.CompactionPolicy{KeyAttribute: "subject"}
+
+		Convey("invalid policy", func() {
+			_, _, err := srv.CompactBlock(context.Background(), vanus.NewTestID(), block.CompactionPolicy{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("not found block", func() {
+			_, _, err := srv.CompactBlock(context.Background(), vanus.NewTestID(), policy)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("compact block", func() {
+			ctrl := NewController(t)
+			defer ctrl.Finish()
+
+			id := vanus.NewTestID()
+			b := NewMockReplica(ctrl)
+			srv.replicas.Store(id, b)
+
+			b.EXPECT().Compact(Any(), policy).Return(block.Statistics{}, nil, block.ErrNotArchived)
+			_, _, err := srv.CompactBlock(context.Background(), id, policy)
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			keys := []block.KeyHash{block.HashKey([]byte("a"))}
+			b.EXPECT().Compact(Any(), policy).Return(block.Statistics{EntrySize: 1024}, keys, nil)
+			size, res, err := srv.CompactBlock(context.Background(), id, policy)
+			So(err, ShouldBeNil)
+			So(size, ShouldEqual, 1024)
+			So(res, ShouldResemble, keys)
+		})
+	})
+}
+
+func TestServer_OffloadBlock(t *testing.T) {
+	Convey("offload block", t, func() {
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		Convey("invalid key", func() {
+			err := srv.OffloadBlock(context.Background(), vanus.NewTestID(), "")
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("not found block", func() {
+			err := srv.OffloadBlock(context.Background(), vanus.NewTestID(), "segments/1")
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("offload block", func() {
+			ctrl := NewController(t)
+			defer ctrl.Finish()
+
+			id := vanus.NewTestID()
+			b := NewMockReplica(ctrl)
+			srv.replicas.Store(id, b)
+
+			b.EXPECT().Offload(Any(), "segments/1").Return(block.ErrNotSupported)
+			err := srv.OffloadBlock(context.Background(), id, "segments/1")
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			b.EXPECT().Offload(Any(), "segments/1").Return(nil)
+			err = srv.OffloadBlock(context.Background(), id, "segments/1")
+			So(err, ShouldBeNil)
+		})
 	})
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/vanus-labs/vanus/internal/store/block"
 	"github.com/vanus-labs/vanus/internal/store/io/stream"
 	"github.com/vanus-labs/vanus/internal/store/io/zone"
+	"github.com/vanus-labs/vanus/internal/store/objstore"
 	"github.com/vanus-labs/vanus/internal/store/vsb/codec"
 	"github.com/vanus-labs/vanus/internal/store/vsb/index"
 )
@@ -57,13 +58,27 @@ type vsBlock struct {
 	fm      meta // flushed meta
 	actx    appendContext
 	indexes []index.Index
-	mu      sync.RWMutex
+	// snapOffset is the end of snapshot data which has been written but not applied.
+	snapOffset int64
+	mu         sync.RWMutex
+	// fmu protects f, obj and indexes from being replaced by compaction or offloading during reading.
+	fmu sync.RWMutex
+	// omu serializes compaction and offloading.
+	omu sync.Mutex
 
 	enc codec.EntryEncoder
 	dec codec.EntryDecoder
 	lis block.ArchivedListener
 
-	f  *os.File
+	f *os.File
+	// obj reads the entries of offloaded block from object storage, it's nil if the block isn't offloaded.
+	obj *offloadedReader
+	// key is the key of object which the block is offloaded to, it's empty if the block isn't offloaded.
+	key   string
+	store objstore.Store
+	// compression is the algorithm used to compress the data of entries, it's accessed atomically.
+	compression uint32
+
 	z  zone.Interface
 	s  stream.Stream
 	wg sync.WaitGroup
@@ -106,7 +121,8 @@ func (b *vsBlock) Close(ctx context.Context) error {
 	return b.f.Close()
 }
 
-func (b *vsBlock) Delete(context.Context) error {
+// Delete removes the local file of block, the offloaded object is kept, see DeleteObject.
+func (b *vsBlock) Delete(ctx context.Context) error {
 	// FIXME(james.yin): make sure block is closed.
 	return os.Remove(b.path)
 }
@@ -43,6 +43,8 @@ type appendContext struct {
 	seq      int64
 	offset   int64
 	archived uint32
+	// compression is the algorithm used to compress the data of entries appended later.
+	compression block.Compression
 }
 
 // Make sure appendContext implements block.AppendContext.
@@ -68,17 +70,22 @@ func (b *vsBlock) NewAppendContext(last block.Fragment) block.AppendContext {
 		_, entry, _ := b.dec.UnmarshalLast(last.Payload())
 		seq := ceschema.SequenceNumber(entry)
 		actx := &appendContext{
-			seq:    seq + 1,
-			offset: last.EndOffset(),
+			seq:         seq + 1,
+			offset:      last.EndOffset(),
+			compression: b.getCompression(),
 		}
 		if ceschema.EntryType(entry) == ceschema.End {
 			actx.archived = 1
+		} else if actx.compression == block.CompressionNone {
+			// The compression isn't set for followers, so inherit it from the entries appended by previous leader.
+			actx.compression = block.Compression(entry.GetUint16(ceschema.CompressionOrdinal))
 		}
 		return actx
 	}
 
 	// Copy append context.
 	actx := b.actx
+	actx.compression = b.getCompression()
 	return &actx
 }
 
@@ -99,6 +106,10 @@ func (b *vsBlock) PrepareAppend(
 		seqs[i] = seq
 	}
 
+	if actx.compression != block.CompressionNone {
+		compressEntries(ents, actx.compression)
+	}
+
 	frag := newFragment(actx.offset, ents, b.enc)
 
 	actx.offset += int64(frag.Size())
@@ -0,0 +1,195 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io"
+	ceschema "github.com/vanus-labs/vanus/internal/store/schema/ce"
+	"github.com/vanus-labs/vanus/internal/store/vsb/codec"
+	"github.com/vanus-labs/vanus/internal/store/vsb/index"
+)
+
+const compactingExt = ".compacting"
+
+// Make sure block implements block.Compactor.
+var _ block.Compactor = (*vsBlock)(nil)
+
+// Compact rewrites the archived block without obsolete entries. An entry is obsolete if a later entry in the block
+// or an entry in the newer blocks (policy.NewerKeys) has the same key hash, or it's a tombstone written before the
+// deadline. The removed entries are replaced by empty indexes, so the sequence numbers of retained entries are
+// unchanged. The last entry is always retained to keep the end of block.
+//
+// The hashes of distinct keys of entries in the block are returned, including the keys of removed tombstones, so
+// that the entries with these keys in the older blocks are removed too.
+func (b *vsBlock) Compact(
+	ctx context.Context, policy block.CompactionPolicy,
+) (block.Statistics, []block.KeyHash, error) {
+	if !b.full() {
+		return block.Statistics{}, nil, block.ErrNotArchived
+	}
+
+	// Wait for index entry is appended.
+	b.wg.Wait()
+
+	b.omu.Lock()
+	defer b.omu.Unlock()
+
+	if b.key != "" {
+		return block.Statistics{}, nil, block.ErrOffloaded
+	}
+
+	m, indexes := b.makeSnapshot()
+	if len(indexes) == 0 {
+		return b.stat(m, indexes), nil, nil
+	}
+
+	data := make([]byte, m.writeOffset-b.dataOffset)
+	if _, err := b.reader().ReadAt(data, b.dataOffset); err != nil {
+		return block.Statistics{}, nil, err
+	}
+
+	retained, keys, ok := b.selectRetained(data, indexes, policy)
+	if !ok {
+		return b.stat(m, indexes), keys, nil
+	}
+
+	// Rebuild data and indexes, the index of removed entry points to the next retained entry.
+	end := indexes[len(indexes)-1].EndOffset()
+	compacted := make([]byte, b.dataOffset, b.dataOffset+int64(len(data)))
+	newIndexes := make([]index.Index, len(indexes))
+	for i, idx := range indexes {
+		off := int64(len(compacted))
+		if !retained[i] {
+			newIndexes[i] = index.NewIndex(off, 0, index.WithStime(idx.Stime()))
+			continue
+		}
+		compacted = append(compacted, data[idx.StartOffset()-b.dataOffset:idx.EndOffset()-b.dataOffset]...)
+		newIndexes[i] = index.NewIndex(off, idx.Length(), index.WithStime(idx.Stime()))
+	}
+	// Append end entry.
+	compacted = append(compacted, data[end-b.dataOffset:]...)
+
+	nm, _ := makeSnapshot(appendContext{offset: int64(len(compacted)), archived: 1}, newIndexes)
+	f, n, err := b.writeCompacted(ctx, compacted, nm, newIndexes)
+	if err != nil {
+		return block.Statistics{}, nil, err
+	}
+
+	b.fmu.Lock()
+	b.mu.Lock()
+	old := b.f
+	b.f = f
+	b.indexes = newIndexes
+	b.actx.offset = nm.writeOffset
+	b.indexOffset = nm.writeOffset
+	b.indexLength = n
+	b.fm = nm
+	b.mu.Unlock()
+	b.fmu.Unlock()
+
+	if err = old.Close(); err != nil {
+		return block.Statistics{}, nil, err
+	}
+
+	return b.stat(nm, newIndexes), keys, nil
+}
+
+// selectRetained returns the flags of retained entries, the hashes of distinct keys of entries, and whether any
+// entry is removed.
+func (b *vsBlock) selectRetained(
+	data []byte, indexes []index.Index, policy block.CompactionPolicy,
+) ([]bool, []block.KeyHash, bool) {
+	retained := make([]bool, len(indexes))
+	keys := make(map[block.KeyHash]struct{})
+	removed := false
+	for i := len(indexes) - 1; i >= 0; i-- {
+		idx := indexes[i]
+		// Removed by previous compaction.
+		if idx.Length() == 0 {
+			continue
+		}
+
+		_, entry, _ := b.dec.Unmarshal(data[idx.StartOffset()-b.dataOffset:])
+		key := ceschema.Attribute(entry, policy.KeyAttribute)
+		if key == nil || i == len(indexes)-1 {
+			retained[i] = true
+			if key != nil {
+				keys[block.HashKey(key)] = struct{}{}
+			}
+			continue
+		}
+
+		kh := block.HashKey(key)
+		if _, ok := keys[kh]; ok {
+			removed = true
+			continue
+		}
+		keys[kh] = struct{}{}
+		if _, ok := policy.NewerKeys[kh]; ok {
+			removed = true
+			continue
+		}
+
+		// The entry whose data is corrupted isn't a tombstone.
+		if idx.Stime() < policy.TombstoneDeadline && codec.DecompressData(entry, int(b.capacity)) == nil &&
+			len(entry.GetBytes(ceschema.DataOrdinal)) == 0 {
+			removed = true
+			continue
+		}
+		retained[i] = true
+	}
+
+	distinct := make([]block.KeyHash, 0, len(keys))
+	for kh := range keys {
+		distinct = append(distinct, kh)
+	}
+	return retained, distinct, removed
+}
+
+// writeCompacted writes the compacted block to a new file, which replaces the original file.
+func (b *vsBlock) writeCompacted(
+	ctx context.Context, data []byte, m meta, indexes []index.Index,
+) (*os.File, int, error) {
+	entry := index.NewEntry(indexes)
+	sz := b.enc.Size(entry)
+	buf := make([]byte, len(data)+sz)
+	copy(buf, data)
+	if _, err := b.enc.MarshalTo(entry, buf[len(data):]); err != nil {
+		return nil, 0, err
+	}
+	copy(buf, b.marshalHeader(m, m.writeOffset, 0))
+
+	path := b.path + compactingExt
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, 0, err
+	}
+	f, err := io.CreateFile(path, 0, os.O_RDWR, true, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, err = f.WriteAt(buf, 0); err != nil {
+		return nil, 0, processError(err, f, path)
+	}
+	if err = os.Rename(path, b.path); err != nil {
+		return nil, 0, processError(err, f, path)
+	}
+	return f, sz, nil
+}
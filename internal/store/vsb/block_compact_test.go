@@ -0,0 +1,165 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io/engine/psync"
+	"github.com/vanus-labs/vanus/internal/store/io/stream"
+	ceschema "github.com/vanus-labs/vanus/internal/store/schema/ce"
+	ceconv "github.com/vanus-labs/vanus/internal/store/schema/ce/convert"
+)
+
+func makeKeyedEntry(key, data string) block.Entry {
+	event := &cepb.CloudEvent{
+		Id:          "id",
+		Source:      "source",
+		SpecVersion: "1.0",
+		Type:        "type",
+	}
+	if key != "" {
+		event.Attributes = map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+			"subject": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: key}},
+		}
+	}
+	if data != "" {
+		event.Data = &cepb.CloudEvent_TextData{TextData: data}
+	}
+	return ceconv.ToEntry(event)
+}
+
+func readSeqs(ctx context.Context, b block.Reader, seq int64, num int) []int64 {
+	entries, err := b.Read(ctx, seq, num)
+	So(err, ShouldBeNil)
+	seqs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		seqs = append(seqs, ceschema.SequenceNumber(entry))
+	}
+	return seqs
+}
+
+func keyHashes(keys ...string) map[block.KeyHash]struct{} {
+	m := make(map[block.KeyHash]struct{}, len(keys))
+	for _, key := range keys {
+		m[block.HashKey([]byte(key))] = struct{}{}
+	}
+	return m
+}
+
+func keyHashSet(hashes []block.KeyHash) map[block.KeyHash]struct{} {
+	m := make(map[block.KeyHash]struct{}, len(hashes))
+	for _, kh := range hashes {
+		m[kh] = struct{}{}
+	}
+	return m
+}
+
+func TestVSBlock_Compact(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("compact vsb by key", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &engine{dir: dir, s: stream.NewScheduler(psync.New())}
+		defer e.Close()
+
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, 1<<20)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		policy := block.CompactionPolicy{KeyAttribute: "subject"}
+		_, _, err = b.Compact(ctx, policy)
+		So(err, ShouldEqual, block.ErrNotArchived)
+
+		entries := []block.Entry{
+			makeKeyedEntry("a", "1"),
+			makeKeyedEntry("b", "1"),
+			makeKeyedEntry("a", "2"),
+			makeKeyedEntry("", "x"),
+			makeKeyedEntry("b", ""),
+			makeKeyedEntry("c", "1"),
+			makeKeyedEntry("c", "2"),
+		}
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, entries...)
+		So(err, ShouldBeNil)
+		ch := make(chan struct{}, 1)
+		b.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+		<-ch
+		frag, err = b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		b.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+		<-ch
+		size := b.Status().EntrySize
+
+		// The tombstone is retained before the deadline.
+		stat, keys, err := b.Compact(ctx, policy)
+		So(err, ShouldBeNil)
+		So(keyHashSet(keys), ShouldResemble, keyHashes("a", "b", "c"))
+		So(stat.EntryNum, ShouldEqual, 7)
+		So(stat.EntrySize, ShouldBeLessThan, size)
+		So(readSeqs(ctx, b, 0, 10), ShouldResemble, []int64{2, 3, 4, 6})
+		So(readSeqs(ctx, b, 0, 2), ShouldResemble, []int64{2, 3})
+		So(readSeqs(ctx, b, 5, 10), ShouldResemble, []int64{6})
+
+		policy.TombstoneDeadline = time.Now().Add(time.Hour).UnixMilli()
+		stat, keys, err = b.Compact(ctx, policy)
+		So(err, ShouldBeNil)
+		So(keyHashSet(keys), ShouldResemble, keyHashes("a", "b", "c"))
+		So(stat.EntryNum, ShouldEqual, 7)
+		So(readSeqs(ctx, b, 0, 10), ShouldResemble, []int64{2, 3, 6})
+		So(readSeqs(ctx, b, 4, 10), ShouldResemble, []int64{6})
+
+		Convey("compact with the keys of newer blocks", func() {
+			// The last entry is retained even if its key is in newer blocks.
+			policy.NewerKeys = keyHashes("a", "c")
+			_, keys, err = b.Compact(ctx, policy)
+			So(err, ShouldBeNil)
+			So(keyHashSet(keys), ShouldResemble, keyHashes("a", "c"))
+			So(readSeqs(ctx, b, 0, 10), ShouldResemble, []int64{3, 6})
+		})
+
+		Convey("reopen the compacted block", func() {
+			So(b.Close(ctx), ShouldBeNil)
+			r, err = e.Open(ctx, id)
+			So(err, ShouldBeNil)
+			defer r.Close(ctx)
+
+			stat := r.Status()
+			So(stat.Archived, ShouldBeTrue)
+			So(stat.EntryNum, ShouldEqual, 7)
+			So(readSeqs(ctx, r, 0, 10), ShouldResemble, []int64{2, 3, 6})
+			_, err = r.Read(ctx, 7, 1)
+			So(err, ShouldEqual, block.ErrExceeded)
+		})
+	})
+}
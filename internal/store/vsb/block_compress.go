@@ -0,0 +1,73 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/observability/metrics"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/block"
+)
+
+// Make sure block implements block.Compressor.
+var _ block.Compressor = (*vsBlock)(nil)
+
+// SetCompression sets the algorithm used to compress the data of entries, and persists it in header. It should be
+// called before appending, when the segment is activated.
+func (b *vsBlock) SetCompression(ctx context.Context, c block.Compression) error {
+	switch c {
+	case block.CompressionNone, block.CompressionLZ4, block.CompressionZstd:
+	default:
+		return block.ErrNotSupported
+	}
+
+	if b.getCompression() == c {
+		return nil
+	}
+	atomic.StoreUint32(&b.compression, uint32(c))
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	return b.persistHeader(ctx, m)
+}
+
+func (b *vsBlock) getCompression() block.Compression {
+	return block.Compression(atomic.LoadUint32(&b.compression))
+}
+
+// compressEntries compresses the data of entries, and records the sizes of data before and after compression.
+func compressEntries(entries []block.Entry, c block.Compression) {
+	var in, out int
+	for _, entry := range entries {
+		if w, ok := entry.(*entryExtWrapper); ok {
+			n, m := w.compress(c)
+			in += n
+			out += m
+		}
+	}
+	if in == 0 {
+		return
+	}
+
+	label := c.String()
+	metrics.CompressionInputByteCounterVec.WithLabelValues(label).Add(float64(in))
+	metrics.CompressionOutputByteCounterVec.WithLabelValues(label).Add(float64(out))
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io/engine/psync"
+	"github.com/vanus-labs/vanus/internal/store/io/stream"
+	ceschema "github.com/vanus-labs/vanus/internal/store/schema/ce"
+	ceconv "github.com/vanus-labs/vanus/internal/store/schema/ce/convert"
+	"github.com/vanus-labs/vanus/internal/store/vsb/codec"
+)
+
+func makeDataEntry(data string) block.Entry {
+	return ceconv.ToEntry(&cepb.CloudEvent{
+		Id:          "id",
+		Source:      "source",
+		SpecVersion: "1.0",
+		Type:        "type",
+		Data:        &cepb.CloudEvent_BinaryData{BinaryData: []byte(data)},
+	})
+}
+
+func appendEntries(ctx context.Context, b *vsBlock, entries ...block.Entry) block.Fragment {
+	actx := b.NewAppendContext(nil)
+	_, frag, _, err := b.PrepareAppend(ctx, actx, entries...)
+	So(err, ShouldBeNil)
+	ch := make(chan struct{}, 1)
+	b.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+	<-ch
+	return frag
+}
+
+func readData(ctx context.Context, b block.Reader, num int) []string {
+	entries, err := b.Read(ctx, 0, num)
+	So(err, ShouldBeNil)
+	data := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, string(entry.GetBytes(ceschema.DataOrdinal)))
+	}
+	return data
+}
+
+func TestVSBlock_Compression(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("compress the data of entries", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &engine{dir: dir, s: stream.NewScheduler(psync.New())}
+		defer e.Close()
+
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, 1<<20)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		So(b.SetCompression(ctx, block.Compression(100)), ShouldEqual, block.ErrNotSupported)
+
+		data := strings.Repeat("vanus", 100)
+		plain := appendEntries(ctx, b, makeDataEntry(data), makeDataEntry("x"))
+
+		So(b.SetCompression(ctx, block.CompressionLZ4), ShouldBeNil)
+		lz4Frag := appendEntries(ctx, b, makeDataEntry(data), makeDataEntry("x"))
+		So(lz4Frag.Size(), ShouldBeLessThan, plain.Size())
+
+		So(b.SetCompression(ctx, block.CompressionZstd), ShouldBeNil)
+		zstdFrag := appendEntries(ctx, b, makeDataEntry(data))
+
+		expected := []string{data, "x", data, "x", data}
+		So(readData(ctx, b, 10), ShouldResemble, expected)
+
+		Convey("inherit the compression from the last entry", func() {
+			follower := &vsBlock{enc: b.enc, dec: b.dec}
+			actx, _ := follower.NewAppendContext(lz4Frag).(*appendContext)
+			So(actx.compression, ShouldEqual, block.CompressionLZ4)
+			actx, _ = follower.NewAppendContext(zstdFrag).(*appendContext)
+			So(actx.compression, ShouldEqual, block.CompressionZstd)
+			actx, _ = follower.NewAppendContext(plain).(*appendContext)
+			So(actx.compression, ShouldEqual, block.CompressionNone)
+		})
+
+		Convey("reopen the block with mixed entries", func() {
+			So(b.Close(ctx), ShouldBeNil)
+			r, err = e.Open(ctx, id)
+			So(err, ShouldBeNil)
+			defer r.Close(ctx)
+
+			b, _ = r.(*vsBlock)
+			So(b.getCompression(), ShouldEqual, block.CompressionZstd)
+			So(readData(ctx, b, 10), ShouldResemble, expected)
+		})
+
+		Convey("read the entry whose data is larger than block", func() {
+			b.capacity = int64(len(data) - 1)
+			_, err = b.Read(ctx, 0, 2)
+			So(err, ShouldBeNil)
+			_, err = b.Read(ctx, 2, 1)
+			So(err, ShouldEqual, codec.ErrCorruptedRecord)
+			_, err = b.Read(ctx, 4, 1)
+			So(err, ShouldEqual, codec.ErrCorruptedRecord)
+		})
+	})
+}
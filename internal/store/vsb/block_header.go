@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/binary"
 	"hash/crc32"
+	"sync/atomic"
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/store/block/raw"
@@ -26,7 +27,7 @@ import (
 
 const (
 	headerBlockSize = 4 * 1024
-	headerSize      = 4 + 4 + 4 + 4 + 4 + 1 + 1 + 2 + 8 + 8 + 4 + 2
+	headerSize      = 4 + 4 + 4 + 4 + 4 + 1 + 1 + 2 + 8 + 8 + 4 + 2 + 2
 
 	magicOffset       = 0
 	crcOffset         = 4
@@ -39,6 +40,7 @@ const (
 	entryLengthOffset = 32
 	entryNumOffset    = 40
 	indexOffsetOffset = 44
+	compressionOffset = 46
 )
 
 var (
@@ -47,9 +49,22 @@ var (
 )
 
 func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
+	buf := b.marshalHeader(m, b.indexOffset, b.flags())
+	if _, err := b.f.WriteAt(buf, 0); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.fm = m
+	b.mu.Unlock()
+
+	return nil
+}
+
+func (b *vsBlock) marshalHeader(m meta, indexOffset int64, flags uint32) []byte {
 	var buf [headerSize]byte
 	binary.LittleEndian.PutUint32(buf[magicOffset:], FormatMagic)               // magic
-	binary.LittleEndian.PutUint32(buf[flagsOffset:], 0)                         // flags
+	binary.LittleEndian.PutUint32(buf[flagsOffset:], flags)                     // flags
 	binary.LittleEndian.PutUint32(buf[breakFlagsOffset:], 0)                    // break flags
 	binary.LittleEndian.PutUint32(buf[dataOffsetOffset:], uint32(b.dataOffset)) // data offset
 	if m.archived {                                                             // state
@@ -59,23 +74,16 @@ func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
 	binary.LittleEndian.PutUint64(buf[capacityOffset:], uint64(b.capacity))       // capacity
 	binary.LittleEndian.PutUint64(buf[entryLengthOffset:], uint64(m.entryLength)) // entry length
 	binary.LittleEndian.PutUint32(buf[entryNumOffset:], uint32(m.entryNum))       // entry number
-	if eo := b.dataOffset + m.entryLength; b.indexOffset > eo {                   // index offset
-		off := b.indexOffset - eo
+	if eo := b.dataOffset + m.entryLength; indexOffset > eo {                     // index offset
+		off := indexOffset - eo
 		binary.LittleEndian.PutUint16(buf[indexOffsetOffset:], uint16(off))
 	}
+	binary.LittleEndian.PutUint16(buf[compressionOffset:], uint16(b.getCompression())) // compression
 	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
 	crc = crc32.Update(crc, crc32q, emptyHeader[headerSize:])
 	binary.LittleEndian.PutUint32(buf[crcOffset:], crc) // crc
 
-	if _, err := b.f.WriteAt(buf[:], 0); err != nil {
-		return err
-	}
-
-	b.mu.Lock()
-	b.fm = m
-	b.mu.Unlock()
-
-	return nil
+	return buf[:]
 }
 
 func (b *vsBlock) loadHeader(ctx context.Context) error {
@@ -100,6 +108,8 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 	b.capacity = int64(binary.LittleEndian.Uint64(buf[capacityOffset:]))          // capacity
 	b.fm.entryLength = int64(binary.LittleEndian.Uint64(buf[entryLengthOffset:])) // entry length
 	b.fm.entryNum = int64(binary.LittleEndian.Uint32(buf[entryNumOffset:]))       // entry number
+	flags := binary.LittleEndian.Uint32(buf[flagsOffset:])                        // flags
+	compression := binary.LittleEndian.Uint16(buf[compressionOffset:])            // compression
 
 	origin := binary.LittleEndian.Uint32(buf[crcOffset:])
 	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
@@ -108,5 +118,11 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 		return errCorrupted
 	}
 
+	atomic.StoreUint32(&b.compression, uint32(compression))
+
+	if flags&flagOffloaded != 0 {
+		return b.loadObjectKey()
+	}
+
 	return nil
 }
@@ -0,0 +1,230 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	stderr "errors"
+	"fmt"
+	stdio "io"
+	"os"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io"
+	"github.com/vanus-labs/vanus/internal/store/objstore"
+)
+
+const (
+	flagOffloaded = uint32(1) << 0
+
+	offloadingExt = ".offloading"
+
+	// The object key is stored after header in the header block of stub file.
+	objectKeyLengthOffset = headerSize
+	objectKeyOffset       = objectKeyLengthOffset + 2
+	maxObjectKeyLength    = headerBlockSize - objectKeyOffset
+)
+
+// Make sure block implements block.Offloader.
+var _ block.Offloader = (*vsBlock)(nil)
+
+// Offload uploads the archived block to object storage, then replaces the local file with a stub file, which
+// only keeps the header block and the entries after data (the end entry and the index entry) at the original
+// offsets. The data of entries is read from object storage after offloaded.
+func (b *vsBlock) Offload(ctx context.Context, key string) error {
+	if b.store == nil {
+		return block.ErrNotSupported
+	}
+	if key == "" || len(key) > maxObjectKeyLength {
+		return fmt.Errorf("invalid object key: %s", key)
+	}
+	if !b.full() {
+		return block.ErrNotArchived
+	}
+
+	// Wait for index entry is appended.
+	b.wg.Wait()
+
+	b.omu.Lock()
+	defer b.omu.Unlock()
+
+	if b.key != "" {
+		if b.key == key {
+			return nil
+		}
+		return block.ErrOffloaded
+	}
+
+	m, _ := b.makeSnapshot()
+	size := b.indexOffset + int64(b.indexLength)
+	if err := b.upload(ctx, key, size); err != nil {
+		return err
+	}
+
+	stub, err := b.writeStub(m, key, size)
+	if err != nil {
+		return err
+	}
+
+	b.fmu.Lock()
+	old := b.f
+	b.f = stub
+	b.key = key
+	b.obj = b.newOffloadedReader(stub)
+	b.fmu.Unlock()
+
+	return old.Close()
+}
+
+func (b *vsBlock) DeleteObject(ctx context.Context) error {
+	b.omu.Lock()
+	defer b.omu.Unlock()
+
+	if b.key == "" {
+		return nil
+	}
+	return b.store.Delete(ctx, b.key)
+}
+
+// upload uploads the block as object key. The replicas of segment are identical after archived, so the object
+// which has been uploaded by another replica is reused.
+func (b *vsBlock) upload(ctx context.Context, key string, size int64) error {
+	n, err := b.store.Size(ctx, key)
+	if err == nil && n == size {
+		return nil
+	}
+	if err != nil && !stderr.Is(err, objstore.ErrNotFound) {
+		return err
+	}
+	return b.store.Put(ctx, key, stdio.NewSectionReader(b.f, 0, size), size)
+}
+
+// writeStub writes the stub file of offloaded block, which replaces the original file.
+func (b *vsBlock) writeStub(m meta, key string, size int64) (*os.File, error) {
+	eo := b.dataOffset + m.entryLength
+	tail := make([]byte, size-eo)
+	if _, err := b.f.ReadAt(tail, eo); err != nil {
+		return nil, err
+	}
+
+	hdr := make([]byte, objectKeyOffset+len(key))
+	copy(hdr, b.marshalHeader(m, b.indexOffset, flagOffloaded))
+	binary.LittleEndian.PutUint16(hdr[objectKeyLengthOffset:], uint16(len(key)))
+	copy(hdr[objectKeyOffset:], key)
+
+	path := b.path + offloadingExt
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	f, err := io.CreateFile(path, 0, os.O_RDWR, true, false)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.WriteAt(hdr, 0); err != nil {
+		return nil, processError(err, f, path)
+	}
+	// The data of entries is left as a hole.
+	if _, err = f.WriteAt(tail, eo); err != nil {
+		return nil, processError(err, f, path)
+	}
+	if err = os.Rename(path, b.path); err != nil {
+		return nil, processError(err, f, path)
+	}
+	return f, nil
+}
+
+// reader returns the reader of block, the entries of offloaded block are read from object storage.
+func (b *vsBlock) reader() stdio.ReaderAt {
+	if b.obj != nil {
+		return b.obj
+	}
+	return b.f
+}
+
+func (b *vsBlock) flags() uint32 {
+	if b.key != "" {
+		return flagOffloaded
+	}
+	return 0
+}
+
+func (b *vsBlock) loadObjectKey() error {
+	var buf [maxObjectKeyLength + 2]byte
+	if _, err := b.f.ReadAt(buf[:], objectKeyLengthOffset); err != nil {
+		return err
+	}
+	n := int(binary.LittleEndian.Uint16(buf[:]))
+	if n == 0 || n > maxObjectKeyLength {
+		return errCorrupted
+	}
+	b.key = string(buf[2 : 2+n])
+	return nil
+}
+
+func (b *vsBlock) newOffloadedReader(stub *os.File) *offloadedReader {
+	return &offloadedReader{
+		stub:  stub,
+		store: b.store,
+		key:   b.key,
+		start: b.dataOffset,
+		end:   b.dataOffset + b.fm.entryLength,
+	}
+}
+
+// offloadedReader reads the data of entries in [start, end) from object storage, and the others from stub file.
+type offloadedReader struct {
+	stub  *os.File
+	store objstore.Store
+	key   string
+	start int64
+	end   int64
+}
+
+// Make sure offloadedReader implements io.ReaderAt.
+var _ stdio.ReaderAt = (*offloadedReader)(nil)
+
+func (r *offloadedReader) ReadAt(p []byte, off int64) (int, error) {
+	var n int
+	if off < r.start {
+		l := len(p)
+		if int64(l) > r.start-off {
+			l = int(r.start - off)
+		}
+		m, err := r.stub.ReadAt(p[:l], off)
+		if n += m; err != nil {
+			return n, err
+		}
+		p, off = p[l:], off+int64(l)
+	}
+	if len(p) != 0 && off < r.end {
+		l := len(p)
+		if int64(l) > r.end-off {
+			l = int(r.end - off)
+		}
+		m, err := r.store.ReadAt(context.Background(), r.key, p[:l], off)
+		if n += m; err != nil {
+			return n, err
+		}
+		p, off = p[l:], off+int64(l)
+	}
+	if len(p) != 0 {
+		m, err := r.stub.ReadAt(p, off)
+		return n + m, err
+	}
+	return n, nil
+}
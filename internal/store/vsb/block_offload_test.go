@@ -0,0 +1,116 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io/engine/psync"
+	"github.com/vanus-labs/vanus/internal/store/io/stream"
+	"github.com/vanus-labs/vanus/internal/store/objstore"
+)
+
+func TestVSBlock_Offload(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("offload vsb to object storage", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		store, err := objstore.NewLocal(filepath.Join(dir, "objects"))
+		So(err, ShouldBeNil)
+		e := &engine{dir: dir, s: stream.NewScheduler(psync.New()), store: store}
+		defer e.Close()
+
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, 1<<20)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		So(b.Offload(ctx, "segments/1"), ShouldEqual, block.ErrNotArchived)
+
+		entries := []block.Entry{
+			makeKeyedEntry("a", "1"),
+			makeKeyedEntry("b", "1"),
+			makeKeyedEntry("a", "2"),
+		}
+		actx := b.NewAppendContext(nil)
+		_, frag, _, err := b.PrepareAppend(ctx, actx, entries...)
+		So(err, ShouldBeNil)
+		ch := make(chan struct{}, 1)
+		b.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+		<-ch
+		frag, err = b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		b.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+		<-ch
+
+		So(b.Offload(ctx, ""), ShouldNotBeNil)
+		So(b.Offload(ctx, "segments/1"), ShouldBeNil)
+		So(b.Offload(ctx, "segments/1"), ShouldBeNil)
+		So(b.Offload(ctx, "segments/2"), ShouldEqual, block.ErrOffloaded)
+		size, err := store.Size(ctx, "segments/1")
+		So(err, ShouldBeNil)
+		So(size, ShouldEqual, b.indexOffset+int64(b.indexLength))
+
+		So(readSeqs(ctx, b, 0, 10), ShouldResemble, []int64{0, 1, 2})
+		_, _, err = b.Compact(ctx, block.CompactionPolicy{KeyAttribute: "subject"})
+		So(err, ShouldEqual, block.ErrOffloaded)
+
+		// The data of entries isn't in the stub file.
+		data := make([]byte, b.fm.entryLength)
+		_, err = b.f.ReadAt(data, b.dataOffset)
+		So(err, ShouldBeNil)
+		So(data, ShouldResemble, make([]byte, len(data)))
+
+		Convey("reopen the offloaded block", func() {
+			So(b.Close(ctx), ShouldBeNil)
+			r, err = e.Open(ctx, id)
+			So(err, ShouldBeNil)
+
+			stat := r.Status()
+			So(stat.Archived, ShouldBeTrue)
+			So(stat.EntryNum, ShouldEqual, 3)
+			So(readSeqs(ctx, r, 1, 10), ShouldResemble, []int64{1, 2})
+
+			_, err = (&engine{dir: dir, s: e.s}).Open(ctx, id)
+			So(err, ShouldEqual, errObjectStoreRequired)
+
+			// The object is shared by other replicas, so it's kept after the block is deleted.
+			So(r.Close(ctx), ShouldBeNil)
+			So(r.Delete(ctx), ShouldBeNil)
+			_, err = store.Size(ctx, "segments/1")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("delete the offloaded object", func() {
+			So(b.DeleteObject(ctx), ShouldBeNil)
+			_, err = store.Size(ctx, "segments/1")
+			So(err, ShouldEqual, objstore.ErrNotFound)
+			So(b.DeleteObject(ctx), ShouldBeNil)
+		})
+	})
+}
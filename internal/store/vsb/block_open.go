@@ -36,6 +36,8 @@ import (
 var (
 	errCorrupted  = stderr.New("corrupted vsb")
 	errIncomplete = stderr.New("incomplete vsb")
+	// errObjectStoreRequired is returned when opening an offloaded block without object storage.
+	errObjectStoreRequired = stderr.New("object store is required by offloaded vsb")
 )
 
 func (b *vsBlock) Open(ctx context.Context) error {
@@ -66,6 +68,13 @@ func (b *vsBlock) init(ctx context.Context) error {
 		return err
 	}
 
+	if b.key != "" {
+		if b.store == nil {
+			return errObjectStoreRequired
+		}
+		b.obj = b.newOffloadedReader(b.f)
+	}
+
 	b.enc = codec.NewEncoder()
 	if dec, err := codec.NewDecoder(false, int(b.indexSize)); err == nil {
 		b.dec = dec
@@ -96,7 +105,7 @@ func (b *vsBlock) repairMeta() error {
 	// Scan entries.
 	indexes := make([]index.Index, 0)
 	// Note: use math.MaxInt64-off to avoid overflow.
-	r := stdio.NewSectionReader(b.f, off, math.MaxInt64-off)
+	r := stdio.NewSectionReader(b.reader(), off, math.MaxInt64-off)
 	if full {
 		n, entry, err = b.dec.UnmarshalReader(r)
 		if err != nil || ceschema.EntryType(entry) != ceschema.End {
@@ -173,7 +182,7 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 
 	// Scan entries.
 	off := b.dataOffset
-	r := stdio.NewSectionReader(b.f, off, b.fm.entryLength)
+	r := stdio.NewSectionReader(b.reader(), off, b.fm.entryLength)
 	for {
 		n, entry, err := b.dec.UnmarshalReader(r)
 		if err != nil {
@@ -187,8 +196,8 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 			return errCorrupted
 		}
 
-		idx := index.NewIndex(off, int32(n), index.WithEntry(entry))
-		indexes = append(indexes, idx)
+		indexes = appendIndex(indexes, off, n, entry)
+		off += int64(n)
 	}
 
 	if len(indexes)+len(tail) != num {
@@ -200,6 +209,16 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 	return nil
 }
 
+// appendIndex appends the index of entry, the gap of sequence numbers which is left by compaction is filled with
+// empty indexes.
+func appendIndex(indexes []index.Index, off int64, n int, entry block.Entry) []index.Index {
+	stime := ceschema.Stime(entry)
+	for seq := ceschema.SequenceNumber(entry); int64(len(indexes)) < seq; {
+		indexes = append(indexes, index.NewIndex(off, 0, index.WithStime(stime)))
+	}
+	return append(indexes, index.NewIndex(off, int32(n), index.WithEntry(entry)))
+}
+
 func (b *vsBlock) validate(ctx context.Context) error {
 	if len(b.indexes) < int(b.fm.entryNum) {
 		return errCorrupted
@@ -25,6 +25,7 @@ import (
 
 	// this project.
 	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/vsb/codec"
 )
 
 // Make sure block implements block.Reader.
@@ -36,6 +37,9 @@ func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry,
 	span.AddEvent("store.vsb.vsBlock.Read() Start")
 	defer span.AddEvent("store.vsb.vsBlock.Read() End")
 
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+
 	from, to, num, err := b.entryRange(int(seq), num)
 	if err != nil {
 		return nil, err
@@ -43,13 +47,16 @@ func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry,
 
 	length := int(to - from)
 	data := make([]byte, length)
-	if _, err = b.f.ReadAt(data, from); err != nil {
+	if _, err = b.reader().ReadAt(data, from); err != nil {
 		return nil, err
 	}
 
 	entries := make([]block.Entry, 0, num)
 	for so := 0; so < length; {
 		n, entry, _ := b.dec.Unmarshal(data[so:])
+		if err = codec.DecompressData(entry, int(b.capacity)); err != nil {
+			return nil, err
+		}
 		entries = append(entries, entry)
 		so += n
 	}
@@ -71,10 +78,19 @@ func (b *vsBlock) entryRange(start, num int) (int64, int64, int, error) {
 		return -1, -1, 0, block.ErrExceeded
 	}
 
-	end := start + num - 1
+	// Skip the entries removed by compaction, the last entry is never removed.
+	end, n := start, 0
+	for ; end < sz; end++ {
+		if b.indexes[end].Length() == 0 {
+			continue
+		}
+		if n++; n == num {
+			break
+		}
+	}
 	if end >= sz {
 		end = sz - 1
 	}
 
-	return b.indexes[start].StartOffset(), b.indexes[end].EndOffset(), end - start + 1, nil
+	return b.indexes[start].StartOffset(), b.indexes[end].EndOffset(), n, nil
 }
@@ -18,6 +18,7 @@ import (
 	// standard libraries.
 	"context"
 	"encoding/binary"
+	stdio "io"
 	"sync/atomic"
 
 	// this project.
@@ -26,8 +27,11 @@ import (
 	"github.com/vanus-labs/vanus/internal/store/vsb/index"
 )
 
-// Make sure block implements block.Snapshoter.
-var _ block.Snapshoter = (*vsBlock)(nil)
+// Make sure block implements block.Snapshoter and block.ChunkedSnapshoter.
+var (
+	_ block.Snapshoter        = (*vsBlock)(nil)
+	_ block.ChunkedSnapshoter = (*vsBlock)(nil)
+)
 
 func (b *vsBlock) makeSnapshot() (meta, []index.Index) {
 	b.mu.RLock()
@@ -48,6 +52,9 @@ func makeSnapshot(actx appendContext, indexes []index.Index) (meta, []index.Inde
 }
 
 func (b *vsBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+
 	m, _ := b.makeSnapshot()
 
 	if m.writeOffset == b.dataOffset {
@@ -59,7 +66,7 @@ func (b *vsBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
 	data := make([]byte, m.writeOffset-b.dataOffset+8)
 	binary.LittleEndian.PutUint64(data, uint64(b.dataOffset))
 
-	if _, err := b.f.ReadAt(data[8:], b.dataOffset); err != nil {
+	if _, err := b.reader().ReadAt(data[8:], b.dataOffset); err != nil {
 		return nil, err
 	}
 
@@ -95,8 +102,7 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 			break
 		}
 
-		idx := index.NewIndex(off, int32(n), index.WithEntry(entry))
-		b.indexes = append(b.indexes, idx)
+		b.indexes = appendIndex(b.indexes, off, n, entry)
 
 		off += int64(n)
 	}
@@ -106,3 +112,81 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 
 	return nil
 }
+
+func (b *vsBlock) SnapshotRange(ctx context.Context) (int64, int64) {
+	m, _ := b.makeSnapshot()
+	return b.dataOffset, m.writeOffset
+}
+
+func (b *vsBlock) ReadSnapshotAt(ctx context.Context, buf []byte, off int64) (int, error) {
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+	return b.reader().ReadAt(buf, off)
+}
+
+func (b *vsBlock) WriteSnapshotAt(ctx context.Context, data []byte, off int64) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	next := b.actx.offset
+	if b.snapOffset > next {
+		next = b.snapOffset
+	}
+
+	end := off + int64(len(data))
+	if off > next || end <= next {
+		return next, nil
+	}
+
+	if _, err := b.f.WriteAt(data[next-off:], next); err != nil {
+		return next, err
+	}
+	b.snapOffset = end
+
+	return end, nil
+}
+
+func (b *vsBlock) ApplySnapshotRange(ctx context.Context, end int64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cur := b.actx.offset
+	if end <= cur {
+		return nil
+	}
+	if b.snapOffset < end {
+		return block.ErrSnapshotIncomplete
+	}
+
+	// Build indexes from the written data.
+	indexes := b.indexes
+	archived := false
+	r := stdio.NewSectionReader(b.f, cur, end-cur)
+	for off := cur; off < end; {
+		n, entry, err := b.dec.UnmarshalReader(r)
+		if err != nil {
+			// The written data is corrupted, discard it so the snapshot is transferred again.
+			b.snapOffset = 0
+			return err
+		}
+
+		if ceschema.EntryType(entry) == ceschema.End {
+			archived = true
+			break
+		}
+
+		indexes = appendIndex(indexes, off, n, entry)
+
+		off += int64(n)
+	}
+
+	b.indexes = indexes
+	if archived {
+		atomic.StoreUint32(&b.actx.archived, 1)
+	}
+	b.actx.seq = int64(len(b.indexes))
+	b.actx.offset = end
+	b.snapOffset = 0
+
+	return nil
+}
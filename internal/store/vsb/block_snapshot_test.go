@@ -14,6 +14,23 @@
 
 package vsb
 
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
+	"github.com/vanus-labs/vanus/internal/store/block"
+	"github.com/vanus-labs/vanus/internal/store/io/engine/psync"
+	"github.com/vanus-labs/vanus/internal/store/io/stream"
+)
+
 // import (
 // 	// standard libraries.
 // 	"context"
@@ -87,3 +104,79 @@ package vsb
 // 		So(err, ShouldBeNil)
 // 	})
 // }
+
+func TestVSBlock_ChunkedSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("transfer the snapshot of vsb in chunks", t, func() {
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		e := &engine{dir: dir, s: stream.NewScheduler(psync.New())}
+		defer e.Close()
+
+		r, err := e.Create(ctx, vanus.NewTestID(), 1<<20)
+		So(err, ShouldBeNil)
+		leader, _ := r.(*vsBlock)
+		r, err = e.Create(ctx, vanus.NewTestID(), 1<<20)
+		So(err, ShouldBeNil)
+		follower, _ := r.(*vsBlock)
+
+		entries := make([]block.Entry, 0, 100)
+		for i := 0; i < 100; i++ {
+			entries = append(entries, makeKeyedEntry("", strings.Repeat("x", i)))
+		}
+		actx := leader.NewAppendContext(nil)
+		_, frag, _, err := leader.PrepareAppend(ctx, actx, entries...)
+		So(err, ShouldBeNil)
+		ch := make(chan struct{}, 1)
+		leader.CommitAppend(ctx, frag, func() { ch <- struct{}{} })
+		<-ch
+
+		start, end := leader.SnapshotRange(ctx)
+		So(start, ShouldEqual, leader.dataOffset)
+		So(end, ShouldEqual, actx.WriteOffset())
+
+		transfer := func(from, to int64, chunkSize int) {
+			buf := make([]byte, chunkSize)
+			for off := from; off < to; {
+				n := int64(chunkSize)
+				if off+n > to {
+					n = to - off
+				}
+				_, err2 := leader.ReadSnapshotAt(ctx, buf[:n], off)
+				So(err2, ShouldBeNil)
+				next, err2 := follower.WriteSnapshotAt(ctx, buf[:n], off)
+				So(err2, ShouldBeNil)
+				So(next, ShouldEqual, off+n)
+				off = next
+			}
+		}
+
+		// The offset expected by the follower is returned if the data isn't expected.
+		next, err := follower.WriteSnapshotAt(ctx, []byte("vanus"), start+100)
+		So(err, ShouldBeNil)
+		So(next, ShouldEqual, start)
+
+		transfer(start, start+1000, 300)
+		So(follower.ApplySnapshotRange(ctx, end), ShouldEqual, block.ErrSnapshotIncomplete)
+		So(follower.Status().EntryNum, ShouldEqual, 0)
+
+		// Resume from the offset of written data.
+		next, err = follower.WriteSnapshotAt(ctx, nil, start)
+		So(err, ShouldBeNil)
+		So(next, ShouldEqual, start+1000)
+		transfer(next, end, 300)
+
+		So(follower.ApplySnapshotRange(ctx, end), ShouldBeNil)
+		So(follower.Status().EntryNum, ShouldEqual, 100)
+		So(readSeqs(ctx, follower, 95, 10), ShouldResemble, []int64{95, 96, 97, 98, 99})
+		So(follower.ApplySnapshotRange(ctx, end), ShouldBeNil)
+
+		// The data has been applied.
+		next, err = follower.WriteSnapshotAt(ctx, nil, start)
+		So(err, ShouldBeNil)
+		So(next, ShouldEqual, end)
+	})
+}
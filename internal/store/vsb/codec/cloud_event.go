@@ -148,8 +148,10 @@ func (cb *marshalOptAttrCallback) OnString(ordinal int, val string) {
 func (cb *marshalOptAttrCallback) OnUint16(ordinal int, val uint16) {
 	cb.bitmap |= 1 << ordinal
 
-	// TODO(james.yin):
-	panic("not supported type")
+	idx := doValueIndex(cb.bitmap, 1<<ordinal)
+	fo := valueOffset(idx)
+	field := cb.buf[fo : fo+8]
+	binary.LittleEndian.PutUint64(field, uint64(val))
 }
 
 func (cb *marshalOptAttrCallback) OnUint64(ordinal int, val uint64) {
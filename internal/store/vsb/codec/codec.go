@@ -38,6 +38,7 @@ var (
 	ErrCorruptedPacket  = errors.New("vsb.codec: corrupted packet")
 	ErrCorruptedRecord  = errors.New("vsb.codec: corrupted record")
 	ErrUnknownRecord    = errors.New("vsb.codec: unknown record")
+	ErrUnknownCompress  = errors.New("vsb.codec: unknown compression")
 )
 
 type EntryEncoder interface {
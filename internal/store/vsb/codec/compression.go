@@ -0,0 +1,124 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"encoding/binary"
+	"sync"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/block"
+)
+
+const (
+	// lz4SizeSize is the size of uncompressed length, which prefixes the lz4 block.
+	lz4SizeSize = 4
+	// lz4MaxRatio is the max compression ratio of lz4.
+	lz4MaxRatio = 255
+)
+
+var (
+	lz4Pool = sync.Pool{
+		New: func() interface{} {
+			return &lz4.Compressor{}
+		},
+	}
+
+	zstdOnce sync.Once
+	zstdEnc  *zstd.Encoder
+	zstdDec  *zstd.Decoder
+)
+
+func initZstd() {
+	// The options are valid, so errors are impossible.
+	zstdEnc, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	zstdDec, _ = zstd.NewReader(nil)
+}
+
+// Compress compresses data by algorithm c. The data is always compressed even if it's incompressible, so that all
+// entries appended after the compression is set carry the algorithm.
+func Compress(c block.Compression, data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	switch c {
+	case block.CompressionLZ4:
+		return compressLZ4(data), nil
+	case block.CompressionZstd:
+		zstdOnce.Do(initZstd)
+		return zstdEnc.EncodeAll(data, make([]byte, 0, len(data))), nil
+	}
+	return nil, ErrUnknownCompress
+}
+
+func compressLZ4(data []byte) []byte {
+	out := make([]byte, lz4SizeSize+lz4.CompressBlockBound(len(data)))
+	binary.LittleEndian.PutUint32(out, uint32(len(data)))
+
+	lc, _ := lz4Pool.Get().(*lz4.Compressor)
+	defer lz4Pool.Put(lc)
+
+	// The buffer is enough, so it always succeeds.
+	n, _ := lc.CompressBlock(data, out[lz4SizeSize:])
+	return out[:lz4SizeSize+n]
+}
+
+// Decompress decompresses data which is compressed by algorithm c. The uncompressed data can't be larger than
+// maxSize, which is usually the capacity of block, it isn't checked if maxSize is zero.
+func Decompress(c block.Compression, data []byte, maxSize int) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	switch c {
+	case block.CompressionNone:
+		return data, nil
+	case block.CompressionLZ4:
+		if len(data) < lz4SizeSize {
+			return nil, ErrCorruptedRecord
+		}
+		// The size is read from data, so check it before allocating.
+		size := int(binary.LittleEndian.Uint32(data))
+		if size > (len(data)-lz4SizeSize)*lz4MaxRatio || (maxSize > 0 && size > maxSize) {
+			return nil, ErrCorruptedRecord
+		}
+		out := make([]byte, size)
+		n, err := lz4.UncompressBlock(data[lz4SizeSize:], out)
+		if err != nil || n != len(out) {
+			return nil, ErrCorruptedRecord
+		}
+		return out, nil
+	case block.CompressionZstd:
+		if maxSize > 0 {
+			var h zstd.Header
+			if err := h.Decode(data); err != nil || (h.HasFCS && h.FrameContentSize > uint64(maxSize)) {
+				return nil, ErrCorruptedRecord
+			}
+		}
+		zstdOnce.Do(initZstd)
+		out, err := zstdDec.DecodeAll(data, nil)
+		if err != nil || (maxSize > 0 && len(out) > maxSize) {
+			return nil, ErrCorruptedRecord
+		}
+		return out, nil
+	}
+	return nil, ErrUnknownCompress
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/vanus-labs/vanus/internal/store/block"
+)
+
+func TestCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("vanus"), 100)
+
+	for _, c := range []block.Compression{block.CompressionLZ4, block.CompressionZstd} {
+		Convey("compress data by "+c.String(), t, func() {
+			out, err := Compress(c, data)
+			So(err, ShouldBeNil)
+			So(len(out), ShouldBeLessThan, len(data))
+			in, err := Decompress(c, out, 0)
+			So(err, ShouldBeNil)
+			So(in, ShouldResemble, data)
+
+			// The incompressible data is compressed too.
+			out, err = Compress(c, []byte("x"))
+			So(err, ShouldBeNil)
+			in, err = Decompress(c, out, 0)
+			So(err, ShouldBeNil)
+			So(in, ShouldResemble, []byte("x"))
+
+			out, err = Compress(c, nil)
+			So(err, ShouldBeNil)
+			So(out, ShouldBeEmpty)
+			in, err = Decompress(c, out, 0)
+			So(err, ShouldBeNil)
+			So(in, ShouldBeEmpty)
+
+			_, err = Decompress(c, []byte{0xff, 0xff, 0xff}, 0)
+			So(err, ShouldEqual, ErrCorruptedRecord)
+
+			// The uncompressed data is larger than block.
+			out, err = Compress(c, data)
+			So(err, ShouldBeNil)
+			_, err = Decompress(c, out, len(data)-1)
+			So(err, ShouldEqual, ErrCorruptedRecord)
+			in, err = Decompress(c, out, len(data))
+			So(err, ShouldBeNil)
+			So(in, ShouldResemble, data)
+		})
+	}
+
+	Convey("the corrupted size of lz4 block", t, func() {
+		out, err := Compress(block.CompressionLZ4, []byte("vanus"))
+		So(err, ShouldBeNil)
+		binary.LittleEndian.PutUint32(out, 0xffffffff)
+		_, err = Decompress(block.CompressionLZ4, out, 0)
+		So(err, ShouldEqual, ErrCorruptedRecord)
+	})
+
+	Convey("unknown compression", t, func() {
+		_, err := Compress(block.CompressionNone, data)
+		So(err, ShouldEqual, ErrUnknownCompress)
+
+		in, err := Decompress(block.CompressionNone, data, 0)
+		So(err, ShouldBeNil)
+		So(in, ShouldResemble, data)
+
+		_, err = Decompress(block.Compression(100), data, 0)
+		So(err, ShouldEqual, ErrUnknownCompress)
+	})
+}
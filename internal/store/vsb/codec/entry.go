@@ -17,11 +17,16 @@ package codec
 import (
 	// standard libraries.
 	"bytes"
+	"context"
 	"encoding/binary"
 	"math/bits"
 	"sort"
 	"time"
 
+	// first-party libraries.
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/observability/metrics"
+
 	// this project.
 	"github.com/vanus-labs/vanus/internal/store/block"
 	ceschema "github.com/vanus-labs/vanus/internal/store/schema/ce"
@@ -51,6 +56,9 @@ const (
 type entry struct {
 	t    uint16
 	data []byte
+	// payload is the uncompressed data, it's set by DecompressData.
+	payload      []byte
+	decompressed bool
 }
 
 // Make sure entry implements block.Entry.
@@ -66,16 +74,20 @@ func (e *entry) GetBytes(ordinal int) []byte {
 		return nil
 	}
 	vo := valueOffset(idx)
-	return e.deref(vo)
+	data := e.deref(vo)
+	if ordinal == ceschema.DataOrdinal {
+		if e.decompressed {
+			return e.payload
+		}
+		// The corruption has been logged, and it's returned by DecompressData on reading.
+		payload, _ := e.decompress(data, 0)
+		return payload
+	}
+	return data
 }
 
 func (e *entry) GetString(ordinal int) string {
-	idx := e.valueIndex(ordinal)
-	if idx < 0 {
-		return ""
-	}
-	vo := valueOffset(idx)
-	return string(e.deref(vo))
+	return string(e.GetBytes(ordinal))
 }
 
 func (e *entry) GetUint16(ordinal int) uint16 {
@@ -168,6 +180,44 @@ func (e *entry) extVecBase() int {
 	return valueOffset(e.valueCount())
 }
 
+// decompress returns the uncompressed data, the corruption is logged and counted.
+func (e *entry) decompress(data []byte, maxSize int) ([]byte, error) {
+	c := block.Compression(e.GetUint16(ceschema.CompressionOrdinal))
+	if c == block.CompressionNone {
+		return data, nil
+	}
+	out, err := Decompress(c, data, maxSize)
+	if err != nil {
+		metrics.DecompressionFailureCounterVec.WithLabelValues(c.String()).Inc()
+		log.Error(context.Background(), "decompress data of entry failed", map[string]interface{}{
+			"compression": c.String(),
+			"size":        len(data),
+			log.KeyError:  err,
+		})
+		return nil, err
+	}
+	return out, nil
+}
+
+// DecompressData decompresses the data of entry in advance, so that the corrupted data is reported as an error
+// instead of empty data. The uncompressed data can't be larger than maxSize.
+func DecompressData(e block.Entry, maxSize int) error {
+	ent, ok := e.(*entry)
+	if !ok || ent.t != ceschema.CloudEvent || ent.decompressed {
+		return nil
+	}
+	idx := ent.valueIndex(ceschema.DataOrdinal)
+	if idx < 0 {
+		return nil
+	}
+	payload, err := ent.decompress(ent.deref(valueOffset(idx)), maxSize)
+	if err != nil {
+		return err
+	}
+	ent.payload, ent.decompressed = payload, true
+	return nil
+}
+
 func (e *entry) deref(base int) []byte {
 	off, sz := offsetAndLength(e.data[base:])
 	return e.data[off : off+sz]
@@ -23,6 +23,7 @@ import (
 	ioengine "github.com/vanus-labs/vanus/internal/store/io/engine"
 	"github.com/vanus-labs/vanus/internal/store/io/engine/psync"
 	"github.com/vanus-labs/vanus/internal/store/io/stream"
+	"github.com/vanus-labs/vanus/internal/store/objstore"
 )
 
 type config struct {
@@ -31,6 +32,7 @@ type config struct {
 	flushDelayTime   time.Duration // default: 3 * time.Millisecond
 	callbackParallel int           // default: 1
 	lis              block.ArchivedListener
+	store            objstore.Store
 }
 
 func (cfg *config) streamSchedulerOptions() (opts []stream.Option) {
@@ -97,3 +99,10 @@ func WithArchivedListener(lis block.ArchivedListener) Option {
 		cfg.lis = lis
 	}
 }
+
+// WithObjectStore sets the object storage which archived blocks are offloaded to.
+func WithObjectStore(store objstore.Store) Option {
+	return func(cfg *config) {
+		cfg.store = store
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/vanus-labs/vanus/internal/store/block"
 	"github.com/vanus-labs/vanus/internal/store/block/raw"
 	"github.com/vanus-labs/vanus/internal/store/io/stream"
+	"github.com/vanus-labs/vanus/internal/store/objstore"
 )
 
 const (
@@ -29,9 +30,10 @@ const (
 )
 
 type engine struct {
-	dir string
-	s   stream.Scheduler
-	lis block.ArchivedListener
+	dir   string
+	s     stream.Scheduler
+	lis   block.ArchivedListener
+	store objstore.Store
 }
 
 // Make sure engine implements raw.Engine.
@@ -56,8 +58,9 @@ func initialize(dir string, cfg config) error {
 	s := stream.NewScheduler(cfg.engine, cfg.streamSchedulerOptions()...)
 
 	return raw.RegisterEngine(raw.VSB, &engine{
-		dir: dir,
-		s:   s,
-		lis: cfg.lis,
+		dir:   dir,
+		s:     s,
+		lis:   cfg.lis,
+		store: cfg.store,
 	})
 }
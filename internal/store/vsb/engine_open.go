@@ -58,10 +58,11 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		actx: appendContext{
 			offset: headerBlockSize,
 		},
-		enc: codec.NewEncoder(),
-		dec: dec,
-		lis: e.lis,
-		f:   f,
+		enc:   codec.NewEncoder(),
+		dec:   dec,
+		lis:   e.lis,
+		f:     f,
+		store: e.store,
 	}
 
 	if err := b.persistHeader(ctx, b.fm); err != nil {
@@ -93,9 +94,10 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 	path := e.resolvePath(id)
 
 	b := &vsBlock{
-		id:   id,
-		path: path,
-		lis:  e.lis,
+		id:    id,
+		path:  path,
+		lis:   e.lis,
+		store: e.store,
 	}
 
 	if err := b.Open(ctx); err != nil {
@@ -18,6 +18,7 @@ import (
 	// this project.
 	"github.com/vanus-labs/vanus/internal/store/block"
 	ceschema "github.com/vanus-labs/vanus/internal/store/schema/ce"
+	"github.com/vanus-labs/vanus/internal/store/vsb/codec"
 )
 
 const (
@@ -29,14 +30,27 @@ type entryExtWrapper struct {
 	t     uint16
 	seq   int64
 	stime int64
+	// data is the compressed data, it's only used if compression isn't none.
+	data        []byte
+	compression block.Compression
 }
 
 // Make sure entryWrapper implements block.Entry.
 var _ block.EntryExt = (*entryExtWrapper)(nil)
 
+func (w *entryExtWrapper) GetBytes(ordinal int) []byte {
+	if ordinal == ceschema.DataOrdinal && w.compressed() {
+		return w.data
+	}
+	return w.EntryExtWrapper.GetBytes(ordinal)
+}
+
 func (w *entryExtWrapper) GetUint16(ordinal int) uint16 {
-	if ordinal == ceschema.EntryTypeOrdinal {
+	switch ordinal {
+	case ceschema.EntryTypeOrdinal:
 		return w.t
+	case ceschema.CompressionOrdinal:
+		return uint16(w.compression)
 	}
 	return w.EntryExtWrapper.GetUint16(ordinal)
 }
@@ -54,13 +68,61 @@ func (w *entryExtWrapper) GetInt64(ordinal int) int64 {
 func (w *entryExtWrapper) RangeOptionalAttributes(cb block.OptionalAttributeCallback) {
 	cb.OnInt64(ceschema.SequenceNumberOrdinal, w.seq)
 	cb.OnInt64(ceschema.StimeOrdinal, w.stime)
-	w.EntryExtWrapper.RangeOptionalAttributes(cb)
+	if !w.compressed() {
+		w.EntryExtWrapper.RangeOptionalAttributes(cb)
+		return
+	}
+	w.EntryExtWrapper.RangeOptionalAttributes(&compressedDataCallback{
+		OptionalAttributeCallback: cb,
+		data:                      w.data,
+	})
+	cb.OnUint16(ceschema.CompressionOrdinal, uint16(w.compression))
 }
 
 func (w *entryExtWrapper) OptionalAttributeCount() int {
+	if w.compressed() {
+		return addedOptCount + 1 + w.EntryExtWrapper.OptionalAttributeCount()
+	}
 	return addedOptCount + w.EntryExtWrapper.OptionalAttributeCount()
 }
 
+func (w *entryExtWrapper) compressed() bool {
+	return w.compression != block.CompressionNone
+}
+
+// compress compresses the data of entry by algorithm c, the sizes of data before and after compression are returned.
+func (w *entryExtWrapper) compress(c block.Compression) (int, int) {
+	data := w.EntryExtWrapper.GetBytes(ceschema.DataOrdinal)
+	compressed, err := codec.Compress(c, data)
+	if err != nil {
+		return len(data), len(data)
+	}
+	w.data = compressed
+	w.compression = c
+	return len(data), len(compressed)
+}
+
+// compressedDataCallback replaces the data with the compressed one.
+type compressedDataCallback struct {
+	block.OptionalAttributeCallback
+	data []byte
+}
+
+func (cb *compressedDataCallback) OnBytes(ordinal int, val []byte) {
+	if ordinal == ceschema.DataOrdinal {
+		val = cb.data
+	}
+	cb.OptionalAttributeCallback.OnBytes(ordinal, val)
+}
+
+func (cb *compressedDataCallback) OnString(ordinal int, val string) {
+	if ordinal == ceschema.DataOrdinal {
+		cb.OptionalAttributeCallback.OnBytes(ordinal, cb.data)
+		return
+	}
+	cb.OptionalAttributeCallback.OnString(ordinal, val)
+}
+
 func wrapEntry(e block.Entry, t uint16, seq int64, stime int64) block.Entry {
 	if ext, ok := e.(block.EntryExt); ok {
 		return &entryExtWrapper{
@@ -128,6 +128,7 @@ var (
 		0x90, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // entry length
 		0x02, 0x00, 0x00, 0x00, // entry num
 		0x28, 0x00, // index offset
+		0x00, 0x00, // compression
 	}
 	EmptyHeaderData = []byte{
 		0x76, 0x73, 0x62, 0x00, // magic
@@ -142,5 +143,6 @@ var (
 		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // entry length
 		0x00, 0x00, 0x00, 0x00, // entry num
 		0x00, 0x00, // index offset
+		0x00, 0x00, // compression
 	}
 )
@@ -86,3 +86,7 @@ func (c *gcloudFunctions) Send(ctx context.Context, events ...*ce.Event) Result
 	}
 	return Success
 }
+
+func (c *gcloudFunctions) Close() error {
+	return nil
+}
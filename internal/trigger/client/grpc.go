@@ -16,70 +16,189 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
+	nethttp "net/http"
+	"strings"
 	"sync"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/pkg/errors"
 	stdGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
 	"github.com/vanus-labs/vanus/proto/pkg/codec"
 )
 
+const (
+	grpcSchema       = "grpc://"
+	grpcSecureSchema = "grpcs://"
+
+	grpcKeepaliveTime    = 30 * time.Second
+	grpcKeepaliveTimeout = 10 * time.Second
+)
+
 type grpc struct {
-	client cloudevents.CloudEventsClient
-	url    string
-	lock   sync.Mutex
+	client   cloudevents.CloudEventsClient
+	conn     *stdGrpc.ClientConn
+	url      string
+	secure   bool
+	options  *options
+	metadata metadata.MD
+	lock     sync.Mutex
 }
 
-func NewGRPCClient(url string) EventClient {
-	return &grpc{
-		url: url,
+// NewGRPCClient returns a client of the sink, the connection is secured by TLS if url starts with grpcs://
+// or the client certificate or CA is set.
+func NewGRPCClient(url string, opts ...Option) EventClient {
+	c := &grpc{
+		url:     url,
+		options: &options{},
+	}
+	for _, opt := range opts {
+		opt(c.options)
 	}
+	switch {
+	case strings.HasPrefix(url, grpcSecureSchema):
+		c.url = strings.TrimPrefix(url, grpcSecureSchema)
+		c.secure = true
+	case strings.HasPrefix(url, grpcSchema):
+		c.url = strings.TrimPrefix(url, grpcSchema)
+	}
+	md := metadata.MD{}
+	for k, v := range c.options.headers {
+		md.Set(k, v)
+	}
+	if authorization := c.options.authorization(); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	c.metadata = md
+	return c
 }
 
-func (c *grpc) init() error {
+// getClient returns the client of the connection, the connection is recreated if it was closed.
+func (c *grpc) getClient() (cloudevents.CloudEventsClient, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	if c.client != nil {
-		return nil
+	if c.conn != nil && c.conn.GetState() != connectivity.Shutdown {
+		return c.client, nil
 	}
-	opts := []stdGrpc.DialOption{
-		stdGrpc.WithBlock(),
-		stdGrpc.WithTransportCredentials(insecure.NewCredentials()),
+	tlsConfig, err := c.options.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil && c.secure {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
 	}
-	//nolint:gomnd //wrong check
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	conn, err := stdGrpc.DialContext(ctx, c.url, opts...)
+	transportCredentials := insecure.NewCredentials()
+	if tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	// the connection isn't blocked on dialing, it reconnects in background when the sink is unavailable.
+	conn, err := stdGrpc.Dial(c.url,
+		stdGrpc.WithTransportCredentials(transportCredentials),
+		stdGrpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                grpcKeepaliveTime,
+			Timeout:             grpcKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	c.conn = conn
 	c.client = cloudevents.NewCloudEventsClient(conn)
-	return nil
+	return c.client, nil
 }
 
 func (c *grpc) Send(ctx context.Context, events ...*ce.Event) Result {
-	if c.client == nil {
-		err := c.init()
-		if err != nil {
-			return newUnknownErr(err)
-		}
+	client, err := c.getClient()
+	if err != nil {
+		return newUnknownErr(err)
 	}
 	es := make([]*cloudevents.CloudEvent, len(events))
 	for idx := range events {
 		es[idx], _ = codec.ToProto(events[idx])
 	}
-	_, err := c.client.Send(ctx, &cloudevents.BatchEvent{
+	if len(c.metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.metadata)
+	}
+	_, err = client.Send(ctx, &cloudevents.BatchEvent{
 		Events: &cloudevents.CloudEventBatch{Events: es},
 	})
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return DeliveryTimeout
 		}
-		return newUnknownErr(err)
+		return c.convertError(err)
 	}
 	return Success
 }
+
+func (c *grpc) convertError(err error) Result {
+	s, ok := status.FromError(err)
+	if !ok {
+		return newUnknownErr(err)
+	}
+	switch s.Code() {
+	case codes.DeadlineExceeded:
+		return DeliveryTimeout
+	case codes.Unavailable:
+		// try to reconnect immediately rather than waiting for the backoff.
+		c.lock.Lock()
+		if c.conn != nil {
+			c.conn.ResetConnectBackoff()
+		}
+		c.lock.Unlock()
+	}
+	return Result{
+		StatusCode: grpcCodeToHTTPStatus(s.Code()),
+		Err:        err,
+	}
+}
+
+func (c *grpc) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	c.client = nil
+	return err
+}
+
+// grpcCodeToHTTPStatus maps the status code of gRPC to HTTP, so the retry and dead letter decision
+// of gRPC sink is the same as HTTP sink.
+func grpcCodeToHTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return nethttp.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return nethttp.StatusBadRequest
+	case codes.Unauthenticated:
+		return nethttp.StatusUnauthorized
+	case codes.PermissionDenied:
+		return nethttp.StatusForbidden
+	case codes.NotFound:
+		return nethttp.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return nethttp.StatusConflict
+	case codes.ResourceExhausted:
+		return nethttp.StatusTooManyRequests
+	case codes.Unimplemented:
+		return nethttp.StatusNotImplemented
+	case codes.Unavailable:
+		return nethttp.StatusServiceUnavailable
+	case codes.Internal, codes.DataLoss:
+		return nethttp.StatusInternalServerError
+	}
+	return errUnknown
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	stdGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
+)
+
+type testGRPCReceiver struct {
+	cloudevents.UnimplementedCloudEventsServer
+	err error
+	md  metadata.MD
+}
+
+func (r *testGRPCReceiver) Send(ctx context.Context, _ *cloudevents.BatchEvent) (*emptypb.Empty, error) {
+	r.md, _ = metadata.FromIncomingContext(ctx)
+	return &emptypb.Empty{}, r.err
+}
+
+func TestGRPCClient_Send(t *testing.T) {
+	Convey("test grpc client send", t, func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		receiver := &testGRPCReceiver{}
+		server := stdGrpc.NewServer()
+		cloudevents.RegisterCloudEventsServer(server, receiver)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		defer server.Stop()
+
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		c := NewGRPCClient(grpcSchema+listener.Addr().String(),
+			WithHeaders(map[string]string{"X-Key": "value"}), WithBearerToken("token"))
+		defer func() {
+			_ = c.Close()
+		}()
+
+		Convey("success with metadata", func() {
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+			So(receiver.md.Get("x-key"), ShouldResemble, []string{"value"})
+			So(receiver.md.Get("authorization"), ShouldResemble, []string{"Bearer token"})
+		})
+		Convey("status code", func() {
+			receiver.err = status.Error(codes.ResourceExhausted, "slow down")
+			So(c.Send(context.Background(), &e).StatusCode, ShouldEqual, nethttp.StatusTooManyRequests)
+			receiver.err = status.Error(codes.InvalidArgument, "invalid")
+			So(c.Send(context.Background(), &e).StatusCode, ShouldEqual, nethttp.StatusBadRequest)
+		})
+		Convey("reconnect after closed", func() {
+			So(c.Close(), ShouldBeNil)
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+		})
+	})
+}
+
+func TestGRPCCodeToHTTPStatus(t *testing.T) {
+	Convey("test grpc code to http status", t, func() {
+		So(grpcCodeToHTTPStatus(codes.Unavailable), ShouldEqual, nethttp.StatusServiceUnavailable)
+		So(grpcCodeToHTTPStatus(codes.ResourceExhausted), ShouldEqual, nethttp.StatusTooManyRequests)
+		So(grpcCodeToHTTPStatus(codes.InvalidArgument), ShouldEqual, nethttp.StatusBadRequest)
+		So(grpcCodeToHTTPStatus(codes.Unknown), ShouldEqual, errUnknown)
+	})
+}
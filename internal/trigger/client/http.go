@@ -17,32 +17,66 @@ package client
 import (
 	"context"
 	"errors"
+	nethttp "net/http"
+	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 )
 
 type http struct {
-	client ce.Client
+	client    ce.Client
+	transport nethttp.RoundTripper
+	// err is the error of building client, such as invalid certificate, every event fails with it.
+	err error
 }
 
-func NewHTTPClient(url string) EventClient {
-	c, _ := ce.NewClientHTTP(ce.WithTarget(url))
+func NewHTTPClient(url string, opts ...Option) EventClient {
+	options := &options{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	transport, err := options.transport()
+	if err != nil {
+		return &http{err: err}
+	}
+	// use an exclusive client, otherwise the protocol changes the transport of the default client.
+	c, err := ce.NewClientHTTP(ce.WithTarget(url),
+		cehttp.WithClient(nethttp.Client{}),
+		cehttp.WithRoundTripper(transport),
+		cehttp.WithRoundTripperDecorator(func(rt nethttp.RoundTripper) nethttp.RoundTripper {
+			return options.decorate(&retryAfterRoundTripper{next: rt})
+		}))
+	if err != nil {
+		return &http{err: err}
+	}
 	return &http{
-		client: c,
+		client:    c,
+		transport: transport,
 	}
 }
 
+func (c *http) Close() error {
+	if t, ok := c.transport.(*nethttp.Transport); ok {
+		t.CloseIdleConnections()
+	}
+	return nil
+}
+
 func (c *http) Send(ctx context.Context, events ...*ce.Event) Result {
+	if c.err != nil {
+		return newUnknownErr(c.err)
+	}
 	event := events[0]
-	res := c.client.Send(ctx, *event)
+	var retryAfter time.Duration
+	res := c.client.Send(context.WithValue(ctx, retryAfterKey{}, &retryAfter), *event)
 	if ce.IsACK(res) {
 		return Success
 	}
 	if errors.Is(res, context.DeadlineExceeded) {
 		return DeliveryTimeout
 	}
-	r := Result{Err: res}
+	r := Result{Err: res, RetryAfter: retryAfter}
 	var httpResult *cehttp.Result
 	if ce.ResultAs(res, &httpResult) {
 		r.StatusCode = httpResult.StatusCode
@@ -52,3 +86,22 @@ func (c *http) Send(ctx context.Context, events ...*ce.Event) Result {
 
 	return r
 }
+
+type retryAfterKey struct{}
+
+// retryAfterRoundTripper records the Retry-After header of response into the request context, because
+// the CloudEvents client doesn't expose the headers of response.
+type retryAfterRoundTripper struct {
+	next nethttp.RoundTripper
+}
+
+func (rt *retryAfterRoundTripper) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	if p, ok := req.Context().Value(retryAfterKey{}).(*time.Duration); ok {
+		*p = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	}
+	return resp, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderSignatureTimestamp is the unix seconds when the request is signed.
+	HeaderSignatureTimestamp = "X-Vanus-Timestamp"
+	// HeaderSignature is "sha256=" followed by the hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+	HeaderSignature = "X-Vanus-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+func (o *options) transport() (nethttp.RoundTripper, error) {
+	transport, _ := nethttp.DefaultTransport.(*nethttp.Transport)
+	transport = transport.Clone()
+	tlsConfig, err := o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+func (o *options) decorate(rt nethttp.RoundTripper) nethttp.RoundTripper {
+	if len(o.headers) == 0 && o.authorization() == "" && o.hmacSecret == "" {
+		return rt
+	}
+	return &authRoundTripper{next: rt, options: o}
+}
+
+type authRoundTripper struct {
+	next    nethttp.RoundTripper
+	options *options
+}
+
+func (rt *authRoundTripper) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	// RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	for k, v := range rt.options.headers {
+		req.Header.Set(k, v)
+	}
+	if authorization := rt.options.authorization(); authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	if rt.options.hmacSecret != "" {
+		var body []byte
+		if req.Body != nil {
+			var err error
+			body, err = io.ReadAll(req.Body)
+			_ = req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderSignatureTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, signaturePrefix+sign(rt.options.hmacSecret, timestamp, body))
+	}
+	return rt.next.RoundTrip(req)
+}
+
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
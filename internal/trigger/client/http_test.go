@@ -0,0 +1,149 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	Convey("test parse retry after", t, func() {
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		So(parseRetryAfter("", now), ShouldEqual, 0)
+		So(parseRetryAfter("abc", now), ShouldEqual, 0)
+		So(parseRetryAfter("-1", now), ShouldEqual, 0)
+		So(parseRetryAfter("120", now), ShouldEqual, 2*time.Minute)
+		So(parseRetryAfter(now.Add(30*time.Second).Format(nethttp.TimeFormat), now), ShouldEqual, 30*time.Second)
+		So(parseRetryAfter(now.Add(-time.Second).Format(nethttp.TimeFormat), now), ShouldEqual, 0)
+	})
+}
+
+func TestHTTPClient_Send(t *testing.T) {
+	Convey("test http client send", t, func() {
+		var code int
+		var retryAfter string
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(code)
+		}))
+		defer server.Close()
+		c := NewHTTPClient(server.URL)
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		Convey("success", func() {
+			code = nethttp.StatusOK
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+		})
+		Convey("too many requests with retry after", func() {
+			code = nethttp.StatusTooManyRequests
+			retryAfter = "5"
+			r := c.Send(context.Background(), &e)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusTooManyRequests)
+			So(r.IsBackpressure(), ShouldBeTrue)
+			So(r.RetryAfter, ShouldEqual, 5*time.Second)
+		})
+		Convey("server error without retry after", func() {
+			code = nethttp.StatusInternalServerError
+			r := c.Send(context.Background(), &e)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusInternalServerError)
+			So(r.IsBackpressure(), ShouldBeFalse)
+			So(r.RetryAfter, ShouldEqual, 0)
+		})
+	})
+}
+
+func TestHTTPClient_Auth(t *testing.T) {
+	Convey("test http client auth", t, func() {
+		var req *nethttp.Request
+		var body []byte
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			req = r
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		Convey("basic", func() {
+			c := NewHTTPClient(server.URL, WithBasicAuth("user", "pass"))
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+			username, password, ok := req.BasicAuth()
+			So(ok, ShouldBeTrue)
+			So(username, ShouldEqual, "user")
+			So(password, ShouldEqual, "pass")
+		})
+		Convey("bearer", func() {
+			c := NewHTTPClient(server.URL, WithBearerToken("token"))
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+			So(req.Header.Get("Authorization"), ShouldEqual, "Bearer token")
+		})
+		Convey("hmac", func() {
+			_ = e.SetData(ce.ApplicationJSON, map[string]string{"key": "value"})
+			c := NewHTTPClient(server.URL, WithHMACSigning("secret"))
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+			timestamp := req.Header.Get(HeaderSignatureTimestamp)
+			ts, err := strconv.ParseInt(timestamp, 10, 64)
+			So(err, ShouldBeNil)
+			So(time.Since(time.Unix(ts, 0)), ShouldBeLessThan, time.Minute)
+			So(req.Header.Get(HeaderSignature), ShouldEqual, signaturePrefix+sign("secret", timestamp, body))
+			So(len(body), ShouldBeGreaterThan, 0)
+		})
+	})
+}
+
+func TestHTTPClient_TLS(t *testing.T) {
+	Convey("test http client tls", t, func() {
+		server := httptest.NewTLSServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {}))
+		defer server.Close()
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		e.SetType("type")
+		Convey("unknown authority", func() {
+			c := NewHTTPClient(server.URL)
+			So(c.Send(context.Background(), &e).StatusCode, ShouldEqual, errUnknown)
+		})
+		Convey("custom ca", func() {
+			ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			c := NewHTTPClient(server.URL, WithCACert(string(ca)))
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+		})
+		Convey("invalid ca", func() {
+			c := NewHTTPClient(server.URL, WithCACert("invalid"))
+			r := c.Send(context.Background(), &e)
+			So(r.StatusCode, ShouldEqual, errUnknown)
+			So(r.Err, ShouldEqual, errInvalidCACert)
+		})
+		Convey("invalid client cert", func() {
+			c := NewHTTPClient(server.URL, WithClientCert("cert", "key"))
+			So(c.Send(context.Background(), &e).StatusCode, ShouldEqual, errUnknown)
+		})
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"fmt"
 	nethttp "net/http"
+	"strconv"
+	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 )
@@ -30,11 +32,14 @@ type Sender interface {
 
 type EventClient interface {
 	Sender
+	Close() error
 }
 
 type Result struct {
 	StatusCode int
 	Err        error
+	// RetryAfter is the delay hinted by sink before retrying, 0 means no hint.
+	RetryAfter time.Duration
 }
 
 func newInternalErr(err error) Result {
@@ -60,7 +65,7 @@ func convertHTTPResponse(statusCode int, desc string, body []byte) Result {
 
 var (
 	Success         = Result{}
-	DeliveryTimeout = Result{errDeliveryTimeout, errors.New("DeliveryTimeout")}
+	DeliveryTimeout = Result{StatusCode: errDeliveryTimeout, Err: errors.New("DeliveryTimeout")}
 )
 
 const (
@@ -69,3 +74,27 @@ const (
 	errUnknown         = 600
 	errDeliveryTimeout = 601
 )
+
+// IsBackpressure returns true if the sink asks to slow down.
+func (r Result) IsBackpressure() bool {
+	return r.StatusCode == nethttp.StatusTooManyRequests || r.StatusCode == nethttp.StatusServiceUnavailable
+}
+
+// parseRetryAfter parses the value of Retry-After header, which is either delay seconds or a HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := nethttp.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
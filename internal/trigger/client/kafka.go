@@ -0,0 +1,308 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	nethttp "net/http"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/trigger/util"
+)
+
+const (
+	kafkaHeaderPrefix      = "ce_"
+	kafkaHeaderContentType = "content-type"
+	kafkaClientID          = "vanus-trigger"
+
+	// kafkaErrThrottlingQuotaExceeded is THROTTLING_QUOTA_EXCEEDED, which isn't defined by sarama.
+	kafkaErrThrottlingQuotaExceeded sarama.KError = 89
+)
+
+type kafka struct {
+	sink     *primitive.KafkaSink
+	options  *options
+	producer *kafkaProducer
+	err      error
+	lock     sync.Mutex
+}
+
+// kafkaProducer counts the sends in flight on the producer, a retired producer is closed once the last send
+// on it returns, so the broken producer is never closed under a concurrent send.
+type kafkaProducer struct {
+	sarama.SyncProducer
+	inflight int
+	retired  bool
+}
+
+// NewKafkaClient returns a client which produces events to the topic of kafka compatible broker
+// following the CloudEvents Kafka protocol binding. The headers are added to each message, the basic auth
+// is used as SASL/PLAIN, and the client certificate or CA enables TLS.
+func NewKafkaClient(sink string, opts ...Option) EventClient {
+	c := &kafka{
+		options: &options{},
+	}
+	for _, opt := range opts {
+		opt(c.options)
+	}
+	c.sink, c.err = primitive.ParseKafkaSink(sink)
+	return c
+}
+
+func (c *kafka) config() (*sarama.Config, error) {
+	cfg := sarama.NewConfig()
+	cfg.ClientID = kafkaClientID
+	// record headers require kafka 0.11 at least.
+	cfg.Version = sarama.V0_11_0_0
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Producer.Return.Successes = true
+	cfg.Producer.Return.Errors = true
+	// the trigger retries failed events itself
+	cfg.Producer.Retry.Max = 1
+	cfg.Metadata.Retry.Max = 1
+	tlsConfig, err := c.options.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil && c.sink.Secure {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if tlsConfig != nil {
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = tlsConfig
+	}
+	if c.options.basicAuth {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		cfg.Net.SASL.User = c.options.username
+		cfg.Net.SASL.Password = c.options.password
+	}
+	return cfg, nil
+}
+
+// acquireProducer returns the producer and holds a reference of it, the producer is created if the previous one
+// failed to connect or was retired.
+func (c *kafka) acquireProducer() (*kafkaProducer, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.producer == nil {
+		cfg, err := c.config()
+		if err != nil {
+			return nil, err
+		}
+		producer, err := sarama.NewSyncProducer(c.sink.Brokers, cfg)
+		if err != nil {
+			return nil, err
+		}
+		c.producer = &kafkaProducer{SyncProducer: producer}
+	}
+	c.producer.inflight++
+	return c.producer, nil
+}
+
+// releaseProducer releases the reference of producer. If broken is true, the producer is retired so that the next
+// send creates a new one, and it's closed after all sends on it return.
+func (c *kafka) releaseProducer(p *kafkaProducer, broken bool) {
+	c.lock.Lock()
+	if broken && c.producer == p {
+		c.producer = nil
+		p.retired = true
+	}
+	p.inflight--
+	closable := p.retired && p.inflight == 0
+	c.lock.Unlock()
+	if closable {
+		_ = p.Close()
+	}
+}
+
+func (c *kafka) Send(ctx context.Context, events ...*ce.Event) Result {
+	if c.err != nil {
+		return newInternalErr(c.err)
+	}
+	msgs := make([]*sarama.ProducerMessage, len(events))
+	for idx := range events {
+		msg, err := c.toProducerMessage(events[idx])
+		if err != nil {
+			return newInternalErr(err)
+		}
+		msgs[idx] = msg
+	}
+	producer, err := c.acquireProducer()
+	if err != nil {
+		return convertKafkaError(err)
+	}
+	// The producer doesn't accept context, so the delivery timeout is checked outside. The send is bounded by the
+	// timeouts of producer, and the goroutine releases the producer when the send returns even if ctx is done.
+	errCh := make(chan error, 1)
+	go func() {
+		err := producer.SendMessages(msgs)
+		c.releaseProducer(producer, isKafkaConnectionError(err))
+		errCh <- err
+	}()
+	select {
+	case <-ctx.Done():
+		return DeliveryTimeout
+	case err = <-errCh:
+	}
+	if err != nil {
+		return convertKafkaError(err)
+	}
+	return Success
+}
+
+func (c *kafka) toProducerMessage(event *ce.Event) (*sarama.ProducerMessage, error) {
+	msg := &sarama.ProducerMessage{
+		Topic: c.sink.Topic,
+	}
+	for k, v := range c.options.headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+	if c.sink.Key != "" {
+		if v, ok := util.LookupAttribute(*event, c.sink.Key); ok && v != nil {
+			key, err := types.Format(v)
+			if err != nil {
+				return nil, err
+			}
+			msg.Key = sarama.StringEncoder(key)
+		}
+	}
+	if c.sink.Mode == primitive.KafkaStructuredMode {
+		value, err := event.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		msg.Value = sarama.ByteEncoder(value)
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{
+			Key:   []byte(kafkaHeaderContentType),
+			Value: []byte(ce.ApplicationCloudEventsJSON),
+		})
+		return msg, nil
+	}
+	headers, err := kafkaBinaryHeaders(event)
+	if err != nil {
+		return nil, err
+	}
+	msg.Headers = append(msg.Headers, headers...)
+	if data := event.Data(); len(data) > 0 {
+		msg.Value = sarama.ByteEncoder(data)
+	}
+	return msg, nil
+}
+
+// kafkaBinaryHeaders returns the headers of event in binary mode, the attributes are prefixed with "ce_"
+// except datacontenttype which is content-type.
+func kafkaBinaryHeaders(event *ce.Event) ([]sarama.RecordHeader, error) {
+	headers := []sarama.RecordHeader{
+		{Key: []byte(kafkaHeaderPrefix + "specversion"), Value: []byte(event.SpecVersion())},
+		{Key: []byte(kafkaHeaderPrefix + "id"), Value: []byte(event.ID())},
+		{Key: []byte(kafkaHeaderPrefix + "source"), Value: []byte(event.Source())},
+		{Key: []byte(kafkaHeaderPrefix + "type"), Value: []byte(event.Type())},
+	}
+	add := func(name, value string) {
+		if value != "" {
+			headers = append(headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(value)})
+		}
+	}
+	add(kafkaHeaderPrefix+"subject", event.Subject())
+	add(kafkaHeaderPrefix+"dataschema", event.DataSchema())
+	add(kafkaHeaderContentType, event.DataContentType())
+	if !event.Time().IsZero() {
+		add(kafkaHeaderPrefix+"time", event.Time().UTC().Format(time.RFC3339Nano))
+	}
+	for name, v := range event.Extensions() {
+		value, err := types.Format(v)
+		if err != nil {
+			return nil, err
+		}
+		add(kafkaHeaderPrefix+name, value)
+	}
+	return headers, nil
+}
+
+// unwrapKafkaError returns the error of the first message if err is the errors of producer.
+func unwrapKafkaError(err error) error {
+	var producerErrs sarama.ProducerErrors
+	if errors.As(err, &producerErrs) && len(producerErrs) > 0 {
+		return producerErrs[0].Err
+	}
+	return err
+}
+
+// isKafkaConnectionError reports whether err isn't returned by the broker, such as the connection is broken or
+// no broker is available, then the producer is recreated.
+func isKafkaConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var kerr sarama.KError
+	return !errors.As(unwrapKafkaError(err), &kerr)
+}
+
+func convertKafkaError(err error) Result {
+	err = unwrapKafkaError(err)
+	return Result{
+		StatusCode: kafkaErrorToHTTPStatus(err),
+		Err:        err,
+	}
+}
+
+func (c *kafka) Close() error {
+	c.lock.Lock()
+	p := c.producer
+	c.producer = nil
+	if p == nil {
+		c.lock.Unlock()
+		return nil
+	}
+	p.retired = true
+	closable := p.inflight == 0
+	c.lock.Unlock()
+	if !closable {
+		// the last send closes it.
+		return nil
+	}
+	return p.Close()
+}
+
+// kafkaErrorToHTTPStatus maps the error of kafka to HTTP status code, so the retry and dead letter decision
+// of kafka sink is the same as HTTP sink. Most of kafka errors are transient, such as leader not available,
+// which are retried.
+func kafkaErrorToHTTPStatus(err error) int {
+	var kerr sarama.KError
+	if !errors.As(err, &kerr) {
+		return nethttp.StatusServiceUnavailable
+	}
+	switch kerr {
+	case sarama.ErrInvalidMessage, sarama.ErrInvalidMessageSize, sarama.ErrMessageSizeTooLarge,
+		sarama.ErrInvalidRecord:
+		return nethttp.StatusBadRequest
+	case sarama.ErrSASLAuthenticationFailed:
+		return nethttp.StatusUnauthorized
+	case sarama.ErrTopicAuthorizationFailed, sarama.ErrClusterAuthorizationFailed:
+		return nethttp.StatusForbidden
+	case kafkaErrThrottlingQuotaExceeded:
+		return nethttp.StatusTooManyRequests
+	}
+	return nethttp.StatusServiceUnavailable
+}
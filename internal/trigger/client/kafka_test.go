@@ -0,0 +1,208 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+)
+
+const testKafkaTopic = "test-topic"
+
+func newTestKafkaBroker(t *testing.T, kerr sarama.KError) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(testKafkaTopic, 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t).SetVersion(3).
+			SetError(testKafkaTopic, 0, kerr),
+	})
+	return broker
+}
+
+func newTestKafkaEvent() *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	e.SetSubject("subject")
+	e.SetExtension("tenant", "vanus")
+	_ = e.SetData(ce.ApplicationJSON, map[string]string{"key": "value"})
+	return &e
+}
+
+func recordHeaders(headers []sarama.RecordHeader) map[string]string {
+	m := make(map[string]string, len(headers))
+	for _, h := range headers {
+		m[string(h.Key)] = string(h.Value)
+	}
+	return m
+}
+
+func TestParseKafkaSink(t *testing.T) {
+	Convey("test parse kafka sink", t, func() {
+		s, err := primitive.ParseKafkaSink("kafka://b1:9092,b2:9092/topic")
+		So(err, ShouldBeNil)
+		So(s.Brokers, ShouldResemble, []string{"b1:9092", "b2:9092"})
+		So(s.Topic, ShouldEqual, "topic")
+		So(s.Key, ShouldEqual, "")
+		So(s.Mode, ShouldEqual, primitive.KafkaBinaryMode)
+		So(s.Secure, ShouldBeFalse)
+
+		s, err = primitive.ParseKafkaSink("kafkas://b1:9092/topic?key=subject&mode=structured")
+		So(err, ShouldBeNil)
+		So(s.Key, ShouldEqual, "subject")
+		So(s.Mode, ShouldEqual, primitive.KafkaStructuredMode)
+		So(s.Secure, ShouldBeTrue)
+
+		_, err = primitive.ParseKafkaSink("http://b1:9092/topic")
+		So(err, ShouldNotBeNil)
+		_, err = primitive.ParseKafkaSink("kafka:///topic")
+		So(err, ShouldNotBeNil)
+		_, err = primitive.ParseKafkaSink("kafka://b1:9092/a/b")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestKafkaClient_ProducerMessage(t *testing.T) {
+	Convey("test kafka producer message", t, func() {
+		e := newTestKafkaEvent()
+		Convey("binary mode", func() {
+			c := NewKafkaClient("kafka://localhost:9092/"+testKafkaTopic+"?key=tenant",
+				WithHeaders(map[string]string{"X-Key": "value"})).(*kafka)
+			msg, err := c.toProducerMessage(e)
+			So(err, ShouldBeNil)
+			So(msg.Topic, ShouldEqual, testKafkaTopic)
+			So(msg.Key, ShouldEqual, sarama.StringEncoder("vanus"))
+			So(msg.Value, ShouldResemble, sarama.ByteEncoder(e.Data()))
+			headers := recordHeaders(msg.Headers)
+			So(headers["X-Key"], ShouldEqual, "value")
+			So(headers["ce_specversion"], ShouldEqual, "1.0")
+			So(headers["ce_id"], ShouldEqual, "id")
+			So(headers["ce_source"], ShouldEqual, "source")
+			So(headers["ce_type"], ShouldEqual, "type")
+			So(headers["ce_subject"], ShouldEqual, "subject")
+			So(headers["ce_tenant"], ShouldEqual, "vanus")
+			So(headers["content-type"], ShouldEqual, ce.ApplicationJSON)
+		})
+		Convey("structured mode", func() {
+			c := NewKafkaClient("kafka://localhost:9092/" + testKafkaTopic + "?mode=structured").(*kafka)
+			msg, err := c.toProducerMessage(e)
+			So(err, ShouldBeNil)
+			So(msg.Key, ShouldBeNil)
+			So(recordHeaders(msg.Headers)["content-type"], ShouldEqual, ce.ApplicationCloudEventsJSON)
+			value, _ := msg.Value.Encode()
+			var got ce.Event
+			So(json.Unmarshal(value, &got), ShouldBeNil)
+			So(got.ID(), ShouldEqual, "id")
+			So(got.Extensions()["tenant"], ShouldEqual, "vanus")
+		})
+	})
+}
+
+func TestKafkaClient_Send(t *testing.T) {
+	Convey("test kafka client send", t, func() {
+		ctx := context.Background()
+		e := newTestKafkaEvent()
+		Convey("invalid sink", func() {
+			c := NewKafkaClient("kafka://localhost:9092")
+			So(c.Send(ctx, e).StatusCode, ShouldEqual, nethttp.StatusInternalServerError)
+		})
+		Convey("success", func() {
+			broker := newTestKafkaBroker(t, sarama.ErrNoError)
+			defer broker.Close()
+			c := NewKafkaClient("kafka://" + broker.Addr() + "/" + testKafkaTopic)
+			defer func() {
+				_ = c.Close()
+			}()
+			So(c.Send(ctx, e, e), ShouldResemble, Success)
+		})
+		Convey("message too large", func() {
+			broker := newTestKafkaBroker(t, sarama.ErrMessageSizeTooLarge)
+			defer broker.Close()
+			c := NewKafkaClient("kafka://" + broker.Addr() + "/" + testKafkaTopic)
+			defer func() {
+				_ = c.Close()
+			}()
+			So(c.Send(ctx, e).StatusCode, ShouldEqual, nethttp.StatusBadRequest)
+		})
+		Convey("broker unavailable", func() {
+			broker := sarama.NewMockBroker(t, 1)
+			addr := broker.Addr()
+			broker.Close()
+			c := NewKafkaClient("kafka://" + addr + "/" + testKafkaTopic)
+			So(c.Send(ctx, e).StatusCode, ShouldEqual, nethttp.StatusServiceUnavailable)
+		})
+	})
+}
+
+type testSyncProducer struct {
+	sarama.SyncProducer
+	closed bool
+}
+
+func (p *testSyncProducer) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestKafkaClient_ReleaseProducer(t *testing.T) {
+	Convey("test kafka client release producer", t, func() {
+		c := NewKafkaClient("kafka://localhost:9092/" + testKafkaTopic).(*kafka)
+		sp := &testSyncProducer{}
+		p := &kafkaProducer{SyncProducer: sp, inflight: 2}
+		c.producer = p
+
+		Convey("broken producer is closed after in-flight sends return", func() {
+			c.releaseProducer(p, true)
+			So(c.producer, ShouldBeNil)
+			So(sp.closed, ShouldBeFalse)
+			c.releaseProducer(p, false)
+			So(sp.closed, ShouldBeTrue)
+		})
+		Convey("close client with in-flight sends", func() {
+			So(c.Close(), ShouldBeNil)
+			So(sp.closed, ShouldBeFalse)
+			c.releaseProducer(p, false)
+			c.releaseProducer(p, false)
+			So(sp.closed, ShouldBeTrue)
+		})
+		Convey("error from broker keeps producer", func() {
+			c.releaseProducer(p, isKafkaConnectionError(sarama.ErrNotEnoughReplicas))
+			So(c.producer, ShouldEqual, p)
+			So(isKafkaConnectionError(sarama.ErrOutOfBrokers), ShouldBeTrue)
+			So(isKafkaConnectionError(nil), ShouldBeFalse)
+		})
+	})
+}
+
+func TestKafkaErrorToHTTPStatus(t *testing.T) {
+	Convey("test kafka error to http status", t, func() {
+		So(kafkaErrorToHTTPStatus(sarama.ErrNotEnoughReplicas), ShouldEqual, nethttp.StatusServiceUnavailable)
+		So(kafkaErrorToHTTPStatus(sarama.ErrMessageSizeTooLarge), ShouldEqual, nethttp.StatusBadRequest)
+		So(kafkaErrorToHTTPStatus(sarama.ErrTopicAuthorizationFailed), ShouldEqual, nethttp.StatusForbidden)
+		So(kafkaErrorToHTTPStatus(kafkaErrThrottlingQuotaExceeded), ShouldEqual, nethttp.StatusTooManyRequests)
+		So(kafkaErrorToHTTPStatus(sarama.ErrOutOfBrokers), ShouldEqual, nethttp.StatusServiceUnavailable)
+	})
+}
@@ -65,3 +65,7 @@ func (l *awsLambda) Send(ctx context.Context, events ...*ce.Event) Result {
 	}
 	return Success
 }
+
+func (l *awsLambda) Close() error {
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+)
+
+var errInvalidCACert = errors.New("no certificate found in ca cert")
+
+// Option configures the authentication and TLS of the sink, the options are shared by HTTP and gRPC
+// clients except that HMAC signing is only for HTTP.
+type Option func(o *options)
+
+type options struct {
+	headers    map[string]string
+	basicAuth  bool
+	username   string
+	password   string
+	token      string
+	hmacSecret string
+	clientCert string
+	clientKey  string
+	caCert     string
+}
+
+// WithHeaders sets the static headers of HTTP request or the metadata of gRPC request.
+func WithHeaders(headers map[string]string) Option {
+	return func(o *options) {
+		o.headers = headers
+	}
+}
+
+// WithBasicAuth sets the Authorization header with the username and password.
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		o.basicAuth = true
+		o.username = username
+		o.password = password
+	}
+}
+
+// WithBearerToken sets the Authorization header with the token.
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.token = token
+	}
+}
+
+// WithHMACSigning signs the request with the secret, so the sink is able to verify the request is from Vanus.
+func WithHMACSigning(secret string) Option {
+	return func(o *options) {
+		o.hmacSecret = secret
+	}
+}
+
+// WithClientCert sets the PEM encoded client certificate and key for mutual TLS.
+func WithClientCert(cert, key string) Option {
+	return func(o *options) {
+		o.clientCert = cert
+		o.clientKey = key
+	}
+}
+
+// WithCACert sets the PEM encoded CA bundle to verify the sink instead of the system pool.
+func WithCACert(ca string) Option {
+	return func(o *options) {
+		o.caCert = ca
+	}
+}
+
+// tlsConfig returns nil if neither client certificate nor CA is set.
+func (o *options) tlsConfig() (*tls.Config, error) {
+	if o.clientCert == "" && o.caCert == "" {
+		return nil, nil //nolint:nilnil // nil means default
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if o.clientCert != "" {
+		cert, err := tls.X509KeyPair([]byte(o.clientCert), []byte(o.clientKey))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if o.caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(o.caCert)) {
+			return nil, errInvalidCACert
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// authorization returns the value of Authorization header.
+func (o *options) authorization() string {
+	switch {
+	case o.basicAuth:
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(o.username+":"+o.password))
+	case o.token != "":
+		return "Bearer " + o.token
+	}
+	return ""
+}
@@ -45,6 +45,7 @@ const (
 	lookupReadableLogsTimeout = 5 * time.Second
 	readEventTimeout          = 5 * time.Second
 	readErrSleepTime          = 2 * time.Second
+	checkEventlogsInterval    = 30 * time.Second
 )
 
 type Config struct {
@@ -93,9 +94,7 @@ func (r *reader) Close() {
 func (r *reader) Start() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	r.stop = cancel
-	timeoutCtx, cancel := context.WithTimeout(ctx, lookupReadableLogsTimeout)
-	defer cancel()
-	logs, err := r.config.Client.Eventbus(timeoutCtx, api.WithID(r.config.EventbusID.Uint64())).ListLog(timeoutCtx)
+	logs, err := r.listLogs(ctx)
 	if err != nil {
 		log.Warning(ctx, "eventbus lookup Readable eventlog error", map[string]interface{}{
 			log.KeyEventbusID: r.config.EventbusID,
@@ -104,33 +103,77 @@ func (r *reader) Start() error {
 		return err
 	}
 	for _, l := range logs {
-		eventlogID := vanus.NewIDFromUint64(l.ID())
-		offset := r.getOffset(eventlogID)
-		elc := &eventlogReader{
-			config:        r.config,
-			eventlogID:    eventlogID,
-			eventlogIDStr: eventlogID.String(),
-			policy:        policy.NewManuallyReadPolicy(l, int64(offset)),
-			events:        r.events,
-			offset:        offset,
+		r.startEventlogReader(ctx, l)
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.watchEventlogs(ctx)
+	}()
+	return nil
+}
+
+func (r *reader) listLogs(ctx context.Context) ([]api.Eventlog, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, lookupReadableLogsTimeout)
+	defer cancel()
+	return r.config.Client.Eventbus(timeoutCtx, api.WithID(r.config.EventbusID.Uint64())).ListLog(timeoutCtx)
+}
+
+// watchEventlogs starts the readers of eventlogs which are added after the reader started,
+// e.g. the number of eventlogs of eventbus is increased.
+func (r *reader) watchEventlogs(ctx context.Context) {
+	ticker := time.NewTicker(checkEventlogsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
-		r.wg.Add(1)
-		go func() {
-			defer r.wg.Done()
-			log.Info(ctx, "event eventlog reader start", map[string]interface{}{
-				log.KeyEventbusID: r.config.EventbusID,
-				log.KeyEventlogID: elc.eventlogID,
-				"offset":          elc.offset,
-			})
-			elc.run(ctx)
-			log.Info(ctx, "event eventlog reader stop", map[string]interface{}{
+		logs, err := r.listLogs(ctx)
+		if err != nil {
+			log.Warning(ctx, "eventbus lookup Readable eventlog error", map[string]interface{}{
 				log.KeyEventbusID: r.config.EventbusID,
-				log.KeyEventlogID: elc.eventlogID,
-				"offset":          elc.offset,
+				log.KeyError:      err,
 			})
-		}()
+			continue
+		}
+		for _, l := range logs {
+			r.startEventlogReader(ctx, l)
+		}
 	}
-	return nil
+}
+
+func (r *reader) startEventlogReader(ctx context.Context, l api.Eventlog) {
+	eventlogID := vanus.NewIDFromUint64(l.ID())
+	if _, exist := r.elReader[eventlogID]; exist {
+		return
+	}
+	r.elReader[eventlogID] = struct{}{}
+	offset := r.getOffset(eventlogID)
+	elc := &eventlogReader{
+		config:        r.config,
+		eventlogID:    eventlogID,
+		eventlogIDStr: eventlogID.String(),
+		policy:        policy.NewManuallyReadPolicy(l, int64(offset)),
+		events:        r.events,
+		offset:        offset,
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		log.Info(ctx, "event eventlog reader start", map[string]interface{}{
+			log.KeyEventbusID: r.config.EventbusID,
+			log.KeyEventlogID: elc.eventlogID,
+			"offset":          elc.offset,
+		})
+		elc.run(ctx)
+		log.Info(ctx, "event eventlog reader stop", map[string]interface{}{
+			log.KeyEventbusID: r.config.EventbusID,
+			log.KeyEventlogID: elc.eventlogID,
+			"offset":          elc.offset,
+		})
+	}()
 }
 
 func (r *reader) getOffset(eventlogID vanus.ID) uint64 {
@@ -220,7 +263,10 @@ func (elReader *eventlogReader) loop(ctx context.Context, lr api.BusReader) erro
 		}
 		elReader.offset = offset
 	}
-	elReader.policy.Forward(len(events))
+	if len(events) != 0 {
+		// Offsets of compacted events are skipped.
+		elReader.policy.Forward(int(int64(elReader.offset) + 1 - elReader.policy.Offset()))
+	}
 	metrics.TriggerPullEventCounter.WithLabelValues(
 		elReader.config.SubscriptionIDStr, elReader.config.EventbusID.Key(), elReader.eventlogIDStr).
 		Add(float64(len(events)))
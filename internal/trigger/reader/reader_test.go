@@ -86,6 +86,9 @@ func TestReaderStart(t *testing.T) {
 			}
 		}()
 		wg.Wait()
+		// the reader of an eventlog is started only once
+		r.startEventlogReader(context.Background(), mockEventlog)
+		So(r.elReader, ShouldHaveLength, 1)
 		r.Close()
 	})
 }
@@ -0,0 +1,135 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+const (
+	// minBackpressureRate is the lowest rate while sink is pushing back.
+	minBackpressureRate = 1
+	// backpressureRecoverInterval is the interval of increasing rate after sink recovered.
+	backpressureRecoverInterval = time.Second
+	// maxRetryAfter limits the delay hinted by sink.
+	maxRetryAfter = time.Hour
+)
+
+// backpressureLimiter lowers the effective rate limit while sink is pushing back, it halves the rate
+// each time sink responds with 429 or 503, and increases the rate by 10% per second after sink
+// recovered, until the rate reaches the rate before the sink pushed back.
+type backpressureLimiter struct {
+	mutex   sync.RWMutex
+	limiter ratelimit.Limiter
+	// rate is the current effective rate, 0 means no backpressure.
+	rate int
+	// ceiling is the rate before sink pushed back.
+	ceiling    int
+	lastAdjust time.Time
+
+	// count the events sent in the current window to estimate the sending rate.
+	windowStart time.Time
+	windowCount int
+	lastRate    int
+}
+
+func newBackpressureLimiter() *backpressureLimiter {
+	return &backpressureLimiter{windowStart: time.Now()}
+}
+
+// Take blocks to make sure the effective rate while sink is pushing back.
+func (l *backpressureLimiter) Take() {
+	l.mutex.Lock()
+	now := time.Now()
+	if now.Sub(l.windowStart) >= time.Second {
+		l.lastRate = l.windowCount
+		l.windowCount = 0
+		l.windowStart = now
+	}
+	l.windowCount++
+	limiter := l.limiter
+	l.mutex.Unlock()
+	if limiter != nil {
+		limiter.Take()
+	}
+}
+
+// Throttle is invoked when sink pushes back, limit is the configured rate limit, 0 means unlimited.
+func (l *backpressureLimiter) Throttle(limit int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	base := l.rate
+	if base == 0 {
+		base = l.lastRate
+		if l.windowCount > base {
+			base = l.windowCount
+		}
+		if limit > 0 && (base == 0 || base > limit) {
+			base = limit
+		}
+		l.ceiling = base
+	} else if time.Since(l.lastAdjust) < backpressureRecoverInterval {
+		// the events in flight before the last adjustment are still coming back.
+		return
+	}
+	rate := base / 2 //nolint:gomnd // halve
+	if rate < minBackpressureRate {
+		rate = minBackpressureRate
+	}
+	l.setRate(rate)
+}
+
+// Recover is invoked when sink accepts events.
+func (l *backpressureLimiter) Recover() {
+	l.mutex.RLock()
+	throttled := l.rate > 0 && time.Since(l.lastAdjust) >= backpressureRecoverInterval
+	l.mutex.RUnlock()
+	if !throttled {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.rate == 0 || time.Since(l.lastAdjust) < backpressureRecoverInterval {
+		return
+	}
+	step := l.rate / 10 //nolint:gomnd // 10%
+	if step < 1 {
+		step = 1
+	}
+	rate := l.rate + step
+	if rate >= l.ceiling {
+		l.limiter = nil
+		l.rate = 0
+		l.lastAdjust = time.Now()
+		return
+	}
+	l.setRate(rate)
+}
+
+func (l *backpressureLimiter) setRate(rate int) {
+	l.rate = rate
+	l.limiter = ratelimit.New(rate)
+	l.lastAdjust = time.Now()
+}
+
+// Rate returns the effective rate, 0 means no backpressure.
+func (l *backpressureLimiter) Rate() int {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.rate
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBackpressureLimiter(t *testing.T) {
+	Convey("test backpressure limiter", t, func() {
+		l := newBackpressureLimiter()
+		So(l.Rate(), ShouldEqual, 0)
+		Convey("throttle with configured rate limit", func() {
+			l.Throttle(100)
+			So(l.Rate(), ShouldEqual, 50)
+			// ignore the push back of events in flight
+			l.Throttle(100)
+			So(l.Rate(), ShouldEqual, 50)
+			l.lastAdjust = time.Now().Add(-backpressureRecoverInterval)
+			l.Throttle(100)
+			So(l.Rate(), ShouldEqual, 25)
+		})
+		Convey("throttle with observed rate", func() {
+			for i := 0; i < 10; i++ {
+				l.Take()
+			}
+			l.Throttle(0)
+			So(l.Rate(), ShouldEqual, 5)
+		})
+		Convey("throttle to min rate", func() {
+			l.Throttle(0)
+			So(l.Rate(), ShouldEqual, minBackpressureRate)
+		})
+		Convey("recover", func() {
+			l.Throttle(20)
+			So(l.Rate(), ShouldEqual, 10)
+			l.Recover()
+			So(l.Rate(), ShouldEqual, 10)
+			for _, rate := range []int{11, 12, 13, 14, 15, 16, 17, 18, 19, 0} {
+				l.lastAdjust = time.Now().Add(-backpressureRecoverInterval)
+				l.Recover()
+				So(l.Rate(), ShouldEqual, rate)
+			}
+		})
+	})
+}
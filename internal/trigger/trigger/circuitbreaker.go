@@ -0,0 +1,206 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	nethttp "net/http"
+	"sync"
+	"time"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/trigger/client"
+)
+
+// circuitBreaker stops delivering events to a sink which is down. It opens after threshold consecutive
+// failures, and moves to half-open after the open timeout, where only one event is sent as a probe.
+// The breaker is closed if the probe succeeds, otherwise it opens again with a doubled timeout.
+type circuitBreaker struct {
+	mutex          sync.Mutex
+	state          primitive.CircuitBreakerState
+	failures       int
+	threshold      int
+	minOpenTimeout time.Duration
+	maxOpenTimeout time.Duration
+	openTimeout    time.Duration
+	openedAt       time.Time
+	probing        bool
+	// changed is closed when the state changes to wake up the waiters.
+	changed       chan struct{}
+	onStateChange func(from, to primitive.CircuitBreakerState)
+}
+
+func newCircuitBreaker(threshold int, openTimeout, maxOpenTimeout time.Duration,
+	onStateChange func(from, to primitive.CircuitBreakerState),
+) *circuitBreaker {
+	if maxOpenTimeout < openTimeout {
+		maxOpenTimeout = openTimeout
+	}
+	return &circuitBreaker{
+		state:          primitive.CircuitBreakerClosed,
+		threshold:      threshold,
+		minOpenTimeout: openTimeout,
+		maxOpenTimeout: maxOpenTimeout,
+		openTimeout:    openTimeout,
+		changed:        make(chan struct{}),
+		onStateChange:  onStateChange,
+	}
+}
+
+// State returns the current state of the breaker.
+func (cb *circuitBreaker) State() primitive.CircuitBreakerState {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	cb.checkOpenTimeout()
+	return cb.state
+}
+
+// Wait blocks while the breaker is open.
+func (cb *circuitBreaker) Wait(ctx context.Context) error {
+	for {
+		cb.mutex.Lock()
+		cb.checkOpenTimeout()
+		if cb.state != primitive.CircuitBreakerOpen {
+			cb.mutex.Unlock()
+			return nil
+		}
+		wait := time.Until(cb.openedAt.Add(cb.openTimeout))
+		changed := cb.changed
+		cb.mutex.Unlock()
+		if err := cb.waitChange(ctx, changed, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Acquire blocks until an event is allowed to be sent, probe is true if the event is the probe of half-open
+// state, the result of the event must be reported by Done.
+func (cb *circuitBreaker) Acquire(ctx context.Context) (bool, error) {
+	for {
+		cb.mutex.Lock()
+		cb.checkOpenTimeout()
+		var wait time.Duration
+		switch cb.state {
+		case primitive.CircuitBreakerClosed:
+			cb.mutex.Unlock()
+			return false, nil
+		case primitive.CircuitBreakerHalfOpen:
+			if !cb.probing {
+				cb.probing = true
+				cb.mutex.Unlock()
+				return true, nil
+			}
+		case primitive.CircuitBreakerOpen:
+			wait = time.Until(cb.openedAt.Add(cb.openTimeout))
+		}
+		changed := cb.changed
+		cb.mutex.Unlock()
+		if err := cb.waitChange(ctx, changed, wait); err != nil {
+			return false, err
+		}
+	}
+}
+
+// Done records the result of the event allowed by Acquire.
+func (cb *circuitBreaker) Done(probe, success bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	if probe {
+		cb.probing = false
+		if cb.state != primitive.CircuitBreakerHalfOpen {
+			return
+		}
+		if success {
+			cb.failures = 0
+			cb.openTimeout = cb.minOpenTimeout
+			cb.setState(primitive.CircuitBreakerClosed)
+			return
+		}
+		cb.openTimeout *= 2
+		if cb.openTimeout > cb.maxOpenTimeout {
+			cb.openTimeout = cb.maxOpenTimeout
+		}
+		cb.open()
+		return
+	}
+	// the events sent before the breaker opened are ignored.
+	if cb.state != primitive.CircuitBreakerClosed {
+		return
+	}
+	if success {
+		cb.failures = 0
+		return
+	}
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.open()
+	}
+}
+
+// Reset closes the breaker, it's used when the sink changed.
+func (cb *circuitBreaker) Reset() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	cb.failures = 0
+	cb.openTimeout = cb.minOpenTimeout
+	if cb.state != primitive.CircuitBreakerClosed {
+		cb.setState(primitive.CircuitBreakerClosed)
+	}
+}
+
+func (cb *circuitBreaker) open() {
+	cb.failures = 0
+	cb.openedAt = time.Now()
+	cb.setState(primitive.CircuitBreakerOpen)
+}
+
+func (cb *circuitBreaker) checkOpenTimeout() {
+	if cb.state == primitive.CircuitBreakerOpen && time.Since(cb.openedAt) >= cb.openTimeout {
+		cb.setState(primitive.CircuitBreakerHalfOpen)
+	}
+}
+
+func (cb *circuitBreaker) setState(state primitive.CircuitBreakerState) {
+	from := cb.state
+	cb.state = state
+	close(cb.changed)
+	cb.changed = make(chan struct{})
+	if cb.onStateChange != nil {
+		cb.onStateChange(from, state)
+	}
+}
+
+func (cb *circuitBreaker) waitChange(ctx context.Context, changed <-chan struct{}, wait time.Duration) error {
+	var timeout <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-changed:
+	case <-timeout:
+	}
+	return nil
+}
+
+// isSinkFailure returns true if the result means sink is unavailable rather than the event is rejected.
+func isSinkFailure(r client.Result) bool {
+	return r.StatusCode >= nethttp.StatusInternalServerError ||
+		r.StatusCode == nethttp.StatusTooManyRequests ||
+		r.StatusCode == nethttp.StatusRequestTimeout
+}
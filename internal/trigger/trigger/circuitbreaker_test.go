@@ -0,0 +1,123 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/trigger/client"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	Convey("test circuit breaker", t, func() {
+		ctx := context.Background()
+		var transitions []primitive.CircuitBreakerState
+		cb := newCircuitBreaker(3, 50*time.Millisecond, 150*time.Millisecond,
+			func(_, to primitive.CircuitBreakerState) {
+				transitions = append(transitions, to)
+			})
+		So(cb.State(), ShouldEqual, primitive.CircuitBreakerClosed)
+
+		Convey("success resets failures", func() {
+			cb.Done(false, false)
+			cb.Done(false, false)
+			cb.Done(false, true)
+			cb.Done(false, false)
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerClosed)
+		})
+
+		Convey("open and probe", func() {
+			for i := 0; i < 3; i++ {
+				probe, err := cb.Acquire(ctx)
+				So(err, ShouldBeNil)
+				So(probe, ShouldBeFalse)
+				cb.Done(probe, false)
+			}
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerOpen)
+			// the events sent before opened don't change the state
+			cb.Done(false, true)
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerOpen)
+
+			timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			So(cb.Wait(timeoutCtx), ShouldResemble, context.DeadlineExceeded)
+
+			So(cb.Wait(ctx), ShouldBeNil)
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerHalfOpen)
+			probe, err := cb.Acquire(ctx)
+			So(err, ShouldBeNil)
+			So(probe, ShouldBeTrue)
+
+			// only one probe is allowed
+			timeoutCtx2, cancel2 := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel2()
+			_, err = cb.Acquire(timeoutCtx2)
+			So(err, ShouldResemble, context.DeadlineExceeded)
+
+			Convey("probe failed", func() {
+				cb.Done(true, false)
+				So(cb.State(), ShouldEqual, primitive.CircuitBreakerOpen)
+				So(cb.openTimeout, ShouldEqual, 100*time.Millisecond)
+				cb.openedAt = time.Now().Add(-100 * time.Millisecond)
+				So(cb.State(), ShouldEqual, primitive.CircuitBreakerHalfOpen)
+				probe, _ = cb.Acquire(ctx)
+				cb.Done(probe, false)
+				So(cb.openTimeout, ShouldEqual, 150*time.Millisecond)
+			})
+
+			Convey("probe succeeded", func() {
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					p, _ := cb.Acquire(ctx)
+					cb.Done(p, true)
+				}()
+				cb.Done(true, true)
+				<-done
+				So(cb.State(), ShouldEqual, primitive.CircuitBreakerClosed)
+				So(cb.openTimeout, ShouldEqual, 50*time.Millisecond)
+				So(transitions, ShouldResemble, []primitive.CircuitBreakerState{
+					primitive.CircuitBreakerOpen, primitive.CircuitBreakerHalfOpen, primitive.CircuitBreakerClosed,
+				})
+			})
+		})
+
+		Convey("reset", func() {
+			for i := 0; i < 3; i++ {
+				cb.Done(false, false)
+			}
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerOpen)
+			cb.Reset()
+			So(cb.State(), ShouldEqual, primitive.CircuitBreakerClosed)
+		})
+	})
+}
+
+func TestIsSinkFailure(t *testing.T) {
+	Convey("test is sink failure", t, func() {
+		So(isSinkFailure(client.Success), ShouldBeFalse)
+		So(isSinkFailure(client.Result{StatusCode: 400}), ShouldBeFalse)
+		So(isSinkFailure(client.Result{StatusCode: 404}), ShouldBeFalse)
+		So(isSinkFailure(client.Result{StatusCode: 408}), ShouldBeTrue)
+		So(isSinkFailure(client.Result{StatusCode: 429}), ShouldBeTrue)
+		So(isSinkFailure(client.Result{StatusCode: 503}), ShouldBeTrue)
+		So(isSinkFailure(client.DeliveryTimeout), ShouldBeTrue)
+	})
+}
@@ -29,6 +29,12 @@ const (
 	defaultGoroutineSize   = 10000
 	defaultMaxUACKNumber   = 10000
 	defaultBatchSize       = 32
+	// defaultOrderedConcurrency is the number of keys of an ordered subscription delivered in parallel.
+	defaultOrderedConcurrency = 16
+	// defaultCircuitBreakerThreshold is the number of consecutive sink failures which opens the circuit breaker.
+	defaultCircuitBreakerThreshold      = 10
+	defaultCircuitBreakerOpenTimeout    = 10 * time.Second
+	defaultCircuitBreakerMaxOpenTimeout = 5 * time.Minute
 )
 
 type Config struct {
@@ -39,12 +45,20 @@ type Config struct {
 	Controllers       []string
 	MaxWriteAttempt   int
 	Ordered           bool
+	OrderedKey        string
 	DisableDeadLetter bool
+	RetryPolicy       *primitive.RetryPolicy
 
 	GoroutineSize int
 	SendBatchSize int
 	PullBatchSize int
 	MaxUACKNumber int
+
+	OrderedConcurrency int
+
+	CircuitBreakerThreshold      int
+	CircuitBreakerOpenTimeout    time.Duration
+	CircuitBreakerMaxOpenTimeout time.Duration
 }
 
 func defaultConfig() Config {
@@ -57,6 +71,12 @@ func defaultConfig() Config {
 		SendBatchSize:    defaultBatchSize,
 		MaxUACKNumber:    defaultMaxUACKNumber,
 		PullBatchSize:    defaultBatchSize,
+
+		OrderedConcurrency: defaultOrderedConcurrency,
+
+		CircuitBreakerThreshold:      defaultCircuitBreakerThreshold,
+		CircuitBreakerOpenTimeout:    defaultCircuitBreakerOpenTimeout,
+		CircuitBreakerMaxOpenTimeout: defaultCircuitBreakerMaxOpenTimeout,
 	}
 	return c
 }
@@ -97,6 +117,29 @@ func WithOrdered(ordered bool) Option {
 	}
 }
 
+// WithOrderedKey sets the attribute of event which ordered delivery is scoped to,
+// events with different keys are delivered in parallel.
+func WithOrderedKey(key string) Option {
+	return func(t *trigger) {
+		t.config.OrderedKey = key
+	}
+}
+
+func WithOrderedConcurrency(concurrency int) Option {
+	return func(t *trigger) {
+		if concurrency <= 0 {
+			return
+		}
+		t.config.OrderedConcurrency = concurrency
+	}
+}
+
+func WithRetryPolicy(policy *primitive.RetryPolicy) Option {
+	return func(t *trigger) {
+		t.config.RetryPolicy = policy
+	}
+}
+
 func WithRateLimit(rateLimit uint32) Option {
 	return func(t *trigger) {
 		t.config.RateLimit = rateLimit
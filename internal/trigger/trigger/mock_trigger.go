@@ -50,6 +50,20 @@ func (mr *MockTriggerMockRecorder) Change(ctx, subscription interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockTrigger)(nil).Change), ctx, subscription)
 }
 
+// GetCircuitBreakerState mocks base method.
+func (m *MockTrigger) GetCircuitBreakerState() primitive.CircuitBreakerState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCircuitBreakerState")
+	ret0, _ := ret[0].(primitive.CircuitBreakerState)
+	return ret0
+}
+
+// GetCircuitBreakerState indicates an expected call of GetCircuitBreakerState.
+func (mr *MockTriggerMockRecorder) GetCircuitBreakerState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCircuitBreakerState", reflect.TypeOf((*MockTrigger)(nil).GetCircuitBreakerState))
+}
+
 // GetOffsets mocks base method.
 func (m *MockTrigger) GetOffsets(ctx context.Context) info.ListOffsetInfo {
 	m.ctrl.T.Helper()
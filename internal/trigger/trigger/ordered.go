@@ -0,0 +1,147 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"github.com/vanus-labs/vanus/observability/log"
+	"github.com/vanus-labs/vanus/observability/metrics"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/trigger/client"
+	"github.com/vanus-labs/vanus/internal/trigger/info"
+)
+
+const (
+	// orderedRetryMaxInterval is the max interval of retrying ordered event in place, unless the max delay of
+	// retry policy is set.
+	orderedRetryMaxInterval = 30 * time.Second
+	// orderedRetryMinInterval prevents retrying ordered event in a hot loop.
+	orderedRetryMinInterval = 100 * time.Millisecond
+)
+
+// dispatchOrderedEvent routes the event to the channel of its key, events with the same key are always
+// routed to the same channel, so they are delivered one by one.
+func (t *trigger) dispatchOrderedEvent(ctx context.Context, record info.EventRecord) {
+	idx := 0
+	if len(t.orderedEventChs) > 1 {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(getOrderedKey(record.Event, t.getConfig().OrderedKey)))
+		idx = int(h.Sum32() % uint32(len(t.orderedEventChs)))
+	}
+	select {
+	case t.orderedEventChs[idx] <- record:
+	case <-ctx.Done():
+	}
+}
+
+func (t *trigger) runOrderedEventSend(ctx context.Context, ch <-chan info.EventRecord) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case record, ok := <-ch:
+			if !ok {
+				return
+			}
+			t.processOrderedEvent(ctx, record)
+		}
+	}
+}
+
+// processOrderedEvent retries the event in place until success, so the following events of the same key
+// are blocked. The max retry attempts of subscription doesn't apply here, the event is never skipped unless
+// it can't be delivered forever. The offset isn't committed if the trigger stopped before the event is delivered.
+func (t *trigger) processOrderedEvent(ctx context.Context, record info.EventRecord) {
+	event, ok := t.filterTransformEvent(ctx, record)
+	if !ok {
+		return
+	}
+	var attempts int32
+	for {
+		r := t.sendEvent(ctx, event.transform)
+		if r == client.Success {
+			metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelSuccess).Inc()
+			t.offsetManager.EventCommit(record.OffsetInfo)
+			return
+		}
+		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelFailed).Inc()
+		policy := t.getConfig().RetryPolicy
+		if needRetry, _ := shouldRetry(policy, r.StatusCode); !needRetry {
+			// the event can't be delivered forever, skip it to unblock the key.
+			t.writeFailResult(ctx, record.Event, r)
+			t.offsetManager.EventCommit(record.OffsetInfo)
+			return
+		}
+		attempts++
+		delay := withRetryAfter(calOrderedRetryDelay(policy, attempts), r.RetryAfter)
+		log.Info(ctx, "send ordered event fail, will retry", map[string]interface{}{
+			log.KeyError:   r.Err,
+			"code":         r.StatusCode,
+			"attempts":     attempts,
+			"delay":        delay,
+			"event_id":     record.Event.ID(),
+			"event_offset": record.OffsetInfo,
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// calOrderedRetryDelay limits the delay of retry policy in [orderedRetryMinInterval, orderedRetryMaxInterval],
+// the upper limit is the max delay of policy if it's set.
+func calOrderedRetryDelay(policy *primitive.RetryPolicy, attempts int32) time.Duration {
+	delay := calRetryDelay(policy, attempts)
+	if (policy == nil || policy.MaxDelay == 0) && delay > orderedRetryMaxInterval {
+		delay = orderedRetryMaxInterval
+	}
+	if delay < orderedRetryMinInterval {
+		delay = orderedRetryMinInterval
+	}
+	return delay
+}
+
+// getOrderedKey returns the value of the attribute, all events share the empty key if attribute isn't set.
+func getOrderedKey(event *ce.Event, attribute string) string {
+	switch attribute {
+	case "":
+		return ""
+	case "id":
+		return event.ID()
+	case "source":
+		return event.Source()
+	case "type":
+		return event.Type()
+	case "subject":
+		return event.Subject()
+	case "dataschema":
+		return event.DataSchema()
+	}
+	v, ok := event.Extensions()[attribute]
+	if !ok {
+		return ""
+	}
+	key, _ := types.ToString(v)
+	return key
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/vanus-labs/vanus/client/pkg/api"
 	"github.com/vanus-labs/vanus/observability/log"
 	"github.com/vanus-labs/vanus/observability/metrics"
+	"github.com/vanus-labs/vanus/pkg/errors"
 	"github.com/vanus-labs/vanus/pkg/util"
 
 	"github.com/vanus-labs/vanus/internal/primitive"
@@ -61,6 +62,7 @@ type Trigger interface {
 	Stop(ctx context.Context) error
 	Change(ctx context.Context, subscription *primitive.Subscription) error
 	GetOffsets(ctx context.Context) pInfo.ListOffsetInfo
+	GetCircuitBreakerState() primitive.CircuitBreakerState
 }
 
 type trigger struct {
@@ -73,13 +75,17 @@ type trigger struct {
 	sendCh        chan *toSendEvent
 	batchSendCh   chan []*toSendEvent
 	eventCli      client.EventClient
+	clientRefs    *sync.WaitGroup // counts the in-flight sends of eventCli
 	client        eb.Client
 	filter        filter.Filter
 	transformer   *transform.Transformer
 	rateLimiter   ratelimit.Limiter
+	backpressure  *backpressureLimiter
+	breaker       *circuitBreaker
 	config        Config
 	batch         bool
 
+	orderedEventChs  []chan info.EventRecord
 	retryEventCh     chan info.EventRecord
 	retryEventReader reader.Reader
 	timerEventWriter api.BusWriter
@@ -107,6 +113,7 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 		subscription:      subscription,
 		subscriptionIDStr: subscription.ID.String(),
 		transformer:       transform.NewTransformer(subscription.Transformer),
+		backpressure:      newBackpressureLimiter(),
 	}
 	if subscription.Protocol == primitive.GRPC {
 		t.batch = true
@@ -115,6 +122,9 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 	if t.rateLimiter == nil {
 		t.rateLimiter = ratelimit.NewUnlimited()
 	}
+	t.breaker = newCircuitBreaker(t.config.CircuitBreakerThreshold, t.config.CircuitBreakerOpenTimeout,
+		t.config.CircuitBreakerMaxOpenTimeout, t.onCircuitBreakerStateChange)
+	metrics.TriggerCircuitBreakerState.WithLabelValues(t.subscriptionIDStr).Set(0)
 	t.offsetManager = offset.NewSubscriptionOffset(subscription.ID, t.config.MaxUACKNumber, subscription.Offsets)
 	t.pool, _ = ants.NewPool(t.config.GoroutineSize)
 	return t
@@ -138,17 +148,46 @@ func (t *trigger) getClient() client.EventClient {
 	return t.eventCli
 }
 
+// acquireClient returns the client and the function to release it after sending, the replaced client is closed
+// after all sends which acquired it are released.
+func (t *trigger) acquireClient() (client.EventClient, func()) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	refs := t.clientRefs
+	if refs == nil {
+		return t.eventCli, func() {}
+	}
+	refs.Add(1)
+	return t.eventCli, refs.Done
+}
+
+func closeClient(cli client.EventClient, refs *sync.WaitGroup) {
+	if refs != nil {
+		refs.Wait()
+	}
+	_ = cli.Close()
+}
+
 func (t *trigger) changeTarget(sink primitive.URI,
 	protocol primitive.Protocol,
 	credential primitive.SinkCredential,
+	setting *primitive.ProtocolSetting,
 ) error {
-	eventCli := newEventClient(sink, protocol, credential)
+	eventCli := newEventClient(sink, protocol, credential, setting)
 	t.lock.Lock()
 	defer t.lock.Unlock()
+	if t.eventCli != nil {
+		// the in-flight sends keep using the old client until they are done.
+		go closeClient(t.eventCli, t.clientRefs)
+	}
 	t.eventCli = eventCli
+	t.clientRefs = &sync.WaitGroup{}
 	t.subscription.Sink = sink
 	t.subscription.Protocol = protocol
 	t.subscription.SinkCredential = credential
+	t.subscription.ProtocolSetting = setting
+	// the new sink deserves a fresh start.
+	t.breaker.Reset()
 	return nil
 }
 
@@ -192,6 +231,16 @@ func (t *trigger) changeConfig(config primitive.SubscriptionConfig) {
 	if config.GetMaxRetryAttempts() != t.subscription.Config.GetMaxRetryAttempts() {
 		t.applyOptions(WithMaxRetryAttempts(config.GetMaxRetryAttempts()))
 	}
+	if !reflect.DeepEqual(config.RetryPolicy, t.subscription.Config.RetryPolicy) {
+		t.applyOptions(WithRetryPolicy(config.RetryPolicy))
+	}
+	if config.OrderedKey != t.config.OrderedKey {
+		t.applyOptions(WithOrderedKey(config.OrderedKey))
+	}
+	// the channels of ordered events are made by Init, checked by Change.
+	if config.OrderedEvent != t.config.Ordered {
+		t.applyOptions(WithOrdered(config.OrderedEvent))
+	}
 	t.subscription.Config = config
 }
 
@@ -223,19 +272,56 @@ func (t *trigger) transformEvent(record info.EventRecord) (*toSendEvent, error)
 }
 
 func (t *trigger) sendEvent(ctx context.Context, events ...*ce.Event) client.Result {
+	probe, err := t.breaker.Acquire(ctx)
+	if err != nil {
+		return client.DeliveryTimeout
+	}
 	timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
 	defer cancel()
 	t.rateLimiter.Take()
+	t.backpressure.Take()
 	startTime := time.Now()
-	r := t.getClient().Send(timeoutCtx, events...)
+	cli, release := t.acquireClient()
+	r := cli.Send(timeoutCtx, events...)
+	release()
+	t.breaker.Done(probe, !isSinkFailure(r))
 	if r == client.Success {
 		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+		t.backpressure.Recover()
+	} else if r.IsBackpressure() {
+		t.backpressure.Throttle(int(t.getConfig().RateLimit))
+		log.Debug(ctx, "sink is pushing back, lower the rate", map[string]interface{}{
+			log.KeySubscriptionID: t.subscription.ID,
+			"code":                r.StatusCode,
+			"rate":                t.backpressure.Rate(),
+		})
 	}
 	return r
 }
 
+func (t *trigger) onCircuitBreakerStateChange(from, to primitive.CircuitBreakerState) {
+	var v float64
+	switch to {
+	case primitive.CircuitBreakerOpen:
+		v = 1
+	case primitive.CircuitBreakerHalfOpen:
+		v = 2
+	}
+	metrics.TriggerCircuitBreakerState.WithLabelValues(t.subscriptionIDStr).Set(v)
+	metrics.TriggerCircuitBreakerTransitionCounter.WithLabelValues(t.subscriptionIDStr, string(to)).Inc()
+	log.Info(context.Background(), "circuit breaker state changed", map[string]interface{}{
+		log.KeySubscriptionID: t.subscription.ID,
+		"from":                from,
+		"to":                  to,
+	})
+}
+
 func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 	for {
+		// stop reading while sink is down.
+		if t.breaker.Wait(ctx) != nil {
+			return
+		}
 		select {
 		case <-ctx.Done():
 			return
@@ -282,6 +368,10 @@ func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 
 func (t *trigger) runEventFilterTransform(ctx context.Context) {
 	for {
+		// stop reading while sink is down.
+		if t.breaker.Wait(ctx) != nil {
+			return
+		}
 		select {
 		case <-ctx.Done():
 			return
@@ -290,24 +380,13 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 				return
 			}
 			t.offsetManager.EventReceive(record.OffsetInfo)
+			if t.config.Ordered {
+				t.dispatchOrderedEvent(ctx, record)
+				continue
+			}
 			_ = t.pool.Submit(func() {
-				startTime := time.Now()
-				res := filter.Run(t.getFilter(), *record.Event)
-				metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
-				if res == filter.FailFilter {
-					t.offsetManager.EventCommit(record.OffsetInfo)
-					return
-				}
-				metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
-				event, err := t.transformEvent(record)
-				if err != nil {
-					log.Info(ctx, "event transform error", map[string]interface{}{
-						log.KeyError:   err,
-						"event_id":     event.record.Event.ID(),
-						"event_offset": event.record.OffsetInfo,
-					})
-					t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
-					t.offsetManager.EventCommit(record.OffsetInfo)
+				event, ok := t.filterTransformEvent(ctx, record)
+				if !ok {
 					return
 				}
 				t.sendCh <- event
@@ -316,6 +395,30 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 	}
 }
 
+// filterTransformEvent returns false if the event doesn't need to be sent, and its offset has been committed.
+func (t *trigger) filterTransformEvent(ctx context.Context, record info.EventRecord) (*toSendEvent, bool) {
+	startTime := time.Now()
+	res := filter.Run(t.getFilter(), *record.Event)
+	metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+	if res == filter.FailFilter {
+		t.offsetManager.EventCommit(record.OffsetInfo)
+		return nil, false
+	}
+	metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+	event, err := t.transformEvent(record)
+	if err != nil {
+		log.Info(ctx, "event transform error", map[string]interface{}{
+			log.KeyError:   err,
+			"event_id":     record.Event.ID(),
+			"event_offset": record.OffsetInfo,
+		})
+		t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
+		t.offsetManager.EventCommit(record.OffsetInfo)
+		return nil, false
+	}
+	return event, true
+}
+
 func (t *trigger) runEventToBatch(ctx context.Context) {
 	var events []*toSendEvent
 	ticker := time.NewTicker(500 * time.Millisecond) ////nolint:gomnd
@@ -360,13 +463,9 @@ func (t *trigger) runEventSend(ctx context.Context) {
 			if !ok {
 				return
 			}
-			if t.config.Ordered {
+			_ = t.pool.Submit(func() {
 				t.processEvent(ctx, events...)
-			} else {
-				_ = t.pool.Submit(func() {
-					t.processEvent(ctx, events...)
-				})
-			}
+			})
 		}
 	}
 }
@@ -393,13 +492,8 @@ func (t *trigger) processEvent(ctx context.Context, events ...*toSendEvent) {
 			"event_id":     events[0].record.Event.ID(),
 			"event_offset": events[0].record.OffsetInfo,
 		})
-		code := r.StatusCode
-		if t.config.Ordered {
-			// todo retry util success, now ordered event no need retry direct into dead letter
-			code = OrderEventCode
-		}
 		for _, event := range events {
-			t.writeFailEvent(ctx, event.record.Event, code, r.Err)
+			t.writeFailResult(ctx, event.record.Event, r)
 		}
 	} else {
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelSuccess).
@@ -415,7 +509,11 @@ func (t *trigger) processEvent(ctx context.Context, events ...*toSendEvent) {
 }
 
 func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err error) {
-	needRetry, reason := isShouldRetry(code)
+	t.writeFailResult(ctx, e, client.Result{StatusCode: code, Err: err})
+}
+
+func (t *trigger) writeFailResult(ctx context.Context, e *ce.Event, r client.Result) {
+	needRetry, reason := shouldRetry(t.getConfig().RetryPolicy, r.StatusCode)
 	ec, _ := e.Context.(*ce.EventContextV1)
 	if ec.Extensions == nil {
 		ec.Extensions = make(map[string]interface{})
@@ -442,20 +540,20 @@ func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err
 		if t.dlEventWriter == nil {
 			return
 		}
-		t.writeEventToDeadLetter(ctx, e, reason, err.Error())
+		t.writeEventToDeadLetter(ctx, e, reason, r.Err.Error())
 		metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 		return
 	}
 	// retry
-	t.writeEventToRetry(ctx, e, attempts)
+	t.writeEventToRetry(ctx, e, attempts, r.RetryAfter)
 	metrics.TriggerRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 }
 
-func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts int32) {
+func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts int32, retryAfter time.Duration) {
 	ec, _ := e.Context.(*ce.EventContextV1)
 	attempts++
 	ec.Extensions[primitive.XVanusRetryAttempts] = attempts
-	delayTime := calDeliveryTime(attempts)
+	delayTime := withRetryAfter(calRetryDelay(t.getConfig().RetryPolicy, attempts), retryAfter)
 	ec.Extensions[primitive.XVanusDeliveryTime] =
 		ce.Timestamp{Time: time.Now().Add(delayTime).UTC()}.Format(time.RFC3339)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
@@ -555,7 +653,9 @@ func getOffset(sub *primitive.Subscription) map[vanus.ID]uint64 {
 }
 
 func (t *trigger) Init(ctx context.Context) error {
-	t.eventCli = newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential)
+	t.eventCli = newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential,
+		t.subscription.ProtocolSetting)
+	t.clientRefs = &sync.WaitGroup{}
 	t.client = eb.Connect(t.config.Controllers)
 
 	t.timerEventWriter = t.client.Eventbus(ctx, api.WithID(t.subscription.TimerEventbusID.Uint64())).Writer()
@@ -566,6 +666,12 @@ func (t *trigger) Init(ctx context.Context) error {
 	t.sendCh = make(chan *toSendEvent, t.config.BufferSize)
 	t.batchSendCh = make(chan []*toSendEvent, t.config.BufferSize)
 	t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
+	if t.config.Ordered {
+		t.orderedEventChs = make([]chan info.EventRecord, t.config.OrderedConcurrency)
+		for i := range t.orderedEventChs {
+			t.orderedEventChs[i] = make(chan info.EventRecord, t.config.BufferSize)
+		}
+	}
 	t.retryEventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.retryEventReader = reader.NewReader(t.getRetryEventReaderConfig(), t.retryEventCh)
 	return nil
@@ -592,6 +698,12 @@ func (t *trigger) Start(ctx context.Context) error {
 	t.wg.StartWithContext(ctx, t.runEventToBatch)
 	t.wg.StartWithContext(ctx, t.runEventSend)
 	t.wg.StartWithContext(ctx, t.runRetryEventFilterTransform)
+	for i := range t.orderedEventChs {
+		ch := t.orderedEventChs[i]
+		t.wg.StartWithContext(ctx, func(ctx context.Context) {
+			t.runOrderedEventSend(ctx, ch)
+		})
+	}
 	t.state = TriggerRunning
 	log.Info(ctx, "trigger started", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -616,6 +728,7 @@ func (t *trigger) Stop(ctx context.Context) error {
 	t.wg.Wait()
 	t.pool.Release()
 	t.offsetManager.Close()
+	_ = t.getClient().Close()
 	t.state = TriggerStopped
 	log.Info(ctx, "trigger stopped", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -624,10 +737,16 @@ func (t *trigger) Stop(ctx context.Context) error {
 }
 
 func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscription) error {
+	if subscription.Config.OrderedEvent != t.getConfig().Ordered && t.state == TriggerRunning {
+		return errors.ErrResourceCanNotOp.WithMessage(
+			"can not switch ordered delivery of a running trigger, stop it first")
+	}
 	if t.subscription.Sink != subscription.Sink ||
 		t.subscription.Protocol != subscription.Protocol ||
-		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) {
-		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential)
+		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) ||
+		!reflect.DeepEqual(t.subscription.ProtocolSetting, subscription.ProtocolSetting) {
+		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential,
+			subscription.ProtocolSetting)
 		if err != nil {
 			return err
 		}
@@ -648,3 +767,7 @@ func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscripti
 func (t *trigger) GetOffsets(ctx context.Context) pInfo.ListOffsetInfo {
 	return t.offsetManager.GetCommit()
 }
+
+func (t *trigger) GetCircuitBreakerState() primitive.CircuitBreakerState {
+	return t.breaker.State()
+}
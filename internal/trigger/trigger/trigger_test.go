@@ -31,6 +31,7 @@ import (
 
 	eb "github.com/vanus-labs/vanus/client"
 	"github.com/vanus-labs/vanus/client/pkg/api"
+	"github.com/vanus-labs/vanus/pkg/errors"
 	"github.com/vanus-labs/vanus/proto/pkg/cloudevents"
 
 	"github.com/vanus-labs/vanus/internal/primitive"
@@ -304,5 +305,213 @@ func TestChangeSubscription(t *testing.T) {
 			})
 			So(err, ShouldBeNil)
 		})
+		Convey("change ordered config", func() {
+			err := tg.Change(ctx, &primitive.Subscription{
+				Config: primitive.SubscriptionConfig{OrderedEvent: true, OrderedKey: "subject"},
+			})
+			So(err, ShouldBeNil)
+			So(tg.getConfig().Ordered, ShouldBeTrue)
+			So(tg.getConfig().OrderedKey, ShouldEqual, "subject")
+
+			// the ordered delivery of a running trigger can't be switched
+			tg.state = TriggerRunning
+			err = tg.Change(ctx, &primitive.Subscription{
+				Config: primitive.SubscriptionConfig{OrderedKey: "type"},
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			err = tg.Change(ctx, &primitive.Subscription{
+				Config: primitive.SubscriptionConfig{OrderedEvent: true, OrderedKey: "type"},
+			})
+			So(err, ShouldBeNil)
+			So(tg.getConfig().OrderedKey, ShouldEqual, "type")
+		})
+	})
+}
+
+func TestTriggerChangeTargetWithInflightSend(t *testing.T) {
+	Convey("test change target while sending", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		tg := NewTrigger(makeSubscription(vanus.NewTestID()), WithControllers([]string{"test"})).(*trigger)
+		tg.eventCli = cli
+		tg.clientRefs = &sync.WaitGroup{}
+
+		sending := make(chan struct{})
+		done := make(chan struct{})
+		closed := make(chan struct{}, 1)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(ctx context.Context, events ...*ce.Event) client.Result {
+				close(sending)
+				<-done
+				return client.Success
+			})
+		cli.EXPECT().Close().Times(1).DoAndReturn(func() error {
+			closed <- struct{}{}
+			return nil
+		})
+
+		go tg.sendEvent(ctx, makeEventRecord("test").Event)
+		<-sending
+		So(tg.changeTarget("test_sink", primitive.HTTPProtocol, nil, nil), ShouldBeNil)
+		So(tg.getClient(), ShouldNotEqual, cli)
+
+		// the old client is closed after the in-flight send is done.
+		time.Sleep(100 * time.Millisecond)
+		So(len(closed), ShouldEqual, 0)
+		close(done)
+		<-closed
+	})
+}
+
+func TestTriggerOrderedEventSend(t *testing.T) {
+	Convey("test ordered event send", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrdered(true), WithOrderedKey("subject"), WithOrderedConcurrency(4)).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		So(len(tg.orderedEventChs), ShouldEqual, 4)
+
+		var (
+			lock     sync.Mutex
+			received = make(map[string][]string)
+			failed   = make(map[string]bool)
+		)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, events ...*ce.Event) client.Result {
+				lock.Lock()
+				defer lock.Unlock()
+				e := events[0]
+				// the first event of each key fails once.
+				if !failed[e.Subject()] {
+					failed[e.Subject()] = true
+					return client.Result{StatusCode: 500}
+				}
+				received[e.Subject()] = append(received[e.Subject()], e.ID())
+				return client.Success
+			})
+
+		keys := []string{"a", "b", "c"}
+		size := 5
+		for i := 0; i < size; i++ {
+			for _, key := range keys {
+				record := makeEventRecord("test")
+				record.Event.SetSubject(key)
+				record.Event.SetID(fmt.Sprintf("%s-%d", key, i))
+				_ = tg.eventArrived(ctx, record)
+			}
+		}
+		go tg.runEventFilterTransform(ctx)
+		for i := range tg.orderedEventChs {
+			go tg.runOrderedEventSend(ctx, tg.orderedEventChs[i])
+		}
+		time.Sleep(1500 * time.Millisecond)
+		lock.Lock()
+		defer lock.Unlock()
+		for _, key := range keys {
+			So(len(received[key]), ShouldEqual, size)
+			for i := 0; i < size; i++ {
+				So(received[key][i], ShouldEqual, fmt.Sprintf("%s-%d", key, i))
+			}
+		}
+	})
+}
+
+func TestTriggerOrderedEventMaxRetryAttempts(t *testing.T) {
+	Convey("test ordered event retries beyond max retry attempts", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrdered(true), WithOrderedConcurrency(1), WithMaxRetryAttempts(2),
+			WithRetryPolicy(&primitive.RetryPolicy{BackoffType: primitive.FixedBackoff, InitialDelay: 1}),
+		).(*trigger)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		tg.dlEventWriter = nil
+
+		var (
+			lock     sync.Mutex
+			sent     = make(map[string]int)
+			received []string
+		)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, events ...*ce.Event) client.Result {
+				lock.Lock()
+				defer lock.Unlock()
+				e := events[0]
+				sent[e.ID()]++
+				// the first event always fails.
+				if e.ID() == "0" {
+					return client.Result{StatusCode: 500, Err: fmt.Errorf("internal error")}
+				}
+				received = append(received, e.ID())
+				return client.Success
+			})
+
+		for i := 0; i < 2; i++ {
+			record := makeEventRecord("test")
+			record.Event.SetID(fmt.Sprintf("%d", i))
+			_ = tg.eventArrived(ctx, record)
+		}
+		go tg.runEventFilterTransform(ctx)
+		go tg.runOrderedEventSend(ctx, tg.orderedEventChs[0])
+		time.Sleep(500 * time.Millisecond)
+		lock.Lock()
+		defer lock.Unlock()
+		// the failed event isn't skipped after max retry attempts, so the following event of the key is blocked.
+		So(sent["0"], ShouldBeGreaterThan, 3)
+		So(received, ShouldBeEmpty)
+	})
+}
+
+func TestCalOrderedRetryDelay(t *testing.T) {
+	Convey("test cal ordered retry delay", t, func() {
+		So(calOrderedRetryDelay(nil, 1), ShouldEqual, time.Second)
+		So(calOrderedRetryDelay(nil, 10), ShouldEqual, orderedRetryMaxInterval)
+		policy := &primitive.RetryPolicy{BackoffType: primitive.ExponentialBackoff, InitialDelay: 1, Multiplier: 10}
+		So(calOrderedRetryDelay(policy, 1), ShouldEqual, orderedRetryMinInterval)
+		So(calOrderedRetryDelay(policy, 1000), ShouldEqual, orderedRetryMaxInterval)
+		policy.MaxDelay = 60000
+		So(calOrderedRetryDelay(policy, 1000), ShouldEqual, time.Minute)
+	})
+}
+
+func TestGetOrderedKey(t *testing.T) {
+	Convey("test get ordered key", t, func() {
+		e := makeEventRecord("test").Event
+		e.SetSubject("subject")
+		e.SetExtension("key", "value")
+		So(getOrderedKey(e, ""), ShouldEqual, "")
+		So(getOrderedKey(e, "subject"), ShouldEqual, "subject")
+		So(getOrderedKey(e, "type"), ShouldEqual, "test")
+		So(getOrderedKey(e, "key"), ShouldEqual, "value")
+		So(getOrderedKey(e, "unknown"), ShouldEqual, "")
 	})
 }
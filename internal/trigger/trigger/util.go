@@ -17,6 +17,7 @@ package trigger
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"net/http"
 	"strconv"
 	"time"
@@ -25,9 +26,14 @@ import (
 	"github.com/vanus-labs/vanus/internal/trigger/client"
 )
 
+// defaultMaxRetryDelay limits the delay of retry policy without max delay, it's the same as the max delay of the
+// default backoff curve.
+const defaultMaxRetryDelay = time.Hour
+
 func newEventClient(sink primitive.URI,
 	protocol primitive.Protocol,
-	credential primitive.SinkCredential) client.EventClient {
+	credential primitive.SinkCredential,
+	setting *primitive.ProtocolSetting) client.EventClient {
 	switch protocol {
 	case primitive.AwsLambdaProtocol:
 		_credential, _ := credential.(*primitive.AkSkSinkCredential)
@@ -36,23 +42,52 @@ func newEventClient(sink primitive.URI,
 		_credential, _ := credential.(*primitive.GCloudSinkCredential)
 		return client.NewGCloudFunctionClient(string(sink), _credential.CredentialJSON)
 	case primitive.GRPC:
-		return client.NewGRPCClient(string(sink))
+		return client.NewGRPCClient(string(sink), getClientOptions(credential, setting)...)
+	case primitive.KafkaProtocol:
+		return client.NewKafkaClient(string(sink), getClientOptions(credential, setting)...)
 	default:
-		return client.NewHTTPClient(string(sink))
+		return client.NewHTTPClient(string(sink), getClientOptions(credential, setting)...)
+	}
+}
+
+func getClientOptions(credential primitive.SinkCredential, setting *primitive.ProtocolSetting) []client.Option {
+	var opts []client.Option
+	if setting != nil && len(setting.Headers) > 0 {
+		opts = append(opts, client.WithHeaders(setting.Headers))
+	}
+	switch _credential := credential.(type) {
+	case *primitive.PlainSinkCredential:
+		opts = append(opts, client.WithBasicAuth(_credential.Identifier, _credential.Secret))
+	case *primitive.HTTPSinkCredential:
+		switch _credential.AuthType {
+		case primitive.HTTPAuthBasic:
+			opts = append(opts, client.WithBasicAuth(_credential.Username, _credential.Password))
+		case primitive.HTTPAuthBearer:
+			opts = append(opts, client.WithBearerToken(_credential.Token))
+		case primitive.HTTPAuthHMAC:
+			opts = append(opts, client.WithHMACSigning(_credential.HMACSecret))
+		}
+		if _credential.ClientCert != "" {
+			opts = append(opts, client.WithClientCert(_credential.ClientCert, _credential.ClientKey))
+		}
+		if _credential.CACert != "" {
+			opts = append(opts, client.WithCACert(_credential.CACert))
+		}
 	}
+	return opts
 }
 
 const (
-	OrderEventCode   = -1
 	ErrTransformCode = 1
 )
 
+// isShouldRetry decides by HTTP status code, the status code of gRPC sink is mapped to HTTP one by the client,
+// such as UNAVAILABLE to 503 and RESOURCE_EXHAUSTED to 429 which are retried, INVALID_ARGUMENT to 400
+// which is sent to dead letter.
 func isShouldRetry(statusCode int) (bool, string) {
 	switch {
 	case statusCode == ErrTransformCode:
 		return false, "TransformError"
-	case statusCode == OrderEventCode:
-		return false, "OrderEvent"
 	case statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError:
 		if statusCode == http.StatusTooManyRequests {
 			return true, ""
@@ -63,6 +98,64 @@ func isShouldRetry(statusCode int) (bool, string) {
 	}
 }
 
+// shouldRetry checks the status code with the status code lists of retry policy first.
+func shouldRetry(policy *primitive.RetryPolicy, statusCode int) (bool, string) {
+	if policy == nil || statusCode == ErrTransformCode {
+		return isShouldRetry(statusCode)
+	}
+	for _, code := range policy.NonRetriableCodes {
+		if int(code) == statusCode {
+			return false, fmt.Sprintf("Response%d", statusCode)
+		}
+	}
+	for _, code := range policy.RetriableCodes {
+		if int(code) == statusCode {
+			return true, ""
+		}
+	}
+	return isShouldRetry(statusCode)
+}
+
+// calRetryDelay calculates the delay of the attempts by retry policy, the default backoff curve
+// is used if policy isn't set. The delay is limited by the max delay of policy, or defaultMaxRetryDelay
+// if it isn't set, so that it never overflows.
+func calRetryDelay(policy *primitive.RetryPolicy, attempts int32) time.Duration {
+	if policy == nil {
+		return calDeliveryTime(attempts)
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+	initial := float64(policy.InitialDelay)
+	var delay float64
+	switch policy.BackoffType {
+	case primitive.FixedBackoff:
+		delay = initial
+	case primitive.LinearBackoff:
+		delay = initial + initial*policy.Multiplier*float64(attempts-1)
+	case primitive.ExponentialBackoff:
+		delay = initial * math.Pow(policy.Multiplier, float64(attempts-1))
+	default:
+		// the unknown backoff type is rejected by validation.
+		return calDeliveryTime(attempts)
+	}
+	maxDelay := float64(defaultMaxRetryDelay.Milliseconds())
+	if policy.MaxDelay > 0 {
+		maxDelay = float64(policy.MaxDelay)
+	}
+	// NaN and +Inf are limited too.
+	if !(delay <= maxDelay) {
+		delay = maxDelay
+	}
+	if policy.Jitter > 0 {
+		delay += delay * policy.Jitter * (rand.Float64()*2 - 1) //nolint:gosec // no need to be secure
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return time.Duration(delay) * time.Millisecond
+}
+
 func calDeliveryTime(attempts int32) time.Duration {
 	var v int
 	switch {
@@ -78,6 +171,18 @@ func calDeliveryTime(attempts int32) time.Duration {
 	return time.Duration(v) * time.Second
 }
 
+// withRetryAfter returns the longer one of the delay and the delay hinted by sink, the hint is limited
+// to maxRetryAfter in case of a misbehaving sink.
+func withRetryAfter(delay, retryAfter time.Duration) time.Duration {
+	if retryAfter > maxRetryAfter {
+		retryAfter = maxRetryAfter
+	}
+	if retryAfter > delay {
+		return retryAfter
+	}
+	return delay
+}
+
 func getRetryAttempts(attempts interface{}) (int32, error) {
 	switch v := attempts.(type) {
 	case int32:
@@ -27,12 +27,12 @@ func TestNewEventClient(t *testing.T) {
 	Convey("test new event client", t, func() {
 		Convey("new lambda client", func() {
 			cli := newEventClient("test", primitive.AwsLambdaProtocol,
-				primitive.NewAkSkSinkCredential("ak", "sk"))
+				primitive.NewAkSkSinkCredential("ak", "sk"), nil)
 			So(cli, ShouldNotBeNil)
 		})
 		Convey("new http client", func() {
 			cli := newEventClient("test", primitive.HTTPProtocol,
-				primitive.NewPlainSinkCredential("identifier", "secret"))
+				primitive.NewPlainSinkCredential("identifier", "secret"), nil)
 			So(cli, ShouldNotBeNil)
 		})
 	})
@@ -75,3 +75,92 @@ func TestCalDeliveryTime(t *testing.T) {
 		So(d, ShouldEqual, time.Second*3600)
 	})
 }
+
+func TestShouldRetry(t *testing.T) {
+	Convey("test should retry with retry policy", t, func() {
+		b, _ := shouldRetry(nil, 500)
+		So(b, ShouldBeTrue)
+		policy := &primitive.RetryPolicy{
+			RetriableCodes:    []int32{400, 500},
+			NonRetriableCodes: []int32{500, 503},
+		}
+		b, _ = shouldRetry(policy, 400)
+		So(b, ShouldBeTrue)
+		b, _ = shouldRetry(policy, 500)
+		So(b, ShouldBeFalse)
+		b, _ = shouldRetry(policy, 503)
+		So(b, ShouldBeFalse)
+		b, _ = shouldRetry(policy, 502)
+		So(b, ShouldBeTrue)
+		b, _ = shouldRetry(policy, 403)
+		So(b, ShouldBeFalse)
+		b, _ = shouldRetry(&primitive.RetryPolicy{RetriableCodes: []int32{ErrTransformCode}}, ErrTransformCode)
+		So(b, ShouldBeFalse)
+	})
+}
+
+func TestCalRetryDelay(t *testing.T) {
+	Convey("test cal retry delay", t, func() {
+		So(calRetryDelay(nil, 3), ShouldEqual, calDeliveryTime(3))
+		Convey("fixed", func() {
+			policy := &primitive.RetryPolicy{BackoffType: primitive.FixedBackoff, InitialDelay: 2000}
+			So(calRetryDelay(policy, 1), ShouldEqual, 2*time.Second)
+			So(calRetryDelay(policy, 10), ShouldEqual, 2*time.Second)
+		})
+		Convey("linear", func() {
+			policy := &primitive.RetryPolicy{
+				BackoffType: primitive.LinearBackoff, InitialDelay: 1000, Multiplier: 2, MaxDelay: 6000,
+			}
+			So(calRetryDelay(policy, 1), ShouldEqual, time.Second)
+			So(calRetryDelay(policy, 2), ShouldEqual, 3*time.Second)
+			So(calRetryDelay(policy, 3), ShouldEqual, 5*time.Second)
+			So(calRetryDelay(policy, 4), ShouldEqual, 6*time.Second)
+		})
+		Convey("exponential", func() {
+			policy := &primitive.RetryPolicy{
+				BackoffType: primitive.ExponentialBackoff, InitialDelay: 1000, Multiplier: 2, MaxDelay: 10000,
+			}
+			So(calRetryDelay(policy, 1), ShouldEqual, time.Second)
+			So(calRetryDelay(policy, 2), ShouldEqual, 2*time.Second)
+			So(calRetryDelay(policy, 4), ShouldEqual, 8*time.Second)
+			So(calRetryDelay(policy, 5), ShouldEqual, 10*time.Second)
+		})
+		Convey("exponential without max delay", func() {
+			policy := &primitive.RetryPolicy{
+				BackoffType: primitive.ExponentialBackoff, InitialDelay: 1000, Multiplier: 10,
+			}
+			So(calRetryDelay(policy, 3), ShouldEqual, 100*time.Second)
+			So(calRetryDelay(policy, 30), ShouldEqual, defaultMaxRetryDelay)
+			So(calRetryDelay(policy, 1000), ShouldEqual, defaultMaxRetryDelay)
+			policy.Jitter = 0.5
+			for i := 0; i < 100; i++ {
+				d := calRetryDelay(policy, 1000)
+				So(d, ShouldBeBetweenOrEqual, defaultMaxRetryDelay/2, defaultMaxRetryDelay*3/2)
+			}
+		})
+		Convey("unknown backoff type", func() {
+			policy := &primitive.RetryPolicy{BackoffType: "unknown", InitialDelay: 2000}
+			So(calRetryDelay(policy, 3), ShouldEqual, calDeliveryTime(3))
+			So(policy.BackoffType.IsValid(), ShouldBeFalse)
+			So(primitive.ExponentialBackoff.IsValid(), ShouldBeTrue)
+		})
+		Convey("jitter", func() {
+			policy := &primitive.RetryPolicy{
+				BackoffType: primitive.FixedBackoff, InitialDelay: 1000, Jitter: 0.5,
+			}
+			for i := 0; i < 100; i++ {
+				d := calRetryDelay(policy, 1)
+				So(d, ShouldBeBetweenOrEqual, 500*time.Millisecond, 1500*time.Millisecond)
+			}
+		})
+	})
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	Convey("test with retry after", t, func() {
+		So(withRetryAfter(time.Second, 0), ShouldEqual, time.Second)
+		So(withRetryAfter(time.Second, 10*time.Second), ShouldEqual, 10*time.Second)
+		So(withRetryAfter(time.Minute, 10*time.Second), ShouldEqual, time.Minute)
+		So(withRetryAfter(time.Second, 2*maxRetryAfter), ShouldEqual, maxRetryAfter)
+	})
+}
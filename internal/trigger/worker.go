@@ -245,6 +245,8 @@ func (w *worker) getAllSubscriptionInfo(ctx context.Context) []*metapb.Subscript
 		subInfos = append(subInfos, &metapb.SubscriptionInfo{
 			SubscriptionId: uint64(id),
 			Offsets:        convert.ToPbOffsetInfos(t.GetOffsets(ctx)),
+
+			CircuitBreakerState: convert.ToPbCircuitBreakerState(t.GetCircuitBreakerState()),
 		})
 	}
 	return subInfos
@@ -258,6 +260,8 @@ func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigg
 		trigger.WithMaxRetryAttempts(config.GetMaxRetryAttempts()),
 		trigger.WithDisableDeadLetter(config.DisableDeadLetter),
 		trigger.WithOrdered(config.OrderedEvent),
+		trigger.WithOrderedKey(config.OrderedKey),
+		trigger.WithRetryPolicy(config.RetryPolicy),
 		trigger.WithGoroutineSize(w.config.SendEventGoroutineSize),
 		trigger.WithSendBatchSize(w.config.SendEventBatchSize),
 		trigger.WithPullBatchSize(w.config.PullEventBatchSize),
@@ -183,6 +183,7 @@ func TestWorker_Stop(t *testing.T) {
 		tg.EXPECT().Stop(gomock.Any()).AnyTimes().Return(nil)
 		offsets := info.ListOffsetInfo{{EventlogID: vanus.NewTestID(), Offset: uint64(100)}}
 		tg.EXPECT().GetOffsets(gomock.Any()).AnyTimes().Return(offsets)
+		tg.EXPECT().GetCircuitBreakerState().AnyTimes().Return(primitive.CircuitBreakerClosed)
 		triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Return(nil, nil)
 		err = m.Stop(ctx)
 		So(err, ShouldBeNil)
@@ -24,6 +24,7 @@ const (
 	KeyEventlogID        = "eventlog_id"
 	KeyEventbusName      = "eventbus_name"
 	KeyEventbusID        = "eventbus_id"
+	KeyNamespace         = "namespace"
 	KeyOffsetInLog       = "offset_in_log"
 	KeySubscriptionID    = "subscription_id"
 	KeyTriggerWorkerAddr = "trigger_worker_addr"
@@ -32,6 +32,25 @@ var (
 		Name:      "eventbus_number_total",
 	})
 
+	NamespaceEventbusGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "namespace_eventbus_number",
+	}, []string{LabelNamespace})
+
+	NamespaceRetainedBytesGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "namespace_retained_bytes",
+	}, []string{LabelNamespace})
+
+	NamespaceQuotaExceededCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "namespace_quota_exceeded_total",
+		Help:      "The number of create requests rejected by namespace quota",
+	}, []string{LabelNamespace, LabelQuota})
+
 	EventbusUpdatedGauge = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfController,
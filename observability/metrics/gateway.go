@@ -57,4 +57,18 @@ var (
 			0.999:  0.0001,
 			0.9999: 0.00001},
 	}, []string{LabelEventbus, LabelProtocol, LabelBatchSize})
+
+	GatewayAuthRejectedCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "auth_rejected_total",
+		Help:      "The number of requests rejected by eventbus auth",
+	}, []string{LabelEventbus, LabelAuthType, LabelResponseCode})
+
+	GatewayQuotaRejectedCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "quota_rejected_total",
+		Help:      "The number of events rejected by namespace quota",
+	}, []string{LabelNamespace, LabelQuota})
 )
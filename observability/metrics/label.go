@@ -24,24 +24,31 @@ const (
 	LabelProtocol      = "protocol"
 	LabelBatchSize     = "batch_size"
 	LabelResponseCode  = "response_code"
+	LabelAuthType      = "auth_type"
+	LabelNamespace     = "namespace"
+	LabelQuota         = "quota"
 
 	LabelTriggerWorker = "trigger_worker"
 	LabelTrigger       = "trigger"
 	LabelResult        = "result"
 	LabelBlock         = "block"
+	LabelState         = "state"
+	LabelCompression   = "compression"
 
 	LabelTimer = "timer"
 )
 
 const (
-	LabelOperationResult                   = "op_result"
-	LabelSuccess                           = "success"
-	LabelFailed                            = "failed"
-	LabelSegmentDeletedBecauseExpired      = "segment_expired"
-	LabelSegmentDeletedBecauseCreateFailed = "segment_create_failed"
-	LabelSegmentDeletedBecauseDeleted      = "segment_deleted"
-	LabelValueProtocolHTTP                 = "http"
-	LabelValueProtocolGRPC                 = "grpc"
+	LabelOperationResult                     = "op_result"
+	LabelSuccess                             = "success"
+	LabelFailed                              = "failed"
+	LabelSegmentDeletedBecauseExpired        = "segment_expired"
+	LabelSegmentDeletedBecauseCreateFailed   = "segment_create_failed"
+	LabelSegmentDeletedBecauseDeleted        = "segment_deleted"
+	LabelSegmentDeletedBecauseExceededBytes  = "segment_exceeded_max_bytes"
+	LabelSegmentDeletedBecauseExceededEvents = "segment_exceeded_max_events"
+	LabelValueProtocolHTTP                   = "http"
+	LabelValueProtocolGRPC                   = "grpc"
 )
 
 const (
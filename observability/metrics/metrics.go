@@ -70,6 +70,9 @@ func GetControllerMetrics() []prometheus.Collector {
 	coll := []prometheus.Collector{
 		ControllerLeaderGaugeVec,
 		EventbusGauge,
+		NamespaceEventbusGaugeVec,
+		NamespaceRetainedBytesGaugeVec,
+		NamespaceQuotaExceededCounterVec,
 		EventbusUpdatedGauge,
 		EventbusDeletedGauge,
 		EventlogGaugeVec,
@@ -92,6 +95,8 @@ func GetGatewayMetrics() []prometheus.Collector {
 		GatewayEventReceivedCountVec,
 		// GatewayEventWriteLatencyHistogramVec,
 		GatewayEventWriteLatencySummaryVec,
+		GatewayAuthRejectedCounterVec,
+		GatewayQuotaRejectedCounterVec,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
 }
@@ -110,6 +115,8 @@ func GetTriggerMetrics() []prometheus.Collector {
 		TriggerDeadLetterEventAppendSecond,
 		TriggerPushEventCounter,
 		TriggerPushEventTime,
+		TriggerCircuitBreakerState,
+		TriggerCircuitBreakerTransitionCounter,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
 }
@@ -134,6 +141,9 @@ func GetSegmentServerMetrics() []prometheus.Collector {
 		WriteTPSCounterVec,
 		ReadTPSCounterVec,
 		ReadThroughputCounterVec,
+		CompressionInputByteCounterVec,
+		CompressionOutputByteCounterVec,
+		DecompressionFailureCounterVec,
 	}
 	return append(coll, getGoRuntimeMetrics()...)
 }
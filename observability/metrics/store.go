@@ -47,6 +47,28 @@ var (
 		Help:      "Total bytes for reading",
 	}, []string{LabelVolume, LabelBlock})
 
+	// The compression ratio is CompressionOutputByteCounterVec / CompressionInputByteCounterVec.
+	CompressionInputByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "compression_input_byte_count",
+		Help:      "Total bytes of event data before compression",
+	}, []string{LabelCompression})
+
+	CompressionOutputByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "compression_output_byte_count",
+		Help:      "Total bytes of event data after compression",
+	}, []string{LabelCompression})
+
+	DecompressionFailureCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "decompression_failure_count",
+		Help:      "Total entries whose data is corrupted and can't be decompressed",
+	}, []string{LabelCompression})
+
 	WALEntryWriteCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfSegmentServer,
@@ -102,4 +102,18 @@ var (
 		Name:      "push_event_rt",
 		Help:      "The rt of trigger push event",
 	}, []string{LabelTrigger})
+
+	TriggerCircuitBreakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "circuit_breaker_state",
+		Help:      "The state of trigger circuit breaker, 0 closed, 1 open, 2 half-open",
+	}, []string{LabelTrigger})
+
+	TriggerCircuitBreakerTransitionCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "circuit_breaker_transition_number",
+		Help:      "The number of trigger circuit breaker transitions to the state",
+	}, []string{LabelTrigger, LabelState})
 )
@@ -54,6 +54,7 @@ type EventbusService interface {
 	Delete(ctx context.Context, id uint64) error
 	GetSystemEventbusByName(ctx context.Context, name string) (*meta.Eventbus, error)
 	GetEventbus(ctx context.Context, id uint64) (*meta.Eventbus, error)
+	GetNamespace(ctx context.Context, name string) (*meta.Namespace, error)
 	RawClient() ctrlpb.EventbusControllerClient
 }
 
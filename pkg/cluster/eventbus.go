@@ -11,6 +11,7 @@ import (
 
 var (
 	systemEventbusPrefix          = "__"
+	systemNamespace               = "system"
 	defaultSystemEventbusEventlog = 1
 )
 
@@ -20,7 +21,7 @@ type eventbusService struct {
 
 func (es *eventbusService) GetSystemEventbusByName(ctx context.Context, name string) (*meta.Eventbus, error) {
 	return es.client.GetEventbusWithHumanFriendly(ctx, &ctrlpb.GetEventbusWithHumanFriendlyRequest{
-		Namespace:    "system",
+		Namespace:    systemNamespace,
 		EventbusName: name,
 	})
 }
@@ -29,9 +30,13 @@ func (es *eventbusService) GetEventbus(ctx context.Context, id uint64) (*meta.Ev
 	return es.client.GetEventbus(ctx, wrapperspb.UInt64(id))
 }
 
+func (es *eventbusService) GetNamespace(ctx context.Context, name string) (*meta.Namespace, error) {
+	return es.client.GetNamespace(ctx, &ctrlpb.GetNamespaceRequest{Name: name})
+}
+
 func (es *eventbusService) IsSystemEventbusExistByName(ctx context.Context, name string) bool {
 	_, err := es.client.GetEventbusWithHumanFriendly(ctx, &ctrlpb.GetEventbusWithHumanFriendlyRequest{
-		Namespace:    "system",
+		Namespace:    systemNamespace,
 		EventbusName: name,
 	})
 	return err == nil
@@ -55,6 +60,7 @@ func (es *eventbusService) CreateSystemEventbusIfNotExist(ctx context.Context, n
 		Name:        name,
 		LogNumber:   int32(defaultSystemEventbusEventlog),
 		Description: desc,
+		Namespace:   systemNamespace,
 	})
 }
 
@@ -216,6 +216,21 @@ func (mr *MockEventbusServiceMockRecorder) GetEventbus(ctx, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbus", reflect.TypeOf((*MockEventbusService)(nil).GetEventbus), ctx, id)
 }
 
+// GetNamespace mocks base method.
+func (m *MockEventbusService) GetNamespace(ctx context.Context, name string) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespace", ctx, name)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespace indicates an expected call of GetNamespace.
+func (mr *MockEventbusServiceMockRecorder) GetNamespace(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockEventbusService)(nil).GetNamespace), ctx, name)
+}
+
 // GetSystemEventbusByName mocks base method.
 func (m *MockEventbusService) GetSystemEventbusByName(ctx context.Context, name string) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -108,9 +108,90 @@ func (ec *eventbusClient) UpdateEventbus(
 	return out, nil
 }
 
+func (ec *eventbusClient) SetEventbusAuth(
+	ctx context.Context, in *ctrlpb.SetEventbusAuthRequest, opts ...grpc.CallOption,
+) (*metapb.Eventbus, error) {
+	out := new(metapb.Eventbus)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/SetEventbusAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetEventbusAuth(
+	ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption,
+) (*metapb.EventbusAuth, error) {
+	out := new(metapb.EventbusAuth)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/GetEventbusAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (ec *eventbusClient) GetEventbusWithHumanFriendly(
 	ctx context.Context, in *ctrlpb.GetEventbusWithHumanFriendlyRequest, opts ...grpc.CallOption,
 ) (*metapb.Eventbus, error) {
-	// TODO
-	return nil, nil
+	out := new(metapb.Eventbus)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/GetEventbusWithHumanFriendly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CreateNamespace(
+	ctx context.Context, in *ctrlpb.CreateNamespaceRequest, opts ...grpc.CallOption,
+) (*metapb.Namespace, error) {
+	out := new(metapb.Namespace)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/CreateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteNamespace(
+	ctx context.Context, in *ctrlpb.DeleteNamespaceRequest, opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListNamespace(
+	ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption,
+) (*ctrlpb.ListNamespaceResponse, error) {
+	out := new(ctrlpb.ListNamespaceResponse)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/ListNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetNamespace(
+	ctx context.Context, in *ctrlpb.GetNamespaceRequest, opts ...grpc.CallOption,
+) (*metapb.Namespace, error) {
+	out := new(metapb.Namespace)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/GetNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) SetNamespaceQuota(
+	ctx context.Context, in *ctrlpb.SetNamespaceQuotaRequest, opts ...grpc.CallOption,
+) (*metapb.Namespace, error) {
+	out := new(metapb.Namespace)
+	err := ec.cc.invoke(ctx, "/vanus.core.controller.EventbusController/SetNamespaceQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
@@ -19,6 +19,7 @@ import (
 	"io"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 )
@@ -60,3 +61,25 @@ func (elc *eventlogClient) GetAppendableSegment(
 	}
 	return out, nil
 }
+
+func (elc *eventlogClient) ListSegmentReplication(
+	ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption,
+) (*ctrlpb.ListSegmentReplicationResponse, error) {
+	out := new(ctrlpb.ListSegmentReplicationResponse)
+	err := elc.cc.invoke(ctx, "/vanus.core.controller.EventlogController/ListSegmentReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (elc *eventlogClient) RebalanceLeaders(
+	ctx context.Context, in *ctrlpb.RebalanceLeadersRequest, opts ...grpc.CallOption,
+) (*ctrlpb.RebalanceLeadersResponse, error) {
+	out := new(ctrlpb.RebalanceLeadersResponse)
+	err := elc.cc.invoke(ctx, "/vanus.core.controller.EventlogController/RebalanceLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
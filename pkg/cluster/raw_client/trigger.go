@@ -224,6 +224,17 @@ func (tc *triggerClient) CommitOffset(
 	return out, nil
 }
 
+func (tc *triggerClient) AcquirePullLease(
+	ctx context.Context, in *ctrlpb.AcquirePullLeaseRequest, opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := tc.cc.invoke(ctx, "/vanus.core.controller.TriggerController/AcquirePullLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) DisableSubscription(
 	ctx context.Context, in *ctrlpb.DisableSubscriptionRequest, opts ...grpc.CallOption,
 ) (*emptypb.Empty, error) {
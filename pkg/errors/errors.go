@@ -86,6 +86,7 @@ const (
 	// ErrorCode_OTHERS 99xx
 	ErrorCode_RESOURCE_EXHAUSTED  ErrorCode = 9901
 	ErrorCode_RESOURCE_CAN_NOT_OP ErrorCode = 9902
+	ErrorCode_QUOTA_EXCEEDED      ErrorCode = 9903
 )
 
 var (
@@ -155,6 +156,7 @@ var (
 
 	// RESOURCE_EXHAUSTED
 	ErrNoAvailableEventlog = New("no eventlog available").WithGRPCCode(ErrorCode_RESOURCE_EXHAUSTED)
+	ErrQuotaExceeded       = New("quota exceeded").WithGRPCCode(ErrorCode_QUOTA_EXCEEDED)
 
 	// NO_MORE_MESSAGE
 
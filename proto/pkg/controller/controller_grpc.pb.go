@@ -133,6 +133,13 @@ const (
 	EventbusController_ListEventbus_FullMethodName                 = "/vanus.core.controller.EventbusController/ListEventbus"
 	EventbusController_UpdateEventbus_FullMethodName               = "/vanus.core.controller.EventbusController/UpdateEventbus"
 	EventbusController_GetEventbusWithHumanFriendly_FullMethodName = "/vanus.core.controller.EventbusController/GetEventbusWithHumanFriendly"
+	EventbusController_SetEventbusAuth_FullMethodName              = "/vanus.core.controller.EventbusController/SetEventbusAuth"
+	EventbusController_GetEventbusAuth_FullMethodName              = "/vanus.core.controller.EventbusController/GetEventbusAuth"
+	EventbusController_CreateNamespace_FullMethodName              = "/vanus.core.controller.EventbusController/CreateNamespace"
+	EventbusController_DeleteNamespace_FullMethodName              = "/vanus.core.controller.EventbusController/DeleteNamespace"
+	EventbusController_ListNamespace_FullMethodName                = "/vanus.core.controller.EventbusController/ListNamespace"
+	EventbusController_GetNamespace_FullMethodName                 = "/vanus.core.controller.EventbusController/GetNamespace"
+	EventbusController_SetNamespaceQuota_FullMethodName            = "/vanus.core.controller.EventbusController/SetNamespaceQuota"
 )
 
 // EventbusControllerClient is the client API for EventbusController service.
@@ -147,6 +154,16 @@ type EventbusControllerClient interface {
 	ListEventbus(ctx context.Context, in *ListEventbusRequest, opts ...grpc.CallOption) (*ListEventbusResponse, error)
 	UpdateEventbus(ctx context.Context, in *UpdateEventbusRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
 	GetEventbusWithHumanFriendly(ctx context.Context, in *GetEventbusWithHumanFriendlyRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
+	SetEventbusAuth(ctx context.Context, in *SetEventbusAuthRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
+	// GetEventbusAuth returns the auth of eventbus with secrets, it's used by gateway to verify requests.
+	GetEventbusAuth(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*meta.EventbusAuth, error)
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
+	// DeleteNamespace deletes an empty namespace, the default and system namespaces can't be deleted.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNamespaceResponse, error)
+	// GetNamespace returns the namespace with its quota and usage.
+	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
+	SetNamespaceQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
 }
 
 type eventbusControllerClient struct {
@@ -220,6 +237,69 @@ func (c *eventbusControllerClient) GetEventbusWithHumanFriendly(ctx context.Cont
 	return out, nil
 }
 
+func (c *eventbusControllerClient) SetEventbusAuth(ctx context.Context, in *SetEventbusAuthRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
+	out := new(meta.Eventbus)
+	err := c.cc.Invoke(ctx, EventbusController_SetEventbusAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) GetEventbusAuth(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*meta.EventbusAuth, error) {
+	out := new(meta.EventbusAuth)
+	err := c.cc.Invoke(ctx, EventbusController_GetEventbusAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, EventbusController_CreateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventbusController_DeleteNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) ListNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNamespaceResponse, error) {
+	out := new(ListNamespaceResponse)
+	err := c.cc.Invoke(ctx, EventbusController_ListNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, EventbusController_GetNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventbusControllerClient) SetNamespaceQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, EventbusController_SetNamespaceQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventbusControllerServer is the server API for EventbusController service.
 // All implementations should embed UnimplementedEventbusControllerServer
 // for forward compatibility
@@ -232,6 +312,16 @@ type EventbusControllerServer interface {
 	ListEventbus(context.Context, *ListEventbusRequest) (*ListEventbusResponse, error)
 	UpdateEventbus(context.Context, *UpdateEventbusRequest) (*meta.Eventbus, error)
 	GetEventbusWithHumanFriendly(context.Context, *GetEventbusWithHumanFriendlyRequest) (*meta.Eventbus, error)
+	SetEventbusAuth(context.Context, *SetEventbusAuthRequest) (*meta.Eventbus, error)
+	// GetEventbusAuth returns the auth of eventbus with secrets, it's used by gateway to verify requests.
+	GetEventbusAuth(context.Context, *wrapperspb.UInt64Value) (*meta.EventbusAuth, error)
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*meta.Namespace, error)
+	// DeleteNamespace deletes an empty namespace, the default and system namespaces can't be deleted.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*emptypb.Empty, error)
+	ListNamespace(context.Context, *emptypb.Empty) (*ListNamespaceResponse, error)
+	// GetNamespace returns the namespace with its quota and usage.
+	GetNamespace(context.Context, *GetNamespaceRequest) (*meta.Namespace, error)
+	SetNamespaceQuota(context.Context, *SetNamespaceQuotaRequest) (*meta.Namespace, error)
 }
 
 // UnimplementedEventbusControllerServer should be embedded to have forward compatible implementations.
@@ -259,6 +349,27 @@ func (UnimplementedEventbusControllerServer) UpdateEventbus(context.Context, *Up
 func (UnimplementedEventbusControllerServer) GetEventbusWithHumanFriendly(context.Context, *GetEventbusWithHumanFriendlyRequest) (*meta.Eventbus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventbusWithHumanFriendly not implemented")
 }
+func (UnimplementedEventbusControllerServer) SetEventbusAuth(context.Context, *SetEventbusAuthRequest) (*meta.Eventbus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventbusAuth not implemented")
+}
+func (UnimplementedEventbusControllerServer) GetEventbusAuth(context.Context, *wrapperspb.UInt64Value) (*meta.EventbusAuth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventbusAuth not implemented")
+}
+func (UnimplementedEventbusControllerServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedEventbusControllerServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedEventbusControllerServer) ListNamespace(context.Context, *emptypb.Empty) (*ListNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespace not implemented")
+}
+func (UnimplementedEventbusControllerServer) GetNamespace(context.Context, *GetNamespaceRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
+}
+func (UnimplementedEventbusControllerServer) SetNamespaceQuota(context.Context, *SetNamespaceQuotaRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceQuota not implemented")
+}
 
 // UnsafeEventbusControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EventbusControllerServer will
@@ -397,6 +508,132 @@ func _EventbusController_GetEventbusWithHumanFriendly_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventbusController_SetEventbusAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventbusAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).SetEventbusAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_SetEventbusAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).SetEventbusAuth(ctx, req.(*SetEventbusAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_GetEventbusAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).GetEventbusAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_GetEventbusAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).GetEventbusAuth(ctx, req.(*wrapperspb.UInt64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_ListNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).ListNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_ListNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).ListNamespace(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).GetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_GetNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).GetNamespace(ctx, req.(*GetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventbusController_SetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventbusControllerServer).SetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventbusController_SetNamespaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventbusControllerServer).SetNamespaceQuota(ctx, req.(*SetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventbusController_ServiceDesc is the grpc.ServiceDesc for EventbusController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -432,14 +669,44 @@ var EventbusController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventbusWithHumanFriendly",
 			Handler:    _EventbusController_GetEventbusWithHumanFriendly_Handler,
 		},
+		{
+			MethodName: "SetEventbusAuth",
+			Handler:    _EventbusController_SetEventbusAuth_Handler,
+		},
+		{
+			MethodName: "GetEventbusAuth",
+			Handler:    _EventbusController_GetEventbusAuth_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _EventbusController_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _EventbusController_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespace",
+			Handler:    _EventbusController_ListNamespace_Handler,
+		},
+		{
+			MethodName: "GetNamespace",
+			Handler:    _EventbusController_GetNamespace_Handler,
+		},
+		{
+			MethodName: "SetNamespaceQuota",
+			Handler:    _EventbusController_SetNamespaceQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller.proto",
 }
 
 const (
-	EventlogController_ListSegment_FullMethodName          = "/vanus.core.controller.EventlogController/ListSegment"
-	EventlogController_GetAppendableSegment_FullMethodName = "/vanus.core.controller.EventlogController/GetAppendableSegment"
+	EventlogController_ListSegment_FullMethodName            = "/vanus.core.controller.EventlogController/ListSegment"
+	EventlogController_GetAppendableSegment_FullMethodName   = "/vanus.core.controller.EventlogController/GetAppendableSegment"
+	EventlogController_ListSegmentReplication_FullMethodName = "/vanus.core.controller.EventlogController/ListSegmentReplication"
+	EventlogController_RebalanceLeaders_FullMethodName       = "/vanus.core.controller.EventlogController/RebalanceLeaders"
 )
 
 // EventlogControllerClient is the client API for EventlogController service.
@@ -448,6 +715,11 @@ const (
 type EventlogControllerClient interface {
 	ListSegment(ctx context.Context, in *ListSegmentRequest, opts ...grpc.CallOption) (*ListSegmentResponse, error)
 	GetAppendableSegment(ctx context.Context, in *GetAppendableSegmentRequest, opts ...grpc.CallOption) (*GetAppendableSegmentResponse, error)
+	// ListSegmentReplication returns the segments which are re-replicating since their replicas are lost.
+	ListSegmentReplication(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSegmentReplicationResponse, error)
+	// RebalanceLeaders transfers the leaders of working segments from the store nodes which have more leaders to
+	// the ones which have less, so the append load is balanced.
+	RebalanceLeaders(ctx context.Context, in *RebalanceLeadersRequest, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error)
 }
 
 type eventlogControllerClient struct {
@@ -476,12 +748,35 @@ func (c *eventlogControllerClient) GetAppendableSegment(ctx context.Context, in
 	return out, nil
 }
 
+func (c *eventlogControllerClient) ListSegmentReplication(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSegmentReplicationResponse, error) {
+	out := new(ListSegmentReplicationResponse)
+	err := c.cc.Invoke(ctx, EventlogController_ListSegmentReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventlogControllerClient) RebalanceLeaders(ctx context.Context, in *RebalanceLeadersRequest, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error) {
+	out := new(RebalanceLeadersResponse)
+	err := c.cc.Invoke(ctx, EventlogController_RebalanceLeaders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventlogControllerServer is the server API for EventlogController service.
 // All implementations should embed UnimplementedEventlogControllerServer
 // for forward compatibility
 type EventlogControllerServer interface {
 	ListSegment(context.Context, *ListSegmentRequest) (*ListSegmentResponse, error)
 	GetAppendableSegment(context.Context, *GetAppendableSegmentRequest) (*GetAppendableSegmentResponse, error)
+	// ListSegmentReplication returns the segments which are re-replicating since their replicas are lost.
+	ListSegmentReplication(context.Context, *emptypb.Empty) (*ListSegmentReplicationResponse, error)
+	// RebalanceLeaders transfers the leaders of working segments from the store nodes which have more leaders to
+	// the ones which have less, so the append load is balanced.
+	RebalanceLeaders(context.Context, *RebalanceLeadersRequest) (*RebalanceLeadersResponse, error)
 }
 
 // UnimplementedEventlogControllerServer should be embedded to have forward compatible implementations.
@@ -494,6 +789,12 @@ func (UnimplementedEventlogControllerServer) ListSegment(context.Context, *ListS
 func (UnimplementedEventlogControllerServer) GetAppendableSegment(context.Context, *GetAppendableSegmentRequest) (*GetAppendableSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppendableSegment not implemented")
 }
+func (UnimplementedEventlogControllerServer) ListSegmentReplication(context.Context, *emptypb.Empty) (*ListSegmentReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegmentReplication not implemented")
+}
+func (UnimplementedEventlogControllerServer) RebalanceLeaders(context.Context, *RebalanceLeadersRequest) (*RebalanceLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceLeaders not implemented")
+}
 
 // UnsafeEventlogControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EventlogControllerServer will
@@ -542,6 +843,42 @@ func _EventlogController_GetAppendableSegment_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventlogController_ListSegmentReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventlogControllerServer).ListSegmentReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventlogController_ListSegmentReplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventlogControllerServer).ListSegmentReplication(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventlogController_RebalanceLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventlogControllerServer).RebalanceLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventlogController_RebalanceLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventlogControllerServer).RebalanceLeaders(ctx, req.(*RebalanceLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventlogController_ServiceDesc is the grpc.ServiceDesc for EventlogController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -557,6 +894,14 @@ var EventlogController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppendableSegment",
 			Handler:    _EventlogController_GetAppendableSegment_Handler,
 		},
+		{
+			MethodName: "ListSegmentReplication",
+			Handler:    _EventlogController_ListSegmentReplication_Handler,
+		},
+		{
+			MethodName: "RebalanceLeaders",
+			Handler:    _EventlogController_RebalanceLeaders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller.proto",
@@ -569,6 +914,7 @@ const (
 	SegmentController_UnregisterSegmentServer_FullMethodName  = "/vanus.core.controller.SegmentController/UnregisterSegmentServer"
 	SegmentController_ReportSegmentBlockIsFull_FullMethodName = "/vanus.core.controller.SegmentController/ReportSegmentBlockIsFull"
 	SegmentController_ReportSegmentLeader_FullMethodName      = "/vanus.core.controller.SegmentController/ReportSegmentLeader"
+	SegmentController_DecommissionVolume_FullMethodName       = "/vanus.core.controller.SegmentController/DecommissionVolume"
 )
 
 // SegmentControllerClient is the client API for SegmentController service.
@@ -581,6 +927,9 @@ type SegmentControllerClient interface {
 	UnregisterSegmentServer(ctx context.Context, in *UnregisterSegmentServerRequest, opts ...grpc.CallOption) (*UnregisterSegmentServerResponse, error)
 	ReportSegmentBlockIsFull(ctx context.Context, in *SegmentHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReportSegmentLeader(ctx context.Context, in *ReportSegmentLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DecommissionVolume marks the volume as draining, no new block is placed in it and its replicas are moved to
+	// other volumes. It returns the progress, the store node can be shut down once the volume is drained.
+	DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error)
 }
 
 type segmentControllerClient struct {
@@ -670,6 +1019,15 @@ func (c *segmentControllerClient) ReportSegmentLeader(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *segmentControllerClient) DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error) {
+	out := new(DecommissionVolumeResponse)
+	err := c.cc.Invoke(ctx, SegmentController_DecommissionVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SegmentControllerServer is the server API for SegmentController service.
 // All implementations should embed UnimplementedSegmentControllerServer
 // for forward compatibility
@@ -680,6 +1038,9 @@ type SegmentControllerServer interface {
 	UnregisterSegmentServer(context.Context, *UnregisterSegmentServerRequest) (*UnregisterSegmentServerResponse, error)
 	ReportSegmentBlockIsFull(context.Context, *SegmentHeartbeatRequest) (*emptypb.Empty, error)
 	ReportSegmentLeader(context.Context, *ReportSegmentLeaderRequest) (*emptypb.Empty, error)
+	// DecommissionVolume marks the volume as draining, no new block is placed in it and its replicas are moved to
+	// other volumes. It returns the progress, the store node can be shut down once the volume is drained.
+	DecommissionVolume(context.Context, *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error)
 }
 
 // UnimplementedSegmentControllerServer should be embedded to have forward compatible implementations.
@@ -704,6 +1065,9 @@ func (UnimplementedSegmentControllerServer) ReportSegmentBlockIsFull(context.Con
 func (UnimplementedSegmentControllerServer) ReportSegmentLeader(context.Context, *ReportSegmentLeaderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentLeader not implemented")
 }
+func (UnimplementedSegmentControllerServer) DecommissionVolume(context.Context, *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionVolume not implemented")
+}
 
 // UnsafeSegmentControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SegmentControllerServer will
@@ -832,6 +1196,24 @@ func _SegmentController_ReportSegmentLeader_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentController_DecommissionVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).DecommissionVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentController_DecommissionVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).DecommissionVolume(ctx, req.(*DecommissionVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SegmentController_ServiceDesc is the grpc.ServiceDesc for SegmentController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -859,6 +1241,10 @@ var SegmentController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportSegmentLeader",
 			Handler:    _SegmentController_ReportSegmentLeader_Handler,
 		},
+		{
+			MethodName: "DecommissionVolume",
+			Handler:    _SegmentController_DecommissionVolume_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -885,6 +1271,7 @@ const (
 	TriggerController_CommitOffset_FullMethodName             = "/vanus.core.controller.TriggerController/CommitOffset"
 	TriggerController_SetDeadLetterEventOffset_FullMethodName = "/vanus.core.controller.TriggerController/SetDeadLetterEventOffset"
 	TriggerController_GetDeadLetterEventOffset_FullMethodName = "/vanus.core.controller.TriggerController/GetDeadLetterEventOffset"
+	TriggerController_AcquirePullLease_FullMethodName         = "/vanus.core.controller.TriggerController/AcquirePullLease"
 )
 
 // TriggerControllerClient is the client API for TriggerController service.
@@ -905,6 +1292,7 @@ type TriggerControllerClient interface {
 	CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error)
 	SetDeadLetterEventOffset(ctx context.Context, in *SetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetDeadLetterEventOffset(ctx context.Context, in *GetDeadLetterEventOffsetRequest, opts ...grpc.CallOption) (*GetDeadLetterEventOffsetResponse, error)
+	AcquirePullLease(ctx context.Context, in *AcquirePullLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type triggerControllerClient struct {
@@ -1066,6 +1454,15 @@ func (c *triggerControllerClient) GetDeadLetterEventOffset(ctx context.Context,
 	return out, nil
 }
 
+func (c *triggerControllerClient) AcquirePullLease(ctx context.Context, in *AcquirePullLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TriggerController_AcquirePullLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TriggerControllerServer is the server API for TriggerController service.
 // All implementations should embed UnimplementedTriggerControllerServer
 // for forward compatibility
@@ -1084,6 +1481,7 @@ type TriggerControllerServer interface {
 	CommitOffset(context.Context, *CommitOffsetRequest) (*CommitOffsetResponse, error)
 	SetDeadLetterEventOffset(context.Context, *SetDeadLetterEventOffsetRequest) (*emptypb.Empty, error)
 	GetDeadLetterEventOffset(context.Context, *GetDeadLetterEventOffsetRequest) (*GetDeadLetterEventOffsetResponse, error)
+	AcquirePullLease(context.Context, *AcquirePullLeaseRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedTriggerControllerServer should be embedded to have forward compatible implementations.
@@ -1132,6 +1530,9 @@ func (UnimplementedTriggerControllerServer) SetDeadLetterEventOffset(context.Con
 func (UnimplementedTriggerControllerServer) GetDeadLetterEventOffset(context.Context, *GetDeadLetterEventOffsetRequest) (*GetDeadLetterEventOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterEventOffset not implemented")
 }
+func (UnimplementedTriggerControllerServer) AcquirePullLease(context.Context, *AcquirePullLeaseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquirePullLease not implemented")
+}
 
 // UnsafeTriggerControllerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TriggerControllerServer will
@@ -1404,6 +1805,24 @@ func _TriggerController_GetDeadLetterEventOffset_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TriggerController_AcquirePullLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquirePullLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).AcquirePullLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerController_AcquirePullLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).AcquirePullLease(ctx, req.(*AcquirePullLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TriggerController_ServiceDesc is the grpc.ServiceDesc for TriggerController service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1463,6 +1882,10 @@ var TriggerController_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeadLetterEventOffset",
 			Handler:    _TriggerController_GetDeadLetterEventOffset_Handler,
 		},
+		{
+			MethodName: "AcquirePullLease",
+			Handler:    _TriggerController_AcquirePullLease_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -176,6 +176,26 @@ func (mr *MockEventbusControllerClientMockRecorder) CreateEventbus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventbus", reflect.TypeOf((*MockEventbusControllerClient)(nil).CreateEventbus), varargs...)
 }
 
+// CreateNamespace mocks base method.
+func (m *MockEventbusControllerClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNamespace", varargs...)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamespace indicates an expected call of CreateNamespace.
+func (mr *MockEventbusControllerClientMockRecorder) CreateNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespace", reflect.TypeOf((*MockEventbusControllerClient)(nil).CreateNamespace), varargs...)
+}
+
 // CreateSystemEventbus mocks base method.
 func (m *MockEventbusControllerClient) CreateSystemEventbus(ctx context.Context, in *CreateEventbusRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +236,26 @@ func (mr *MockEventbusControllerClientMockRecorder) DeleteEventbus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbus", reflect.TypeOf((*MockEventbusControllerClient)(nil).DeleteEventbus), varargs...)
 }
 
+// DeleteNamespace mocks base method.
+func (m *MockEventbusControllerClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNamespace", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockEventbusControllerClientMockRecorder) DeleteNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockEventbusControllerClient)(nil).DeleteNamespace), varargs...)
+}
+
 // GetEventbus mocks base method.
 func (m *MockEventbusControllerClient) GetEventbus(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +276,26 @@ func (mr *MockEventbusControllerClientMockRecorder) GetEventbus(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbus", reflect.TypeOf((*MockEventbusControllerClient)(nil).GetEventbus), varargs...)
 }
 
+// GetEventbusAuth mocks base method.
+func (m *MockEventbusControllerClient) GetEventbusAuth(ctx context.Context, in *wrapperspb.UInt64Value, opts ...grpc.CallOption) (*meta.EventbusAuth, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventbusAuth", varargs...)
+	ret0, _ := ret[0].(*meta.EventbusAuth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventbusAuth indicates an expected call of GetEventbusAuth.
+func (mr *MockEventbusControllerClientMockRecorder) GetEventbusAuth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbusAuth", reflect.TypeOf((*MockEventbusControllerClient)(nil).GetEventbusAuth), varargs...)
+}
+
 // GetEventbusWithHumanFriendly mocks base method.
 func (m *MockEventbusControllerClient) GetEventbusWithHumanFriendly(ctx context.Context, in *GetEventbusWithHumanFriendlyRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +316,26 @@ func (mr *MockEventbusControllerClientMockRecorder) GetEventbusWithHumanFriendly
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbusWithHumanFriendly", reflect.TypeOf((*MockEventbusControllerClient)(nil).GetEventbusWithHumanFriendly), varargs...)
 }
 
+// GetNamespace mocks base method.
+func (m *MockEventbusControllerClient) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespace", varargs...)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespace indicates an expected call of GetNamespace.
+func (mr *MockEventbusControllerClientMockRecorder) GetNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockEventbusControllerClient)(nil).GetNamespace), varargs...)
+}
+
 // ListEventbus mocks base method.
 func (m *MockEventbusControllerClient) ListEventbus(ctx context.Context, in *ListEventbusRequest, opts ...grpc.CallOption) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +356,66 @@ func (mr *MockEventbusControllerClientMockRecorder) ListEventbus(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbus", reflect.TypeOf((*MockEventbusControllerClient)(nil).ListEventbus), varargs...)
 }
 
+// ListNamespace mocks base method.
+func (m *MockEventbusControllerClient) ListNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespace", varargs...)
+	ret0, _ := ret[0].(*ListNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespace indicates an expected call of ListNamespace.
+func (mr *MockEventbusControllerClientMockRecorder) ListNamespace(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespace", reflect.TypeOf((*MockEventbusControllerClient)(nil).ListNamespace), varargs...)
+}
+
+// SetEventbusAuth mocks base method.
+func (m *MockEventbusControllerClient) SetEventbusAuth(ctx context.Context, in *SetEventbusAuthRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetEventbusAuth", varargs...)
+	ret0, _ := ret[0].(*meta.Eventbus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventbusAuth indicates an expected call of SetEventbusAuth.
+func (mr *MockEventbusControllerClientMockRecorder) SetEventbusAuth(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventbusAuth", reflect.TypeOf((*MockEventbusControllerClient)(nil).SetEventbusAuth), varargs...)
+}
+
+// SetNamespaceQuota mocks base method.
+func (m *MockEventbusControllerClient) SetNamespaceQuota(ctx context.Context, in *SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetNamespaceQuota", varargs...)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNamespaceQuota indicates an expected call of SetNamespaceQuota.
+func (mr *MockEventbusControllerClientMockRecorder) SetNamespaceQuota(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuota", reflect.TypeOf((*MockEventbusControllerClient)(nil).SetNamespaceQuota), varargs...)
+}
+
 // UpdateEventbus mocks base method.
 func (m *MockEventbusControllerClient) UpdateEventbus(ctx context.Context, in *UpdateEventbusRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +474,21 @@ func (mr *MockEventbusControllerServerMockRecorder) CreateEventbus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventbus", reflect.TypeOf((*MockEventbusControllerServer)(nil).CreateEventbus), arg0, arg1)
 }
 
+// CreateNamespace mocks base method.
+func (m *MockEventbusControllerServer) CreateNamespace(arg0 context.Context, arg1 *CreateNamespaceRequest) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNamespace indicates an expected call of CreateNamespace.
+func (mr *MockEventbusControllerServerMockRecorder) CreateNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespace", reflect.TypeOf((*MockEventbusControllerServer)(nil).CreateNamespace), arg0, arg1)
+}
+
 // CreateSystemEventbus mocks base method.
 func (m *MockEventbusControllerServer) CreateSystemEventbus(arg0 context.Context, arg1 *CreateEventbusRequest) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -364,6 +519,21 @@ func (mr *MockEventbusControllerServerMockRecorder) DeleteEventbus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbus", reflect.TypeOf((*MockEventbusControllerServer)(nil).DeleteEventbus), arg0, arg1)
 }
 
+// DeleteNamespace mocks base method.
+func (m *MockEventbusControllerServer) DeleteNamespace(arg0 context.Context, arg1 *DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockEventbusControllerServerMockRecorder) DeleteNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockEventbusControllerServer)(nil).DeleteNamespace), arg0, arg1)
+}
+
 // GetEventbus mocks base method.
 func (m *MockEventbusControllerServer) GetEventbus(arg0 context.Context, arg1 *wrapperspb.UInt64Value) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -379,6 +549,21 @@ func (mr *MockEventbusControllerServerMockRecorder) GetEventbus(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbus", reflect.TypeOf((*MockEventbusControllerServer)(nil).GetEventbus), arg0, arg1)
 }
 
+// GetEventbusAuth mocks base method.
+func (m *MockEventbusControllerServer) GetEventbusAuth(arg0 context.Context, arg1 *wrapperspb.UInt64Value) (*meta.EventbusAuth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventbusAuth", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventbusAuth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventbusAuth indicates an expected call of GetEventbusAuth.
+func (mr *MockEventbusControllerServerMockRecorder) GetEventbusAuth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbusAuth", reflect.TypeOf((*MockEventbusControllerServer)(nil).GetEventbusAuth), arg0, arg1)
+}
+
 // GetEventbusWithHumanFriendly mocks base method.
 func (m *MockEventbusControllerServer) GetEventbusWithHumanFriendly(arg0 context.Context, arg1 *GetEventbusWithHumanFriendlyRequest) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +579,21 @@ func (mr *MockEventbusControllerServerMockRecorder) GetEventbusWithHumanFriendly
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventbusWithHumanFriendly", reflect.TypeOf((*MockEventbusControllerServer)(nil).GetEventbusWithHumanFriendly), arg0, arg1)
 }
 
+// GetNamespace mocks base method.
+func (m *MockEventbusControllerServer) GetNamespace(arg0 context.Context, arg1 *GetNamespaceRequest) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespace indicates an expected call of GetNamespace.
+func (mr *MockEventbusControllerServerMockRecorder) GetNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockEventbusControllerServer)(nil).GetNamespace), arg0, arg1)
+}
+
 // ListEventbus mocks base method.
 func (m *MockEventbusControllerServer) ListEventbus(arg0 context.Context, arg1 *ListEventbusRequest) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -409,6 +609,51 @@ func (mr *MockEventbusControllerServerMockRecorder) ListEventbus(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbus", reflect.TypeOf((*MockEventbusControllerServer)(nil).ListEventbus), arg0, arg1)
 }
 
+// ListNamespace mocks base method.
+func (m *MockEventbusControllerServer) ListNamespace(arg0 context.Context, arg1 *emptypb.Empty) (*ListNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*ListNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespace indicates an expected call of ListNamespace.
+func (mr *MockEventbusControllerServerMockRecorder) ListNamespace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespace", reflect.TypeOf((*MockEventbusControllerServer)(nil).ListNamespace), arg0, arg1)
+}
+
+// SetEventbusAuth mocks base method.
+func (m *MockEventbusControllerServer) SetEventbusAuth(arg0 context.Context, arg1 *SetEventbusAuthRequest) (*meta.Eventbus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventbusAuth", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Eventbus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventbusAuth indicates an expected call of SetEventbusAuth.
+func (mr *MockEventbusControllerServerMockRecorder) SetEventbusAuth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventbusAuth", reflect.TypeOf((*MockEventbusControllerServer)(nil).SetEventbusAuth), arg0, arg1)
+}
+
+// SetNamespaceQuota mocks base method.
+func (m *MockEventbusControllerServer) SetNamespaceQuota(arg0 context.Context, arg1 *SetNamespaceQuotaRequest) (*meta.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNamespaceQuota", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNamespaceQuota indicates an expected call of SetNamespaceQuota.
+func (mr *MockEventbusControllerServerMockRecorder) SetNamespaceQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceQuota", reflect.TypeOf((*MockEventbusControllerServer)(nil).SetNamespaceQuota), arg0, arg1)
+}
+
 // UpdateEventbus mocks base method.
 func (m *MockEventbusControllerServer) UpdateEventbus(arg0 context.Context, arg1 *UpdateEventbusRequest) (*meta.Eventbus, error) {
 	m.ctrl.T.Helper()
@@ -522,6 +767,46 @@ func (mr *MockEventlogControllerClientMockRecorder) ListSegment(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventlogControllerClient)(nil).ListSegment), varargs...)
 }
 
+// ListSegmentReplication mocks base method.
+func (m *MockEventlogControllerClient) ListSegmentReplication(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSegmentReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSegmentReplication", varargs...)
+	ret0, _ := ret[0].(*ListSegmentReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegmentReplication indicates an expected call of ListSegmentReplication.
+func (mr *MockEventlogControllerClientMockRecorder) ListSegmentReplication(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegmentReplication", reflect.TypeOf((*MockEventlogControllerClient)(nil).ListSegmentReplication), varargs...)
+}
+
+// RebalanceLeaders mocks base method.
+func (m *MockEventlogControllerClient) RebalanceLeaders(ctx context.Context, in *RebalanceLeadersRequest, opts ...grpc.CallOption) (*RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebalanceLeaders", varargs...)
+	ret0, _ := ret[0].(*RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockEventlogControllerClientMockRecorder) RebalanceLeaders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockEventlogControllerClient)(nil).RebalanceLeaders), varargs...)
+}
+
 // MockEventlogControllerServer is a mock of EventlogControllerServer interface.
 type MockEventlogControllerServer struct {
 	ctrl     *gomock.Controller
@@ -575,6 +860,36 @@ func (mr *MockEventlogControllerServerMockRecorder) ListSegment(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventlogControllerServer)(nil).ListSegment), arg0, arg1)
 }
 
+// ListSegmentReplication mocks base method.
+func (m *MockEventlogControllerServer) ListSegmentReplication(arg0 context.Context, arg1 *emptypb.Empty) (*ListSegmentReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegmentReplication", arg0, arg1)
+	ret0, _ := ret[0].(*ListSegmentReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegmentReplication indicates an expected call of ListSegmentReplication.
+func (mr *MockEventlogControllerServerMockRecorder) ListSegmentReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegmentReplication", reflect.TypeOf((*MockEventlogControllerServer)(nil).ListSegmentReplication), arg0, arg1)
+}
+
+// RebalanceLeaders mocks base method.
+func (m *MockEventlogControllerServer) RebalanceLeaders(arg0 context.Context, arg1 *RebalanceLeadersRequest) (*RebalanceLeadersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceLeaders", arg0, arg1)
+	ret0, _ := ret[0].(*RebalanceLeadersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalanceLeaders indicates an expected call of RebalanceLeaders.
+func (mr *MockEventlogControllerServerMockRecorder) RebalanceLeaders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceLeaders", reflect.TypeOf((*MockEventlogControllerServer)(nil).RebalanceLeaders), arg0, arg1)
+}
+
 // MockUnsafeEventlogControllerServer is a mock of UnsafeEventlogControllerServer interface.
 type MockUnsafeEventlogControllerServer struct {
 	ctrl     *gomock.Controller
@@ -633,6 +948,26 @@ func (m *MockSegmentControllerClient) EXPECT() *MockSegmentControllerClientMockR
 	return m.recorder
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockSegmentControllerClient) DecommissionVolume(ctx context.Context, in *DecommissionVolumeRequest, opts ...grpc.CallOption) (*DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DecommissionVolume", varargs...)
+	ret0, _ := ret[0].(*DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockSegmentControllerClientMockRecorder) DecommissionVolume(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockSegmentControllerClient)(nil).DecommissionVolume), varargs...)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerClient) QuerySegmentRouteInfo(ctx context.Context, in *QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -913,6 +1248,21 @@ func (m *MockSegmentControllerServer) EXPECT() *MockSegmentControllerServerMockR
 	return m.recorder
 }
 
+// DecommissionVolume mocks base method.
+func (m *MockSegmentControllerServer) DecommissionVolume(arg0 context.Context, arg1 *DecommissionVolumeRequest) (*DecommissionVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecommissionVolume", arg0, arg1)
+	ret0, _ := ret[0].(*DecommissionVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionVolume indicates an expected call of DecommissionVolume.
+func (mr *MockSegmentControllerServerMockRecorder) DecommissionVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionVolume", reflect.TypeOf((*MockSegmentControllerServer)(nil).DecommissionVolume), arg0, arg1)
+}
+
 // QuerySegmentRouteInfo mocks base method.
 func (m *MockSegmentControllerServer) QuerySegmentRouteInfo(arg0 context.Context, arg1 *QuerySegmentRouteInfoRequest) (*QuerySegmentRouteInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1194,6 +1544,26 @@ func (m *MockTriggerControllerClient) EXPECT() *MockTriggerControllerClientMockR
 	return m.recorder
 }
 
+// AcquirePullLease mocks base method.
+func (m *MockTriggerControllerClient) AcquirePullLease(ctx context.Context, in *AcquirePullLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcquirePullLease", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquirePullLease indicates an expected call of AcquirePullLease.
+func (mr *MockTriggerControllerClientMockRecorder) AcquirePullLease(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquirePullLease", reflect.TypeOf((*MockTriggerControllerClient)(nil).AcquirePullLease), varargs...)
+}
+
 // CommitOffset mocks base method.
 func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
 	m.ctrl.T.Helper()
@@ -1634,6 +2004,21 @@ func (m *MockTriggerControllerServer) EXPECT() *MockTriggerControllerServerMockR
 	return m.recorder
 }
 
+// AcquirePullLease mocks base method.
+func (m *MockTriggerControllerServer) AcquirePullLease(arg0 context.Context, arg1 *AcquirePullLeaseRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquirePullLease", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquirePullLease indicates an expected call of AcquirePullLease.
+func (mr *MockTriggerControllerServerMockRecorder) AcquirePullLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquirePullLease", reflect.TypeOf((*MockTriggerControllerServer)(nil).AcquirePullLease), arg0, arg1)
+}
+
 // CommitOffset mocks base method.
 func (m *MockTriggerControllerServer) CommitOffset(arg0 context.Context, arg1 *CommitOffsetRequest) (*CommitOffsetResponse, error) {
 	m.ctrl.T.Helper()
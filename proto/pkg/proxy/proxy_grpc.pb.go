@@ -43,7 +43,16 @@ const (
 	ControllerProxy_ListEventbus_FullMethodName                 = "/vanus.core.proxy.ControllerProxy/ListEventbus"
 	ControllerProxy_UpdateEventbus_FullMethodName               = "/vanus.core.proxy.ControllerProxy/UpdateEventbus"
 	ControllerProxy_GetEventbusWithHumanFriendly_FullMethodName = "/vanus.core.proxy.ControllerProxy/GetEventbusWithHumanFriendly"
+	ControllerProxy_SetEventbusAuth_FullMethodName              = "/vanus.core.proxy.ControllerProxy/SetEventbusAuth"
 	ControllerProxy_ListSegment_FullMethodName                  = "/vanus.core.proxy.ControllerProxy/ListSegment"
+	ControllerProxy_ListSegmentReplication_FullMethodName       = "/vanus.core.proxy.ControllerProxy/ListSegmentReplication"
+	ControllerProxy_RebalanceLeaders_FullMethodName             = "/vanus.core.proxy.ControllerProxy/RebalanceLeaders"
+	ControllerProxy_DecommissionVolume_FullMethodName           = "/vanus.core.proxy.ControllerProxy/DecommissionVolume"
+	ControllerProxy_CreateNamespace_FullMethodName              = "/vanus.core.proxy.ControllerProxy/CreateNamespace"
+	ControllerProxy_DeleteNamespace_FullMethodName              = "/vanus.core.proxy.ControllerProxy/DeleteNamespace"
+	ControllerProxy_ListNamespace_FullMethodName                = "/vanus.core.proxy.ControllerProxy/ListNamespace"
+	ControllerProxy_GetNamespace_FullMethodName                 = "/vanus.core.proxy.ControllerProxy/GetNamespace"
+	ControllerProxy_SetNamespaceQuota_FullMethodName            = "/vanus.core.proxy.ControllerProxy/SetNamespaceQuota"
 	ControllerProxy_CreateSubscription_FullMethodName           = "/vanus.core.proxy.ControllerProxy/CreateSubscription"
 	ControllerProxy_UpdateSubscription_FullMethodName           = "/vanus.core.proxy.ControllerProxy/UpdateSubscription"
 	ControllerProxy_DeleteSubscription_FullMethodName           = "/vanus.core.proxy.ControllerProxy/DeleteSubscription"
@@ -72,7 +81,16 @@ type ControllerProxyClient interface {
 	ListEventbus(ctx context.Context, in *controller.ListEventbusRequest, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error)
 	UpdateEventbus(ctx context.Context, in *controller.UpdateEventbusRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
 	GetEventbusWithHumanFriendly(ctx context.Context, in *controller.GetEventbusWithHumanFriendlyRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
+	SetEventbusAuth(ctx context.Context, in *controller.SetEventbusAuthRequest, opts ...grpc.CallOption) (*meta.Eventbus, error)
 	ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error)
+	ListSegmentReplication(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSegmentReplicationResponse, error)
+	RebalanceLeaders(ctx context.Context, in *controller.RebalanceLeadersRequest, opts ...grpc.CallOption) (*controller.RebalanceLeadersResponse, error)
+	DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest, opts ...grpc.CallOption) (*controller.DecommissionVolumeResponse, error)
+	CreateNamespace(ctx context.Context, in *controller.CreateNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
+	DeleteNamespace(ctx context.Context, in *controller.DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListNamespaceResponse, error)
+	GetNamespace(ctx context.Context, in *controller.GetNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
+	SetNamespaceQuota(ctx context.Context, in *controller.SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*meta.Namespace, error)
 	// Trigger
 	CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
 	UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
@@ -155,6 +173,15 @@ func (c *controllerProxyClient) GetEventbusWithHumanFriendly(ctx context.Context
 	return out, nil
 }
 
+func (c *controllerProxyClient) SetEventbusAuth(ctx context.Context, in *controller.SetEventbusAuthRequest, opts ...grpc.CallOption) (*meta.Eventbus, error) {
+	out := new(meta.Eventbus)
+	err := c.cc.Invoke(ctx, ControllerProxy_SetEventbusAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerProxyClient) ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error) {
 	out := new(controller.ListSegmentResponse)
 	err := c.cc.Invoke(ctx, ControllerProxy_ListSegment_FullMethodName, in, out, opts...)
@@ -164,6 +191,78 @@ func (c *controllerProxyClient) ListSegment(ctx context.Context, in *controller.
 	return out, nil
 }
 
+func (c *controllerProxyClient) ListSegmentReplication(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSegmentReplicationResponse, error) {
+	out := new(controller.ListSegmentReplicationResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_ListSegmentReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) RebalanceLeaders(ctx context.Context, in *controller.RebalanceLeadersRequest, opts ...grpc.CallOption) (*controller.RebalanceLeadersResponse, error) {
+	out := new(controller.RebalanceLeadersResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_RebalanceLeaders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DecommissionVolume(ctx context.Context, in *controller.DecommissionVolumeRequest, opts ...grpc.CallOption) (*controller.DecommissionVolumeResponse, error) {
+	out := new(controller.DecommissionVolumeResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_DecommissionVolume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CreateNamespace(ctx context.Context, in *controller.CreateNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, ControllerProxy_CreateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteNamespace(ctx context.Context, in *controller.DeleteNamespaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControllerProxy_DeleteNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListNamespace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListNamespaceResponse, error) {
+	out := new(controller.ListNamespaceResponse)
+	err := c.cc.Invoke(ctx, ControllerProxy_ListNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetNamespace(ctx context.Context, in *controller.GetNamespaceRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, ControllerProxy_GetNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) SetNamespaceQuota(ctx context.Context, in *controller.SetNamespaceQuotaRequest, opts ...grpc.CallOption) (*meta.Namespace, error) {
+	out := new(meta.Namespace)
+	err := c.cc.Invoke(ctx, ControllerProxy_SetNamespaceQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerProxyClient) CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
 	out := new(meta.Subscription)
 	err := c.cc.Invoke(ctx, ControllerProxy_CreateSubscription_FullMethodName, in, out, opts...)
@@ -310,7 +409,16 @@ type ControllerProxyServer interface {
 	ListEventbus(context.Context, *controller.ListEventbusRequest) (*controller.ListEventbusResponse, error)
 	UpdateEventbus(context.Context, *controller.UpdateEventbusRequest) (*meta.Eventbus, error)
 	GetEventbusWithHumanFriendly(context.Context, *controller.GetEventbusWithHumanFriendlyRequest) (*meta.Eventbus, error)
+	SetEventbusAuth(context.Context, *controller.SetEventbusAuthRequest) (*meta.Eventbus, error)
 	ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error)
+	ListSegmentReplication(context.Context, *emptypb.Empty) (*controller.ListSegmentReplicationResponse, error)
+	RebalanceLeaders(context.Context, *controller.RebalanceLeadersRequest) (*controller.RebalanceLeadersResponse, error)
+	DecommissionVolume(context.Context, *controller.DecommissionVolumeRequest) (*controller.DecommissionVolumeResponse, error)
+	CreateNamespace(context.Context, *controller.CreateNamespaceRequest) (*meta.Namespace, error)
+	DeleteNamespace(context.Context, *controller.DeleteNamespaceRequest) (*emptypb.Empty, error)
+	ListNamespace(context.Context, *emptypb.Empty) (*controller.ListNamespaceResponse, error)
+	GetNamespace(context.Context, *controller.GetNamespaceRequest) (*meta.Namespace, error)
+	SetNamespaceQuota(context.Context, *controller.SetNamespaceQuotaRequest) (*meta.Namespace, error)
 	// Trigger
 	CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error)
 	UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error)
@@ -353,9 +461,36 @@ func (UnimplementedControllerProxyServer) UpdateEventbus(context.Context, *contr
 func (UnimplementedControllerProxyServer) GetEventbusWithHumanFriendly(context.Context, *controller.GetEventbusWithHumanFriendlyRequest) (*meta.Eventbus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEventbusWithHumanFriendly not implemented")
 }
+func (UnimplementedControllerProxyServer) SetEventbusAuth(context.Context, *controller.SetEventbusAuthRequest) (*meta.Eventbus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventbusAuth not implemented")
+}
 func (UnimplementedControllerProxyServer) ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSegment not implemented")
 }
+func (UnimplementedControllerProxyServer) ListSegmentReplication(context.Context, *emptypb.Empty) (*controller.ListSegmentReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegmentReplication not implemented")
+}
+func (UnimplementedControllerProxyServer) RebalanceLeaders(context.Context, *controller.RebalanceLeadersRequest) (*controller.RebalanceLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceLeaders not implemented")
+}
+func (UnimplementedControllerProxyServer) DecommissionVolume(context.Context, *controller.DecommissionVolumeRequest) (*controller.DecommissionVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionVolume not implemented")
+}
+func (UnimplementedControllerProxyServer) CreateNamespace(context.Context, *controller.CreateNamespaceRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedControllerProxyServer) DeleteNamespace(context.Context, *controller.DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedControllerProxyServer) ListNamespace(context.Context, *emptypb.Empty) (*controller.ListNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespace not implemented")
+}
+func (UnimplementedControllerProxyServer) GetNamespace(context.Context, *controller.GetNamespaceRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
+}
+func (UnimplementedControllerProxyServer) SetNamespaceQuota(context.Context, *controller.SetNamespaceQuotaRequest) (*meta.Namespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceQuota not implemented")
+}
 func (UnimplementedControllerProxyServer) CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
 }
@@ -521,6 +656,24 @@ func _ControllerProxy_GetEventbusWithHumanFriendly_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerProxy_SetEventbusAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.SetEventbusAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).SetEventbusAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_SetEventbusAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).SetEventbusAuth(ctx, req.(*controller.SetEventbusAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerProxy_ListSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(controller.ListSegmentRequest)
 	if err := dec(in); err != nil {
@@ -539,6 +692,150 @@ func _ControllerProxy_ListSegment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControllerProxy_ListSegmentReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListSegmentReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_ListSegmentReplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListSegmentReplication(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_RebalanceLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.RebalanceLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).RebalanceLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_RebalanceLeaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).RebalanceLeaders(ctx, req.(*controller.RebalanceLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_DecommissionVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DecommissionVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DecommissionVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_DecommissionVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DecommissionVolume(ctx, req.(*controller.DecommissionVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CreateNamespace(ctx, req.(*controller.CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteNamespace(ctx, req.(*controller.DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ListNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_ListNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListNamespace(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_GetNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetNamespace(ctx, req.(*controller.GetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_SetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.SetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).SetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControllerProxy_SetNamespaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).SetNamespaceQuota(ctx, req.(*controller.SetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControllerProxy_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(controller.CreateSubscriptionRequest)
 	if err := dec(in); err != nil {
@@ -840,10 +1137,46 @@ var ControllerProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEventbusWithHumanFriendly",
 			Handler:    _ControllerProxy_GetEventbusWithHumanFriendly_Handler,
 		},
+		{
+			MethodName: "SetEventbusAuth",
+			Handler:    _ControllerProxy_SetEventbusAuth_Handler,
+		},
 		{
 			MethodName: "ListSegment",
 			Handler:    _ControllerProxy_ListSegment_Handler,
 		},
+		{
+			MethodName: "ListSegmentReplication",
+			Handler:    _ControllerProxy_ListSegmentReplication_Handler,
+		},
+		{
+			MethodName: "RebalanceLeaders",
+			Handler:    _ControllerProxy_RebalanceLeaders_Handler,
+		},
+		{
+			MethodName: "DecommissionVolume",
+			Handler:    _ControllerProxy_DecommissionVolume_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _ControllerProxy_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _ControllerProxy_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespace",
+			Handler:    _ControllerProxy_ListNamespace_Handler,
+		},
+		{
+			MethodName: "GetNamespace",
+			Handler:    _ControllerProxy_GetNamespace_Handler,
+		},
+		{
+			MethodName: "SetNamespaceQuota",
+			Handler:    _ControllerProxy_SetNamespaceQuota_Handler,
+		},
 		{
 			MethodName: "CreateSubscription",
 			Handler:    _ControllerProxy_CreateSubscription_Handler,
@@ -910,18 +1243,26 @@ var ControllerProxy_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StoreProxy_Publish_FullMethodName   = "/vanus.core.proxy.StoreProxy/Publish"
-	StoreProxy_Subscribe_FullMethodName = "/vanus.core.proxy.StoreProxy/Subscribe"
-	StoreProxy_Ack_FullMethodName       = "/vanus.core.proxy.StoreProxy/Ack"
+	StoreProxy_Publish_FullMethodName    = "/vanus.core.proxy.StoreProxy/Publish"
+	StoreProxy_Subscribe_FullMethodName  = "/vanus.core.proxy.StoreProxy/Subscribe"
+	StoreProxy_Ack_FullMethodName        = "/vanus.core.proxy.StoreProxy/Ack"
+	StoreProxy_Fetch_FullMethodName      = "/vanus.core.proxy.StoreProxy/Fetch"
+	StoreProxy_AckEvents_FullMethodName  = "/vanus.core.proxy.StoreProxy/AckEvents"
+	StoreProxy_NackEvents_FullMethodName = "/vanus.core.proxy.StoreProxy/NackEvents"
 )
 
 // StoreProxyClient is the client API for StoreProxy service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StoreProxyClient interface {
-	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StoreProxy_SubscribeClient, error)
 	Ack(ctx context.Context, opts ...grpc.CallOption) (StoreProxy_AckClient, error)
+	// Fetch leases events of a disabled subscription to the caller, all consumers of the same subscription
+	// share the events. The events are redelivered if they aren't acknowledged before the lease is timeout.
+	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error)
+	AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	NackEvents(ctx context.Context, in *NackEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type storeProxyClient struct {
@@ -932,8 +1273,8 @@ func NewStoreProxyClient(cc grpc.ClientConnInterface) StoreProxyClient {
 	return &storeProxyClient{cc}
 }
 
-func (c *storeProxyClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *storeProxyClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
 	err := c.cc.Invoke(ctx, StoreProxy_Publish_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1007,20 +1348,52 @@ func (x *storeProxyAckClient) CloseAndRecv() (*emptypb.Empty, error) {
 	return m, nil
 }
 
+func (c *storeProxyClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	out := new(FetchResponse)
+	err := c.cc.Invoke(ctx, StoreProxy_Fetch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeProxyClient) AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StoreProxy_AckEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storeProxyClient) NackEvents(ctx context.Context, in *NackEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, StoreProxy_NackEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoreProxyServer is the server API for StoreProxy service.
 // All implementations should embed UnimplementedStoreProxyServer
 // for forward compatibility
 type StoreProxyServer interface {
-	Publish(context.Context, *PublishRequest) (*emptypb.Empty, error)
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
 	Subscribe(*SubscribeRequest, StoreProxy_SubscribeServer) error
 	Ack(StoreProxy_AckServer) error
+	// Fetch leases events of a disabled subscription to the caller, all consumers of the same subscription
+	// share the events. The events are redelivered if they aren't acknowledged before the lease is timeout.
+	Fetch(context.Context, *FetchRequest) (*FetchResponse, error)
+	AckEvents(context.Context, *AckEventsRequest) (*emptypb.Empty, error)
+	NackEvents(context.Context, *NackEventsRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedStoreProxyServer should be embedded to have forward compatible implementations.
 type UnimplementedStoreProxyServer struct {
 }
 
-func (UnimplementedStoreProxyServer) Publish(context.Context, *PublishRequest) (*emptypb.Empty, error) {
+func (UnimplementedStoreProxyServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
 }
 func (UnimplementedStoreProxyServer) Subscribe(*SubscribeRequest, StoreProxy_SubscribeServer) error {
@@ -1029,6 +1402,15 @@ func (UnimplementedStoreProxyServer) Subscribe(*SubscribeRequest, StoreProxy_Sub
 func (UnimplementedStoreProxyServer) Ack(StoreProxy_AckServer) error {
 	return status.Errorf(codes.Unimplemented, "method Ack not implemented")
 }
+func (UnimplementedStoreProxyServer) Fetch(context.Context, *FetchRequest) (*FetchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fetch not implemented")
+}
+func (UnimplementedStoreProxyServer) AckEvents(context.Context, *AckEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEvents not implemented")
+}
+func (UnimplementedStoreProxyServer) NackEvents(context.Context, *NackEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NackEvents not implemented")
+}
 
 // UnsafeStoreProxyServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StoreProxyServer will
@@ -1106,6 +1488,60 @@ func (x *storeProxyAckServer) Recv() (*AckRequest, error) {
 	return m, nil
 }
 
+func _StoreProxy_Fetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreProxyServer).Fetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreProxy_Fetch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreProxyServer).Fetch(ctx, req.(*FetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreProxy_AckEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreProxyServer).AckEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreProxy_AckEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreProxyServer).AckEvents(ctx, req.(*AckEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoreProxy_NackEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreProxyServer).NackEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoreProxy_NackEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreProxyServer).NackEvents(ctx, req.(*NackEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StoreProxy_ServiceDesc is the grpc.ServiceDesc for StoreProxy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1117,6 +1553,18 @@ var StoreProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Publish",
 			Handler:    _StoreProxy_Publish_Handler,
 		},
+		{
+			MethodName: "Fetch",
+			Handler:    _StoreProxy_Fetch_Handler,
+		},
+		{
+			MethodName: "AckEvents",
+			Handler:    _StoreProxy_AckEvents_Handler,
+		},
+		{
+			MethodName: "NackEvents",
+			Handler:    _StoreProxy_NackEvents_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
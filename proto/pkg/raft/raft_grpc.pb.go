@@ -1,3 +1,17 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
@@ -20,11 +34,19 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	RaftServer_SendMessage_FullMethodName  = "/vanus.core.raft.RaftServer/SendMessage"
+	RaftServer_SendSnapshot_FullMethodName = "/vanus.core.raft.RaftServer/SendSnapshot"
+)
+
 // RaftServerClient is the client API for RaftServer service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RaftServerClient interface {
 	SendMessage(ctx context.Context, opts ...grpc.CallOption) (RaftServer_SendMessageClient, error)
+	// SendSnapshot transfers the data of snapshot in chunks, each chunk is acknowledged with the offset of next chunk
+	// expected by the receiver, so the sender can resume the transfer from there.
+	SendSnapshot(ctx context.Context, opts ...grpc.CallOption) (RaftServer_SendSnapshotClient, error)
 }
 
 type raftServerClient struct {
@@ -36,7 +58,7 @@ func NewRaftServerClient(cc grpc.ClientConnInterface) RaftServerClient {
 }
 
 func (c *raftServerClient) SendMessage(ctx context.Context, opts ...grpc.CallOption) (RaftServer_SendMessageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RaftServer_ServiceDesc.Streams[0], "/vanus.core.raft.RaftServer/SendMessage", opts...)
+	stream, err := c.cc.NewStream(ctx, &RaftServer_ServiceDesc.Streams[0], RaftServer_SendMessage_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -69,11 +91,45 @@ func (x *raftServerSendMessageClient) CloseAndRecv() (*emptypb.Empty, error) {
 	return m, nil
 }
 
+func (c *raftServerClient) SendSnapshot(ctx context.Context, opts ...grpc.CallOption) (RaftServer_SendSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RaftServer_ServiceDesc.Streams[1], RaftServer_SendSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftServerSendSnapshotClient{stream}
+	return x, nil
+}
+
+type RaftServer_SendSnapshotClient interface {
+	Send(*SnapshotChunk) error
+	Recv() (*SnapshotAck, error)
+	grpc.ClientStream
+}
+
+type raftServerSendSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftServerSendSnapshotClient) Send(m *SnapshotChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *raftServerSendSnapshotClient) Recv() (*SnapshotAck, error) {
+	m := new(SnapshotAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RaftServerServer is the server API for RaftServer service.
 // All implementations should embed UnimplementedRaftServerServer
 // for forward compatibility
 type RaftServerServer interface {
 	SendMessage(RaftServer_SendMessageServer) error
+	// SendSnapshot transfers the data of snapshot in chunks, each chunk is acknowledged with the offset of next chunk
+	// expected by the receiver, so the sender can resume the transfer from there.
+	SendSnapshot(RaftServer_SendSnapshotServer) error
 }
 
 // UnimplementedRaftServerServer should be embedded to have forward compatible implementations.
@@ -83,6 +139,9 @@ type UnimplementedRaftServerServer struct {
 func (UnimplementedRaftServerServer) SendMessage(RaftServer_SendMessageServer) error {
 	return status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedRaftServerServer) SendSnapshot(RaftServer_SendSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendSnapshot not implemented")
+}
 
 // UnsafeRaftServerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RaftServerServer will
@@ -121,6 +180,32 @@ func (x *raftServerSendMessageServer) Recv() (*raftpb.Message, error) {
 	return m, nil
 }
 
+func _RaftServer_SendSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftServerServer).SendSnapshot(&raftServerSendSnapshotServer{stream})
+}
+
+type RaftServer_SendSnapshotServer interface {
+	Send(*SnapshotAck) error
+	Recv() (*SnapshotChunk, error)
+	grpc.ServerStream
+}
+
+type raftServerSendSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftServerSendSnapshotServer) Send(m *SnapshotAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftServerSendSnapshotServer) Recv() (*SnapshotChunk, error) {
+	m := new(SnapshotChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RaftServer_ServiceDesc is the grpc.ServiceDesc for RaftServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -134,6 +219,12 @@ var RaftServer_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RaftServer_SendMessage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SendSnapshot",
+			Handler:       _RaftServer_SendSnapshot_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "raft.proto",
 }
@@ -21,3 +21,7 @@ const (
 	// Block.
 	XVanusStime = "xvanusstime"
 )
+
+// CompactKeyHashBatchSize is the max number of key hashes in a message of CompactBlock stream, so that a message
+// is about 1 MB, far below the default max message size of gRPC.
+const CompactKeyHashBatchSize = 64 * 1024
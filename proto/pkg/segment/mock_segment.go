@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -56,6 +57,26 @@ func (mr *MockSegmentServerClientMockRecorder) ActivateSegment(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockSegmentServerClient)(nil).ActivateSegment), varargs...)
 }
 
+// AddReplica mocks base method.
+func (m *MockSegmentServerClient) AddReplica(ctx context.Context, in *AddReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddReplica", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReplica indicates an expected call of AddReplica.
+func (mr *MockSegmentServerClientMockRecorder) AddReplica(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockSegmentServerClient)(nil).AddReplica), varargs...)
+}
+
 // AppendToBlock mocks base method.
 func (m *MockSegmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +97,26 @@ func (mr *MockSegmentServerClientMockRecorder) AppendToBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).AppendToBlock), varargs...)
 }
 
+// CompactBlock mocks base method.
+func (m *MockSegmentServerClient) CompactBlock(ctx context.Context, opts ...grpc.CallOption) (SegmentServer_CompactBlockClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompactBlock", varargs...)
+	ret0, _ := ret[0].(SegmentServer_CompactBlockClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactBlock indicates an expected call of CompactBlock.
+func (mr *MockSegmentServerClientMockRecorder) CompactBlock(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).CompactBlock), varargs...)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +197,26 @@ func (mr *MockSegmentServerClientMockRecorder) LookupOffsetInBlock(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).LookupOffsetInBlock), varargs...)
 }
 
+// OffloadBlock mocks base method.
+func (m *MockSegmentServerClient) OffloadBlock(ctx context.Context, in *OffloadBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OffloadBlock", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OffloadBlock indicates an expected call of OffloadBlock.
+func (mr *MockSegmentServerClientMockRecorder) OffloadBlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).OffloadBlock), varargs...)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockSegmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +257,26 @@ func (mr *MockSegmentServerClientMockRecorder) RemoveBlock(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).RemoveBlock), varargs...)
 }
 
+// RemoveReplica mocks base method.
+func (m *MockSegmentServerClient) RemoveReplica(ctx context.Context, in *RemoveReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveReplica", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReplica indicates an expected call of RemoveReplica.
+func (mr *MockSegmentServerClientMockRecorder) RemoveReplica(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplica", reflect.TypeOf((*MockSegmentServerClient)(nil).RemoveReplica), varargs...)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerClient) Start(ctx context.Context, in *StartSegmentServerRequest, opts ...grpc.CallOption) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +337,163 @@ func (mr *MockSegmentServerClientMockRecorder) Stop(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerClient)(nil).Stop), varargs...)
 }
 
+// TransferLeader mocks base method.
+func (m *MockSegmentServerClient) TransferLeader(ctx context.Context, in *TransferLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferLeader", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockSegmentServerClientMockRecorder) TransferLeader(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockSegmentServerClient)(nil).TransferLeader), varargs...)
+}
+
+// MockSegmentServer_CompactBlockClient is a mock of SegmentServer_CompactBlockClient interface.
+type MockSegmentServer_CompactBlockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentServer_CompactBlockClientMockRecorder
+}
+
+// MockSegmentServer_CompactBlockClientMockRecorder is the mock recorder for MockSegmentServer_CompactBlockClient.
+type MockSegmentServer_CompactBlockClientMockRecorder struct {
+	mock *MockSegmentServer_CompactBlockClient
+}
+
+// NewMockSegmentServer_CompactBlockClient creates a new mock instance.
+func NewMockSegmentServer_CompactBlockClient(ctrl *gomock.Controller) *MockSegmentServer_CompactBlockClient {
+	mock := &MockSegmentServer_CompactBlockClient{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_CompactBlockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentServer_CompactBlockClient) EXPECT() *MockSegmentServer_CompactBlockClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) Recv() (*CompactBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*CompactBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_CompactBlockClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) Send(arg0 *CompactBlockRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_CompactBlockClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockSegmentServer_CompactBlockClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSegmentServer_CompactBlockClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSegmentServer_CompactBlockClient)(nil).Trailer))
+}
+
 // MockSegmentServerServer is a mock of SegmentServerServer interface.
 type MockSegmentServerServer struct {
 	ctrl     *gomock.Controller
@@ -294,6 +532,21 @@ func (mr *MockSegmentServerServerMockRecorder) ActivateSegment(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockSegmentServerServer)(nil).ActivateSegment), arg0, arg1)
 }
 
+// AddReplica mocks base method.
+func (m *MockSegmentServerServer) AddReplica(arg0 context.Context, arg1 *AddReplicaRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReplica", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReplica indicates an expected call of AddReplica.
+func (mr *MockSegmentServerServerMockRecorder) AddReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplica", reflect.TypeOf((*MockSegmentServerServer)(nil).AddReplica), arg0, arg1)
+}
+
 // AppendToBlock mocks base method.
 func (m *MockSegmentServerServer) AppendToBlock(arg0 context.Context, arg1 *AppendToBlockRequest) (*AppendToBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +562,20 @@ func (mr *MockSegmentServerServerMockRecorder) AppendToBlock(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).AppendToBlock), arg0, arg1)
 }
 
+// CompactBlock mocks base method.
+func (m *MockSegmentServerServer) CompactBlock(arg0 SegmentServer_CompactBlockServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactBlock", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompactBlock indicates an expected call of CompactBlock.
+func (mr *MockSegmentServerServerMockRecorder) CompactBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).CompactBlock), arg0)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerServer) CreateBlock(arg0 context.Context, arg1 *CreateBlockRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -369,6 +636,21 @@ func (mr *MockSegmentServerServerMockRecorder) LookupOffsetInBlock(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).LookupOffsetInBlock), arg0, arg1)
 }
 
+// OffloadBlock mocks base method.
+func (m *MockSegmentServerServer) OffloadBlock(arg0 context.Context, arg1 *OffloadBlockRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadBlock", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OffloadBlock indicates an expected call of OffloadBlock.
+func (mr *MockSegmentServerServerMockRecorder) OffloadBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).OffloadBlock), arg0, arg1)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockSegmentServerServer) ReadFromBlock(arg0 context.Context, arg1 *ReadFromBlockRequest) (*ReadFromBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +681,21 @@ func (mr *MockSegmentServerServerMockRecorder) RemoveBlock(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).RemoveBlock), arg0, arg1)
 }
 
+// RemoveReplica mocks base method.
+func (m *MockSegmentServerServer) RemoveReplica(arg0 context.Context, arg1 *RemoveReplicaRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReplica", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReplica indicates an expected call of RemoveReplica.
+func (mr *MockSegmentServerServerMockRecorder) RemoveReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReplica", reflect.TypeOf((*MockSegmentServerServer)(nil).RemoveReplica), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerServer) Start(arg0 context.Context, arg1 *StartSegmentServerRequest) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -444,6 +741,21 @@ func (mr *MockSegmentServerServerMockRecorder) Stop(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerServer)(nil).Stop), arg0, arg1)
 }
 
+// TransferLeader mocks base method.
+func (m *MockSegmentServerServer) TransferLeader(arg0 context.Context, arg1 *TransferLeaderRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferLeader", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferLeader indicates an expected call of TransferLeader.
+func (mr *MockSegmentServerServerMockRecorder) TransferLeader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferLeader", reflect.TypeOf((*MockSegmentServerServer)(nil).TransferLeader), arg0, arg1)
+}
+
 // MockUnsafeSegmentServerServer is a mock of UnsafeSegmentServerServer interface.
 type MockUnsafeSegmentServerServer struct {
 	ctrl     *gomock.Controller
@@ -478,3 +790,137 @@ func (mr *MockUnsafeSegmentServerServerMockRecorder) mustEmbedUnimplementedSegme
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSegmentServerServer", reflect.TypeOf((*MockUnsafeSegmentServerServer)(nil).mustEmbedUnimplementedSegmentServerServer))
 }
+
+// MockSegmentServer_CompactBlockServer is a mock of SegmentServer_CompactBlockServer interface.
+type MockSegmentServer_CompactBlockServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentServer_CompactBlockServerMockRecorder
+}
+
+// MockSegmentServer_CompactBlockServerMockRecorder is the mock recorder for MockSegmentServer_CompactBlockServer.
+type MockSegmentServer_CompactBlockServerMockRecorder struct {
+	mock *MockSegmentServer_CompactBlockServer
+}
+
+// NewMockSegmentServer_CompactBlockServer creates a new mock instance.
+func NewMockSegmentServer_CompactBlockServer(ctrl *gomock.Controller) *MockSegmentServer_CompactBlockServer {
+	mock := &MockSegmentServer_CompactBlockServer{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_CompactBlockServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentServer_CompactBlockServer) EXPECT() *MockSegmentServer_CompactBlockServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) Recv() (*CompactBlockRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*CompactBlockRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_CompactBlockServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) Send(arg0 *CompactBlockResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_CompactBlockServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockSegmentServer_CompactBlockServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockSegmentServer_CompactBlockServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSegmentServer_CompactBlockServer)(nil).SetTrailer), arg0)
+}
@@ -1,7 +1,21 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
-// - protoc             (unknown)
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
 // source: segment.proto
 
 package segment
@@ -19,6 +33,25 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	SegmentServer_Start_FullMethodName               = "/vanus.core.segment.SegmentServer/Start"
+	SegmentServer_Stop_FullMethodName                = "/vanus.core.segment.SegmentServer/Stop"
+	SegmentServer_CreateBlock_FullMethodName         = "/vanus.core.segment.SegmentServer/CreateBlock"
+	SegmentServer_RemoveBlock_FullMethodName         = "/vanus.core.segment.SegmentServer/RemoveBlock"
+	SegmentServer_CompactBlock_FullMethodName        = "/vanus.core.segment.SegmentServer/CompactBlock"
+	SegmentServer_OffloadBlock_FullMethodName        = "/vanus.core.segment.SegmentServer/OffloadBlock"
+	SegmentServer_GetBlockInfo_FullMethodName        = "/vanus.core.segment.SegmentServer/GetBlockInfo"
+	SegmentServer_AddReplica_FullMethodName          = "/vanus.core.segment.SegmentServer/AddReplica"
+	SegmentServer_RemoveReplica_FullMethodName       = "/vanus.core.segment.SegmentServer/RemoveReplica"
+	SegmentServer_TransferLeader_FullMethodName      = "/vanus.core.segment.SegmentServer/TransferLeader"
+	SegmentServer_ActivateSegment_FullMethodName     = "/vanus.core.segment.SegmentServer/ActivateSegment"
+	SegmentServer_InactivateSegment_FullMethodName   = "/vanus.core.segment.SegmentServer/InactivateSegment"
+	SegmentServer_AppendToBlock_FullMethodName       = "/vanus.core.segment.SegmentServer/AppendToBlock"
+	SegmentServer_ReadFromBlock_FullMethodName       = "/vanus.core.segment.SegmentServer/ReadFromBlock"
+	SegmentServer_LookupOffsetInBlock_FullMethodName = "/vanus.core.segment.SegmentServer/LookupOffsetInBlock"
+	SegmentServer_Status_FullMethodName              = "/vanus.core.segment.SegmentServer/Status"
+)
+
 // SegmentServerClient is the client API for SegmentServer service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -27,7 +60,18 @@ type SegmentServerClient interface {
 	Stop(ctx context.Context, in *StopSegmentServerRequest, opts ...grpc.CallOption) (*StopSegmentServerResponse, error)
 	CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveBlock(ctx context.Context, in *RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CompactBlock compacts the archived block by key. The hashes of newer keys are sent in batches, the compaction
+	// starts once the request stream is closed, and the hashes of keys in the block are returned in batches too.
+	CompactBlock(ctx context.Context, opts ...grpc.CallOption) (SegmentServer_CompactBlockClient, error)
+	OffloadBlock(ctx context.Context, in *OffloadBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error)
+	// AddReplica adds a replica to the raft group of block, it must be sent to the leader.
+	AddReplica(ctx context.Context, in *AddReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RemoveReplica removes a replica from the raft group of block, it must be sent to the leader.
+	RemoveReplica(ctx context.Context, in *RemoveReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TransferLeader transfers the leadership of raft group to a replica, it must be sent to the leader. It returns
+	// before the transfer is done, the new leader is reported to controller.
+	TransferLeader(ctx context.Context, in *TransferLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error)
 	InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error)
@@ -46,7 +90,7 @@ func NewSegmentServerClient(cc grpc.ClientConnInterface) SegmentServerClient {
 
 func (c *segmentServerClient) Start(ctx context.Context, in *StartSegmentServerRequest, opts ...grpc.CallOption) (*StartSegmentServerResponse, error) {
 	out := new(StartSegmentServerResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/Start", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_Start_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +99,7 @@ func (c *segmentServerClient) Start(ctx context.Context, in *StartSegmentServerR
 
 func (c *segmentServerClient) Stop(ctx context.Context, in *StopSegmentServerRequest, opts ...grpc.CallOption) (*StopSegmentServerResponse, error) {
 	out := new(StopSegmentServerResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/Stop", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_Stop_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +108,7 @@ func (c *segmentServerClient) Stop(ctx context.Context, in *StopSegmentServerReq
 
 func (c *segmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/CreateBlock", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_CreateBlock_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +117,47 @@ func (c *segmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRe
 
 func (c *segmentServerClient) RemoveBlock(ctx context.Context, in *RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/RemoveBlock", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_RemoveBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) CompactBlock(ctx context.Context, opts ...grpc.CallOption) (SegmentServer_CompactBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SegmentServer_ServiceDesc.Streams[0], SegmentServer_CompactBlock_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &segmentServerCompactBlockClient{stream}
+	return x, nil
+}
+
+type SegmentServer_CompactBlockClient interface {
+	Send(*CompactBlockRequest) error
+	Recv() (*CompactBlockResponse, error)
+	grpc.ClientStream
+}
+
+type segmentServerCompactBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *segmentServerCompactBlockClient) Send(m *CompactBlockRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *segmentServerCompactBlockClient) Recv() (*CompactBlockResponse, error) {
+	m := new(CompactBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *segmentServerClient) OffloadBlock(ctx context.Context, in *OffloadBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_OffloadBlock_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +166,34 @@ func (c *segmentServerClient) RemoveBlock(ctx context.Context, in *RemoveBlockRe
 
 func (c *segmentServerClient) GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error) {
 	out := new(GetBlockInfoResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/GetBlockInfo", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_GetBlockInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) AddReplica(ctx context.Context, in *AddReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_AddReplica_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) RemoveReplica(ctx context.Context, in *RemoveReplicaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_RemoveReplica_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) TransferLeader(ctx context.Context, in *TransferLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SegmentServer_TransferLeader_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +202,7 @@ func (c *segmentServerClient) GetBlockInfo(ctx context.Context, in *GetBlockInfo
 
 func (c *segmentServerClient) ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error) {
 	out := new(ActivateSegmentResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/ActivateSegment", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_ActivateSegment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +211,7 @@ func (c *segmentServerClient) ActivateSegment(ctx context.Context, in *ActivateS
 
 func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/InactivateSegment", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_InactivateSegment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +220,7 @@ func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *Inactiv
 
 func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
 	out := new(AppendToBlockResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/AppendToBlock", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_AppendToBlock_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +229,7 @@ func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlo
 
 func (c *segmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error) {
 	out := new(ReadFromBlockResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/ReadFromBlock", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_ReadFromBlock_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +238,7 @@ func (c *segmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlo
 
 func (c *segmentServerClient) LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error) {
 	out := new(LookupOffsetInBlockResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/LookupOffsetInBlock", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_LookupOffsetInBlock_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +247,7 @@ func (c *segmentServerClient) LookupOffsetInBlock(ctx context.Context, in *Looku
 
 func (c *segmentServerClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/vanus.core.segment.SegmentServer/Status", in, out, opts...)
+	err := c.cc.Invoke(ctx, SegmentServer_Status_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +262,18 @@ type SegmentServerServer interface {
 	Stop(context.Context, *StopSegmentServerRequest) (*StopSegmentServerResponse, error)
 	CreateBlock(context.Context, *CreateBlockRequest) (*emptypb.Empty, error)
 	RemoveBlock(context.Context, *RemoveBlockRequest) (*emptypb.Empty, error)
+	// CompactBlock compacts the archived block by key. The hashes of newer keys are sent in batches, the compaction
+	// starts once the request stream is closed, and the hashes of keys in the block are returned in batches too.
+	CompactBlock(SegmentServer_CompactBlockServer) error
+	OffloadBlock(context.Context, *OffloadBlockRequest) (*emptypb.Empty, error)
 	GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error)
+	// AddReplica adds a replica to the raft group of block, it must be sent to the leader.
+	AddReplica(context.Context, *AddReplicaRequest) (*emptypb.Empty, error)
+	// RemoveReplica removes a replica from the raft group of block, it must be sent to the leader.
+	RemoveReplica(context.Context, *RemoveReplicaRequest) (*emptypb.Empty, error)
+	// TransferLeader transfers the leadership of raft group to a replica, it must be sent to the leader. It returns
+	// before the transfer is done, the new leader is reported to controller.
+	TransferLeader(context.Context, *TransferLeaderRequest) (*emptypb.Empty, error)
 	ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error)
 	InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error)
 	AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error)
@@ -176,9 +298,24 @@ func (UnimplementedSegmentServerServer) CreateBlock(context.Context, *CreateBloc
 func (UnimplementedSegmentServerServer) RemoveBlock(context.Context, *RemoveBlockRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlock not implemented")
 }
+func (UnimplementedSegmentServerServer) CompactBlock(SegmentServer_CompactBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method CompactBlock not implemented")
+}
+func (UnimplementedSegmentServerServer) OffloadBlock(context.Context, *OffloadBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffloadBlock not implemented")
+}
 func (UnimplementedSegmentServerServer) GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockInfo not implemented")
 }
+func (UnimplementedSegmentServerServer) AddReplica(context.Context, *AddReplicaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReplica not implemented")
+}
+func (UnimplementedSegmentServerServer) RemoveReplica(context.Context, *RemoveReplicaRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReplica not implemented")
+}
+func (UnimplementedSegmentServerServer) TransferLeader(context.Context, *TransferLeaderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeader not implemented")
+}
 func (UnimplementedSegmentServerServer) ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateSegment not implemented")
 }
@@ -219,7 +356,7 @@ func _SegmentServer_Start_Handler(srv interface{}, ctx context.Context, dec func
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/Start",
+		FullMethod: SegmentServer_Start_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).Start(ctx, req.(*StartSegmentServerRequest))
@@ -237,7 +374,7 @@ func _SegmentServer_Stop_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/Stop",
+		FullMethod: SegmentServer_Stop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).Stop(ctx, req.(*StopSegmentServerRequest))
@@ -255,7 +392,7 @@ func _SegmentServer_CreateBlock_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/CreateBlock",
+		FullMethod: SegmentServer_CreateBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).CreateBlock(ctx, req.(*CreateBlockRequest))
@@ -273,7 +410,7 @@ func _SegmentServer_RemoveBlock_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/RemoveBlock",
+		FullMethod: SegmentServer_RemoveBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).RemoveBlock(ctx, req.(*RemoveBlockRequest))
@@ -281,6 +418,50 @@ func _SegmentServer_RemoveBlock_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_CompactBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SegmentServerServer).CompactBlock(&segmentServerCompactBlockServer{stream})
+}
+
+type SegmentServer_CompactBlockServer interface {
+	Send(*CompactBlockResponse) error
+	Recv() (*CompactBlockRequest, error)
+	grpc.ServerStream
+}
+
+type segmentServerCompactBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *segmentServerCompactBlockServer) Send(m *CompactBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *segmentServerCompactBlockServer) Recv() (*CompactBlockRequest, error) {
+	m := new(CompactBlockRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SegmentServer_OffloadBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffloadBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).OffloadBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_OffloadBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).OffloadBlock(ctx, req.(*OffloadBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockInfoRequest)
 	if err := dec(in); err != nil {
@@ -291,7 +472,7 @@ func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/GetBlockInfo",
+		FullMethod: SegmentServer_GetBlockInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).GetBlockInfo(ctx, req.(*GetBlockInfoRequest))
@@ -299,6 +480,60 @@ func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_AddReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).AddReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_AddReplica_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).AddReplica(ctx, req.(*AddReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_RemoveReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).RemoveReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_RemoveReplica_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).RemoveReplica(ctx, req.(*RemoveReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_TransferLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).TransferLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentServer_TransferLeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).TransferLeader(ctx, req.(*TransferLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_ActivateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateSegmentRequest)
 	if err := dec(in); err != nil {
@@ -309,7 +544,7 @@ func _SegmentServer_ActivateSegment_Handler(srv interface{}, ctx context.Context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/ActivateSegment",
+		FullMethod: SegmentServer_ActivateSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).ActivateSegment(ctx, req.(*ActivateSegmentRequest))
@@ -327,7 +562,7 @@ func _SegmentServer_InactivateSegment_Handler(srv interface{}, ctx context.Conte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/InactivateSegment",
+		FullMethod: SegmentServer_InactivateSegment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).InactivateSegment(ctx, req.(*InactivateSegmentRequest))
@@ -345,7 +580,7 @@ func _SegmentServer_AppendToBlock_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/AppendToBlock",
+		FullMethod: SegmentServer_AppendToBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).AppendToBlock(ctx, req.(*AppendToBlockRequest))
@@ -363,7 +598,7 @@ func _SegmentServer_ReadFromBlock_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/ReadFromBlock",
+		FullMethod: SegmentServer_ReadFromBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).ReadFromBlock(ctx, req.(*ReadFromBlockRequest))
@@ -381,7 +616,7 @@ func _SegmentServer_LookupOffsetInBlock_Handler(srv interface{}, ctx context.Con
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/LookupOffsetInBlock",
+		FullMethod: SegmentServer_LookupOffsetInBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).LookupOffsetInBlock(ctx, req.(*LookupOffsetInBlockRequest))
@@ -399,7 +634,7 @@ func _SegmentServer_Status_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vanus.core.segment.SegmentServer/Status",
+		FullMethod: SegmentServer_Status_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(SegmentServerServer).Status(ctx, req.(*emptypb.Empty))
@@ -430,10 +665,26 @@ var SegmentServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBlock",
 			Handler:    _SegmentServer_RemoveBlock_Handler,
 		},
+		{
+			MethodName: "OffloadBlock",
+			Handler:    _SegmentServer_OffloadBlock_Handler,
+		},
 		{
 			MethodName: "GetBlockInfo",
 			Handler:    _SegmentServer_GetBlockInfo_Handler,
 		},
+		{
+			MethodName: "AddReplica",
+			Handler:    _SegmentServer_AddReplica_Handler,
+		},
+		{
+			MethodName: "RemoveReplica",
+			Handler:    _SegmentServer_RemoveReplica_Handler,
+		},
+		{
+			MethodName: "TransferLeader",
+			Handler:    _SegmentServer_TransferLeader_Handler,
+		},
 		{
 			MethodName: "ActivateSegment",
 			Handler:    _SegmentServer_ActivateSegment_Handler,
@@ -459,6 +710,13 @@ var SegmentServer_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _SegmentServer_Status_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CompactBlock",
+			Handler:       _SegmentServer_CompactBlock_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "segment.proto",
 }
@@ -17,6 +17,7 @@ package command
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -30,7 +31,13 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"gopkg.in/yaml.v3"
+
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
 )
 
 var (
@@ -110,6 +117,8 @@ func NewClusterCommand() *cobra.Command {
 	cmd.AddCommand(scaleClusterCommand())
 	cmd.AddCommand(getClusterCommand())
 	cmd.AddCommand(genClusterCommand())
+	cmd.AddCommand(rebalanceClusterCommand())
+	cmd.AddCommand(storeClusterCommand())
 	return cmd
 }
 
@@ -796,12 +805,177 @@ func getClusterCommand() *cobra.Command {
 				t.Style().Box = table.StyleBoxDefault
 				t.SetOutputMirror(os.Stdout)
 				t.Render()
+				printSegmentReplications(cmd)
 			}
 		},
 	}
 	return cmd
 }
 
+// printSegmentReplications prints the segments which are being re-replicated, because their replicas are lost.
+func printSegmentReplications(cmd *cobra.Command) {
+	conn, err := dialGateway(mustGetGatewayEndpoint(cmd))
+	if err != nil {
+		color.Yellow("WARN: get segment replications failed, the gateway is unreachable: %s\n", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	res, err := proxypb.NewControllerProxyClient(conn).ListSegmentReplication(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		color.Yellow("WARN: get segment replications failed: %s\n", err)
+		return
+	}
+	if len(res.Replications) == 0 {
+		return
+	}
+
+	t := table.NewWriter()
+	t.SetTitle("Segment Replications")
+	t.AppendHeader(table.Row{"Segment", "Eventlog", "Lost Block", "New Block", "New Volume", "State",
+		"Progress", "Start Time", "Message"})
+	for _, r := range res.Replications {
+		t.AppendRow(table.Row{
+			formatID(r.SegmentId), formatID(r.EventlogId), formatID(r.LostBlockId), formatID(r.NewBlockId),
+			formatID(r.NewVolumeId), r.State, fmt.Sprintf("%d%%", r.Progress),
+			time.UnixMilli(r.StartTime).Format(time.RFC3339), r.Message,
+		})
+	}
+	renderCenteredTable(t, 9)
+}
+
+func rebalanceClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "rebalance the segment leaders between store nodes, it's a dry run unless --apply is set",
+		Run: func(cmd *cobra.Command, args []string) {
+			if imbalanceThreshold < 0 {
+				cmdFailedf(cmd, "the --threshold can not be negative")
+			}
+			InitGatewayClient(cmd)
+			defer DestroyGatewayClient()
+			res, err := client.RebalanceLeaders(context.Background(), &ctrlpb.RebalanceLeadersRequest{
+				DryRun:    !applyRebalance,
+				Threshold: imbalanceThreshold,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "rebalance leaders failed: %s", err)
+			}
+
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+				return
+			}
+			if len(res.Transfers) == 0 {
+				color.Green("the segment leaders are balanced, no transfer is needed\n")
+			} else {
+				t := table.NewWriter()
+				if applyRebalance {
+					t.SetTitle("Leader Transfers")
+				} else {
+					t.SetTitle("Leader Transfers (dry run)")
+				}
+				t.AppendHeader(table.Row{"Segment", "Eventlog", "From Block", "From Volume", "To Block",
+					"To Volume", "Message"})
+				for _, tr := range res.Transfers {
+					t.AppendRow(table.Row{
+						formatID(tr.SegmentId), formatID(tr.EventlogId), formatID(tr.FromBlockId),
+						formatID(tr.FromVolumeId), formatID(tr.ToBlockId), formatID(tr.ToVolumeId), tr.Message,
+					})
+				}
+				renderCenteredTable(t, 7)
+			}
+
+			t := table.NewWriter()
+			t.SetTitle("Segment Leaders of Volumes")
+			t.AppendHeader(table.Row{"Volume", "Address", "Before", "After"})
+			for _, v := range res.Volumes {
+				t.AppendRow(table.Row{formatID(v.VolumeId), v.Address, v.Before, v.After})
+			}
+			renderCenteredTable(t, 4)
+		},
+	}
+	cmd.Flags().BoolVar(&applyRebalance, "apply", false, "apply the leader transfers, instead of a dry run")
+	cmd.Flags().Int32Var(&imbalanceThreshold, "threshold", 0,
+		"the max difference between the leader numbers of store nodes, 0 means the controller's configuration")
+	return cmd
+}
+
+func storeClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store sub-command",
+		Short: "store node operations",
+	}
+	cmd.AddCommand(decommissionStoreCommand())
+	return cmd
+}
+
+func decommissionStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decommission",
+		Short: "move the replicas out of a store node, so it can be shut down safely",
+		Run: func(cmd *cobra.Command, args []string) {
+			if volumeID == 0 && storeAddress == "" {
+				cmdFailedf(cmd, "the --volume-id or --address is required")
+			}
+			InitGatewayClient(cmd)
+			defer DestroyGatewayClient()
+			req := &ctrlpb.DecommissionVolumeRequest{
+				VolumeId: volumeID,
+				Address:  storeAddress,
+			}
+			res, err := client.DecommissionVolume(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "decommission store node failed: %s", err)
+			}
+			for waitDrained && res.State != primitive.VolumeStateDrained {
+				if !IsFormatJSON(cmd) {
+					color.Yellow("the store node is draining, %d replicas and %d leaders are left\n",
+						res.Replicas, res.Leaders)
+				}
+				time.Sleep(5 * time.Second)
+				if res, err = client.DecommissionVolume(context.Background(), req); err != nil {
+					cmdFailedf(cmd, "get decommission progress failed: %s", err)
+				}
+			}
+
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Volume", "Address", "State", "Replicas", "Leaders"})
+			t.AppendRow(table.Row{formatID(res.VolumeId), res.Address, res.State, res.Replicas, res.Leaders})
+			renderCenteredTable(t, 5)
+			if res.State == primitive.VolumeStateDrained {
+				color.Green("the store node has been drained, it can be shut down now\n")
+			}
+		},
+	}
+	cmd.Flags().Uint64Var(&volumeID, "volume-id", 0, "the volume id of store node")
+	cmd.Flags().StringVar(&storeAddress, "address", "", "the address of store node, if --volume-id isn't set")
+	cmd.Flags().BoolVar(&waitDrained, "wait", false, "wait until the store node is drained")
+	return cmd
+}
+
+func renderCenteredTable(t table.Writer, columns int) {
+	colConfigs := make([]table.ColumnConfig, columns)
+	for idx := range colConfigs {
+		colConfigs[idx] = table.ColumnConfig{
+			Number: idx + 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+		}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetStyle(table.StyleLight)
+	t.Style().Options.SeparateRows = true
+	t.Style().Box = table.StyleBoxDefault
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
 func genClusterCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
@@ -44,6 +44,7 @@ const (
 	cloudEventDataRowLength = 4
 	httpPrefix              = "http://"
 	xceVanusDeliveryTime    = "xvanusdeliverytime"
+	xcePartitionKey         = "partitionkey"
 )
 
 func NewEventCommand() *cobra.Command {
@@ -84,10 +85,9 @@ func putEventCommand() *cobra.Command {
 			var target string
 			endpoint := mustGetGatewayCloudEventsEndpoint(cmd)
 			if strings.HasPrefix(endpoint, httpPrefix) {
-				// TODO replace with namespace
-				target = fmt.Sprintf("%s/gateway/%s", endpoint, args[0])
+				target = fmt.Sprintf("%s/gateway/%s/%s", endpoint, mustGetNamespace(cmd), args[0])
 			} else {
-				target = fmt.Sprintf("%s%s/gateway/%s", httpPrefix, endpoint, args[0])
+				target = fmt.Sprintf("%s%s/gateway/%s/%s", httpPrefix, endpoint, mustGetNamespace(cmd), args[0])
 			}
 
 			ctx := v2.ContextWithTarget(context.Background(), target)
@@ -109,6 +109,10 @@ func putEventCommand() *cobra.Command {
 		"event delay delivery time of CloudEvent, only support the unit of seconds, for example: 60")
 	cmd.Flags().StringVar(&eventType, "type", "cmd", "event type of CloudEvent")
 	cmd.Flags().StringVar(&eventData, "data", "", "event data of CloudEvent")
+	cmd.Flags().StringVar(&partitionKey, "partition-key", "",
+		"partition key of CloudEvent, events with the same key are written into the same eventlog")
+	cmd.Flags().StringVar(&partitionKeyAttribute, "partition-key-attribute", xcePartitionKey,
+		"the attribute which the partition key is set to, it must be the partition_key configured in gateway")
 	cmd.Flags().StringVar(&dataFile, "file", "", "the data file to send, each line represent a event "+
 		"and like [id],[source],[type],<body>")
 	cmd.Flags().BoolVar(&printDataTemplate, "print-template", false, "print data template file")
@@ -139,6 +143,9 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		timeOfRFC3339Nano := time.Now().Add(time.Duration(timeOfInt64) * time.Second).Format(time.RFC3339Nano)
 		event.SetExtension(xceVanusDeliveryTime, timeOfRFC3339Nano)
 	}
+	if partitionKey != "" {
+		event.SetExtension(strings.ToLower(partitionKeyAttribute), partitionKey)
+	}
 	var err error
 	if strings.ToLower(dataFormat) == "json" {
 		m := make(map[string]interface{})
@@ -255,6 +262,9 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		event.SetID(v[0])
 		event.SetSource(v[1])
 		event.SetType(v[2])
+		if partitionKey != "" {
+			event.SetExtension(strings.ToLower(partitionKeyAttribute), partitionKey)
+		}
 		err = event.SetData(v2.ApplicationJSON, v[3])
 		if err != nil {
 			cmdFailedf(cmd, "set data failed: %s\n", err)
@@ -306,7 +316,7 @@ func getEventCommand() *cobra.Command {
 			}
 
 			res, err := client.GetEvent(context.Background(), &proxypb.GetEventRequest{
-				EventbusId: mustGetEventbusID(namespace, args[0]).Uint64(),
+				EventbusId: mustGetEventbusID(cmd, args[0]).Uint64(),
 				EventlogId: eventlogID,
 				Offset:     offset,
 				EventId:    eventID,
@@ -370,7 +380,7 @@ func queryEventCommand() *cobra.Command {
 			}
 			ctx := context.Background()
 			res, err := client.LookupOffset(ctx, &proxypb.LookupOffsetRequest{
-				EventbusId: mustGetEventbusID(namespace, args[0]).Uint64(),
+				EventbusId: mustGetEventbusID(cmd, args[0]).Uint64(),
 				EventlogId: eventlogID,
 				Timestamp:  t.UnixMilli(),
 			})
@@ -387,7 +397,7 @@ func queryEventCommand() *cobra.Command {
 				}
 				if v >= 0 {
 					res, err := client.GetEvent(ctx, &proxypb.GetEventRequest{
-						EventbusId: mustGetEventbusID(namespace, args[0]).Uint64(),
+						EventbusId: mustGetEventbusID(cmd, args[0]).Uint64(),
 						EventlogId: k,
 						Offset:     v,
 						Number:     1,
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -47,6 +48,8 @@ func NewEventbusCommand() *cobra.Command {
 	cmd.AddCommand(deleteEventbusCommand())
 	cmd.AddCommand(getEventbusInfoCommand())
 	cmd.AddCommand(listEventbusInfoCommand())
+	cmd.AddCommand(setEventbusAuthCommand())
+	cmd.AddCommand(updateEventbusCommand())
 	return cmd
 }
 
@@ -62,6 +65,11 @@ func createEventbusCommand() *cobra.Command {
 				Name:        eventbus,
 				LogNumber:   eventlogNum,
 				Description: description,
+				Auth:        getEventbusAuth(cmd),
+				Namespace:   mustGetNamespace(cmd),
+				Retention:   getRetentionPolicy(cmd),
+				Compaction:  getCompactionPolicy(cmd),
+				Compression: mustGetCompression(cmd),
 			})
 			if err != nil {
 				cmdFailedf(cmd, "create eventbus failed: %s", err)
@@ -85,9 +93,198 @@ func createEventbusCommand() *cobra.Command {
 	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to deleting")
 	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 1, "number of eventlog")
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
+	addEventbusAuthFlags(cmd)
+	addRetentionFlags(cmd)
+	addCompactionFlags(cmd)
+	addCompressionFlags(cmd)
 	return cmd
 }
 
+func updateEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "update a eventbus, the unset fields aren't changed",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			req := &ctrlpb.UpdateEventbusRequest{
+				Id:         mustGetEventbusID(cmd, eventbus).Uint64(),
+				Retention:  getRetentionPolicy(cmd),
+				Compaction: getCompactionPolicy(cmd),
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("eventlog") {
+				req.LogNumber = eventlogNum
+				req.Force = forceIncreaseEventlog
+			}
+			if cmd.Flags().Changed("compression") {
+				c := mustGetCompression(cmd)
+				req.Compression = &c
+			}
+			_, err := client.UpdateEventbus(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "update eventbus failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{"Result": "Update Success", "EventbusService": eventbus})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "EventbusService"})
+				t.AppendRow(table.Row{"Update Success", eventbus})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to updating")
+	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 0, "number of eventlog, it can only be increased. "+
+		"Some partition keys are routed to the new eventlogs, so the order of events by key isn't kept across "+
+		"the change, it's refused if the eventbus has ordered subscriptions unless --force is set")
+	cmd.Flags().BoolVar(&forceIncreaseEventlog, "force", false,
+		"increase the number of eventlog even if the eventbus has ordered subscriptions")
+	cmd.Flags().StringVar(&description, "description", "", "eventbus description")
+	addRetentionFlags(cmd)
+	addCompactionFlags(cmd)
+	addCompressionFlags(cmd)
+	return cmd
+}
+
+func addRetentionFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&retentionKeepForever, "retention-keep-forever", false,
+		"keep the events forever, the other retention flags are ignored")
+	cmd.Flags().DurationVar(&retentionMaxAge, "retention-max-age", 0,
+		"the maximum age of events, e.g. 72h")
+	cmd.Flags().Int64Var(&retentionMaxBytes, "retention-max-bytes", 0,
+		"the maximum bytes retained by each eventlog")
+	cmd.Flags().Int64Var(&retentionMaxEvents, "retention-max-events", 0,
+		"the maximum number of events retained by each eventlog")
+}
+
+// getRetentionPolicy returns nil if no retention flag is set, the default retention of cluster is used.
+func getRetentionPolicy(cmd *cobra.Command) *metapb.RetentionPolicy {
+	changed := false
+	for _, name := range []string{
+		"retention-keep-forever", "retention-max-age", "retention-max-bytes", "retention-max-events",
+	} {
+		changed = changed || cmd.Flags().Changed(name)
+	}
+	if !changed {
+		return nil
+	}
+	return &metapb.RetentionPolicy{
+		KeepForever:   retentionKeepForever,
+		MaxAgeSeconds: int64(retentionMaxAge / time.Second),
+		MaxBytes:      retentionMaxBytes,
+		MaxEvents:     retentionMaxEvents,
+	}
+}
+
+func addCompactionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&compactionKey, "compaction-key", "",
+		"the attribute used as key of compaction, e.g. subject, the compaction is disabled if empty")
+	cmd.Flags().DurationVar(&compactionTombstoneGrace, "compaction-tombstone-grace", 0,
+		"how long the tombstones (events without data) are retained before compacted, e.g. 24h")
+}
+
+// getCompactionPolicy returns nil if no compaction flag is set.
+func getCompactionPolicy(cmd *cobra.Command) *metapb.CompactionPolicy {
+	if !cmd.Flags().Changed("compaction-key") && !cmd.Flags().Changed("compaction-tombstone-grace") {
+		return nil
+	}
+	return &metapb.CompactionPolicy{
+		KeyAttribute:          compactionKey,
+		TombstoneGraceSeconds: int64(compactionTombstoneGrace / time.Second),
+	}
+}
+
+func addCompressionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&compression, "compression", "none",
+		"the algorithm used to compress the events written to the new segments, none, lz4 or zstd")
+}
+
+func mustGetCompression(cmd *cobra.Command) metapb.CompressAlgorithm {
+	c, ok := metapb.CompressAlgorithm_value[strings.ToUpper(compression)]
+	if !ok {
+		cmdFailedf(cmd, "the --compression flag MUST be one of none, lz4 and zstd")
+	}
+	return metapb.CompressAlgorithm(c)
+}
+
+func setEventbusAuthCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auth",
+		Short: "set the auth of events sent to the eventbus through gateway",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			_, err := client.SetEventbusAuth(context.Background(), &ctrlpb.SetEventbusAuthRequest{
+				EventbusId: mustGetEventbusID(cmd, eventbus).Uint64(),
+				Auth:       getEventbusAuth(cmd),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "set eventbus auth failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(map[string]interface{}{"Result": "Set Auth Success", "EventbusService": eventbus})
+				color.Green(string(data))
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"Result", "EventbusService"})
+				t.AppendRow(table.Row{"Set Auth Success", eventbus})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to set auth")
+	addEventbusAuthFlags(cmd)
+	return cmd
+}
+
+func addEventbusAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&authType, "auth-type", "", "auth of gateway ingress, none, token, github or stripe")
+	cmd.Flags().StringSliceVar(&authTokens, "auth-token", nil,
+		"the accepted tokens in Authorization: Bearer or X-Api-Key header if auth-type is token")
+	cmd.Flags().StringVar(&authSecret, "auth-secret", "",
+		"the signing secret of webhook if auth-type is github or stripe")
+	cmd.Flags().Uint32Var(&authTolerance, "auth-tolerance", 0,
+		"the maximum age in seconds of stripe signature, default is 300")
+}
+
+func getEventbusAuth(cmd *cobra.Command) *metapb.EventbusAuth {
+	auth := &metapb.EventbusAuth{
+		Tokens:    authTokens,
+		Secret:    authSecret,
+		Tolerance: authTolerance,
+	}
+	switch strings.ToLower(authType) {
+	case "", "none":
+		return nil
+	case "token":
+		auth.Type = metapb.EventbusAuth_TOKEN
+	case "github":
+		auth.Type = metapb.EventbusAuth_GITHUB
+	case "stripe":
+		auth.Type = metapb.EventbusAuth_STRIPE
+	default:
+		cmdFailedf(cmd, "auth-type is invalid\n")
+	}
+	return auth
+}
+
 func deleteEventbusCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <eventbus-name> ",
@@ -98,7 +295,7 @@ func deleteEventbusCommand() *cobra.Command {
 			}
 
 			_, err := client.DeleteEventbus(context.Background(),
-				wrapperspb.UInt64(mustGetEventbusID(namespace, eventbus).Uint64()))
+				wrapperspb.UInt64(mustGetEventbusID(cmd, eventbus).Uint64()))
 			if err != nil {
 				cmdFailedf(cmd, "delete eventbus failed: %s", err)
 			}
@@ -134,7 +331,7 @@ func getEventbusInfoCommand() *cobra.Command {
 			segs := make(map[uint64][]*metapb.Segment)
 			ctx := context.Background()
 			res, err := client.GetEventbus(ctx,
-				wrapperspb.UInt64(mustGetEventbusID(namespace, eventbus).Uint64()))
+				wrapperspb.UInt64(mustGetEventbusID(cmd, eventbus).Uint64()))
 			if err != nil {
 				cmdFailedf(cmd, "get eventbus failed: %s", err)
 			}
@@ -186,7 +383,7 @@ func getEventbusInfoCommand() *cobra.Command {
 				if !showBlock {
 					t.AppendHeader(table.Row{
 						"EventbusService", "Description", "Created_At", "Updated_At",
-						"Eventlog", "Segment", "Capacity", "Size", "Start", "End",
+						"Eventlog", "Segment", "Capacity", "Size", "Start", "End", "Tier",
 					})
 					for idx := 0; idx < len(res.Logs); idx++ {
 						segOfEL := segs[res.Logs[idx].EventlogId]
@@ -196,7 +393,7 @@ func getEventbusInfoCommand() *cobra.Command {
 								time.UnixMilli(res.CreatedAt).Format(time.RFC3339),
 								time.UnixMilli(res.UpdatedAt).Format(time.RFC3339),
 								formatID(res.Logs[idx].EventlogId), formatID(v.Id),
-								v.Capacity, v.Size, v.StartOffsetInLog, v.EndOffsetInLog,
+								v.Capacity, v.Size, v.StartOffsetInLog, v.EndOffsetInLog, v.StorageTier,
 							})
 						}
 						t.AppendSeparator()
@@ -219,6 +416,7 @@ func getEventbusInfoCommand() *cobra.Command {
 						},
 						{Number: len(cfgs) + 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 						{Number: len(cfgs) + 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+						{Number: len(cfgs) + 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 					}...)
 					t.SetColumnConfigs(cfgs)
 				} else {
@@ -308,7 +506,9 @@ func listEventbusInfoCommand() *cobra.Command {
 		Use:   "list",
 		Short: "list the eventbus",
 		Run: func(cmd *cobra.Command, args []string) {
-			res, err := client.ListEventbus(context.Background(), &ctrlpb.ListEventbusRequest{})
+			res, err := client.ListEventbus(context.Background(), &ctrlpb.ListEventbusRequest{
+				Namespace: mustGetNamespace(cmd),
+			})
 			if err != nil {
 				cmdFailedf(cmd, "list eventbus failed: %s", err)
 			}
@@ -318,7 +518,7 @@ func listEventbusInfoCommand() *cobra.Command {
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{
-					"NodeName", "Description", "Created_At",
+					"NodeName", "Namespace", "Description", "Created_At",
 					"Updated_At", "Eventlog Number",
 				})
 				for idx := range res.Eventbus {
@@ -326,13 +526,16 @@ func listEventbusInfoCommand() *cobra.Command {
 					t.AppendSeparator()
 					t.AppendRow(table.Row{
 						eb.Name,
+						eb.Namespace,
 						eb.Description,
 						time.UnixMilli(eb.CreatedAt).Format(time.RFC3339),
 						time.UnixMilli(eb.UpdatedAt).Format(time.RFC3339),
 						eb.LogNumber,
 					})
 				}
-				cfgs := eventbusColConfigs()
+				cfgs := append(eventbusColConfigs(), table.ColumnConfig{
+					Number: 6, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+				})
 				for idx := range cfgs {
 					cfgs[idx].AutoMerge = false
 				}
@@ -14,6 +14,8 @@
 
 package command
 
+import "time"
+
 var (
 	// for vsctl event.
 	id                string
@@ -30,9 +32,11 @@ var (
 	detail            bool
 	eventID           string
 	eventCreateTime   string
+	partitionKey      string
+
+	partitionKeyAttribute string
 
 	// for both of eventbus and subscription.
-	namespace           string
 	eventbus            string
 	eventlogID          uint64
 	eventlogNum         int32
@@ -48,6 +52,8 @@ var (
 
 	orderedPushEvent     bool
 	orderedPushEventStr  string
+	orderedKey           string
+	retryPolicy          string
 	disableDeadLetter    bool
 	disableDeadLetterStr string
 
@@ -61,6 +67,36 @@ var (
 	showSegment bool
 	showBlock   bool
 
+	// for eventbus auth
+	authType      string
+	authTokens    []string
+	authSecret    string
+	authTolerance uint32
+
+	// for eventbus retention
+	retentionKeepForever bool
+	retentionMaxAge      time.Duration
+	retentionMaxBytes    int64
+	retentionMaxEvents   int64
+
+	// for eventbus compaction
+	compactionKey            string
+	compactionTombstoneGrace time.Duration
+
+	// for eventbus compression
+	compression string
+
+	// for eventbus update
+	forceIncreaseEventlog bool
+
+	// for namespace
+	namespaceName      string
+	maxEventbuses      int32
+	maxSubscriptions   int32
+	maxEventsPerSecond int64
+	maxBytesPerSecond  int64
+	maxRetainedBytes   int64
+
 	// for cluster
 	clusterConfigFile   string
 	clusterVersion      string
@@ -69,6 +105,11 @@ var (
 	controllerReplicas  int32
 	storeReplicas       int32
 	triggerReplicas     int32
+	applyRebalance      bool
+	imbalanceThreshold  int32
+	volumeID            uint64
+	storeAddress        string
+	waitDrained         bool
 
 	// for connector
 	connectorConfigFile string
@@ -86,4 +127,6 @@ var (
 const (
 	AWSCredentialType    = "aws"
 	GCloudCredentialType = "gcloud"
+	PlainCredentialType  = "plain"
+	HTTPCredentialType   = "http"
 )
@@ -17,7 +17,6 @@ package command
 import (
 	"context"
 	"fmt"
-	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 	"strconv"
 	"strings"
 	"time"
@@ -27,7 +26,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
 	proxypb "github.com/vanus-labs/vanus/proto/pkg/proxy"
+
+	"github.com/vanus-labs/vanus/internal/primitive"
+	"github.com/vanus-labs/vanus/internal/primitive/vanus"
 )
 
 const (
@@ -49,6 +52,7 @@ type GlobalFlags struct {
 	Debug            bool
 	ConfigFile       string
 	Format           string
+	Namespace        string
 }
 
 var (
@@ -61,18 +65,22 @@ func InitGatewayClient(cmd *cobra.Command) {
 	if err != nil {
 		cmdFailedf(cmd, "get gateway endpoint failed: %s", err)
 	}
+	conn, err := dialGateway(endpoint)
+	if err != nil {
+		panic("failed to dial gateway: " + err.Error())
+	}
+	cc = conn
+	client = proxypb.NewControllerProxyClient(conn)
+}
+
+func dialGateway(endpoint string) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, endpoint, opts...)
-	if err != nil {
-		panic("failed to dial gateway: " + err.Error())
-	}
-	cc = conn
-	client = proxypb.NewControllerProxyClient(conn)
+	return grpc.DialContext(ctx, endpoint, opts...)
 }
 
 func DestroyGatewayClient() {
@@ -109,6 +117,24 @@ func IsFormatJSON(cmd *cobra.Command) bool {
 	return strings.ToLower(v) == FormatJSON
 }
 
-func mustGetEventbusID(namespace, name string) vanus.ID {
-	return vanus.EmptyID()
+func mustGetNamespace(cmd *cobra.Command) string {
+	ns, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		cmdFailedf(cmd, "get namespace failed: %s", err)
+	}
+	if ns == "" {
+		return primitive.DefaultNamespace
+	}
+	return ns
+}
+
+func mustGetEventbusID(cmd *cobra.Command, name string) vanus.ID {
+	eb, err := client.GetEventbusWithHumanFriendly(context.Background(), &ctrlpb.GetEventbusWithHumanFriendlyRequest{
+		Namespace:    mustGetNamespace(cmd),
+		EventbusName: name,
+	})
+	if err != nil {
+		cmdFailedf(cmd, "get eventbus %s failed: %s", name, err)
+	}
+	return vanus.NewIDFromUint64(eb.Id)
 }
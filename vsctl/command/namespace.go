@@ -0,0 +1,245 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	ctrlpb "github.com/vanus-labs/vanus/proto/pkg/controller"
+	metapb "github.com/vanus-labs/vanus/proto/pkg/meta"
+)
+
+func NewNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace sub-command",
+		Short: "sub-commands for namespace operations",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(createNamespaceCommand())
+	cmd.AddCommand(deleteNamespaceCommand())
+	cmd.AddCommand(listNamespaceCommand())
+	cmd.AddCommand(infoNamespaceCommand())
+	cmd.AddCommand(setNamespaceQuotaCommand())
+	return cmd
+}
+
+func createNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a namespace",
+		Run: func(cmd *cobra.Command, args []string) {
+			if namespaceName == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			_, err := client.CreateNamespace(context.Background(), &ctrlpb.CreateNamespaceRequest{
+				Name:        namespaceName,
+				Description: description,
+				Quota:       newNamespaceQuota(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "create namespace failed: %s", err)
+			}
+			printNamespaceResult(cmd, "Create Success")
+		},
+	}
+	cmd.Flags().StringVar(&namespaceName, "name", "", "namespace name to creating")
+	cmd.Flags().StringVar(&description, "description", "", "namespace description")
+	addNamespaceQuotaFlags(cmd)
+	return cmd
+}
+
+func infoNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "get the quota and usage of a namespace",
+		Run: func(cmd *cobra.Command, args []string) {
+			if namespaceName == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			ns, err := client.GetNamespace(context.Background(), &ctrlpb.GetNamespaceRequest{
+				Name: namespaceName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get namespace failed: %s", err)
+			}
+			printNamespaceQuota(cmd, ns)
+		},
+	}
+	cmd.Flags().StringVar(&namespaceName, "name", "", "namespace name to showing")
+	return cmd
+}
+
+func setNamespaceQuotaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-quota",
+		Short: "set the quota of a namespace, the unset quota is unlimited",
+		Run: func(cmd *cobra.Command, args []string) {
+			if namespaceName == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			ns, err := client.SetNamespaceQuota(context.Background(), &ctrlpb.SetNamespaceQuotaRequest{
+				Name:  namespaceName,
+				Quota: newNamespaceQuota(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "set namespace quota failed: %s", err)
+			}
+			printNamespaceQuota(cmd, ns)
+		},
+	}
+	cmd.Flags().StringVar(&namespaceName, "name", "", "namespace name to setting quota")
+	addNamespaceQuotaFlags(cmd)
+	return cmd
+}
+
+func addNamespaceQuotaFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32Var(&maxEventbuses, "max-eventbuses", 0,
+		"the maximum number of eventbuses, 0 means unlimited")
+	cmd.Flags().Int32Var(&maxSubscriptions, "max-subscriptions", 0,
+		"the maximum number of subscriptions, 0 means unlimited")
+	cmd.Flags().Int64Var(&maxEventsPerSecond, "max-events-per-second", 0,
+		"the maximum events per second published to the namespace through each gateway instance, 0 means unlimited")
+	cmd.Flags().Int64Var(&maxBytesPerSecond, "max-bytes-per-second", 0,
+		"the maximum bytes per second published to the namespace through each gateway instance, 0 means unlimited")
+	cmd.Flags().Int64Var(&maxRetainedBytes, "max-retained-bytes", 0,
+		"the maximum bytes retained in the namespace, 0 means unlimited")
+}
+
+func newNamespaceQuota() *metapb.NamespaceQuota {
+	return &metapb.NamespaceQuota{
+		MaxEventbuses:      maxEventbuses,
+		MaxSubscriptions:   maxSubscriptions,
+		MaxEventsPerSecond: maxEventsPerSecond,
+		MaxBytesPerSecond:  maxBytesPerSecond,
+		MaxRetainedBytes:   maxRetainedBytes,
+	}
+}
+
+func printNamespaceQuota(cmd *cobra.Command, ns *metapb.Namespace) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(ns)
+		color.Green(string(data))
+		return
+	}
+	quota, usage := ns.GetQuota(), ns.GetUsage()
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Quota", "Limit", "Usage"})
+	t.AppendRow(table.Row{"max_eventbuses", quota.GetMaxEventbuses(), usage.GetEventbuses()})
+	t.AppendSeparator()
+	t.AppendRow(table.Row{"max_subscriptions", quota.GetMaxSubscriptions(), "-"})
+	t.AppendSeparator()
+	t.AppendRow(table.Row{"max_events_per_second", quota.GetMaxEventsPerSecond(), "-"})
+	t.AppendSeparator()
+	t.AppendRow(table.Row{"max_bytes_per_second", quota.GetMaxBytesPerSecond(), "-"})
+	t.AppendSeparator()
+	t.AppendRow(table.Row{"max_retained_bytes", quota.GetMaxRetainedBytes(), usage.GetRetainedBytes()})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
+func deleteNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a namespace, the eventbuses in it must be deleted first",
+		Run: func(cmd *cobra.Command, args []string) {
+			if namespaceName == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			_, err := client.DeleteNamespace(context.Background(), &ctrlpb.DeleteNamespaceRequest{
+				Name: namespaceName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete namespace failed: %s", err)
+			}
+			printNamespaceResult(cmd, "Delete Success")
+		},
+	}
+	cmd.Flags().StringVar(&namespaceName, "name", "", "namespace name to deleting")
+	return cmd
+}
+
+func listNamespaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the namespace",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListNamespace(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list namespace failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				data, _ := json.Marshal(res)
+				color.Green(string(data))
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Name", "Description", "Created_At", "Updated_At"})
+			for _, ns := range res.Namespaces {
+				t.AppendSeparator()
+				t.AppendRow(table.Row{
+					ns.Name,
+					ns.Description,
+					time.UnixMilli(ns.CreatedAt).Format(time.RFC3339),
+					time.UnixMilli(ns.UpdatedAt).Format(time.RFC3339),
+				})
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	return cmd
+}
+
+func printNamespaceResult(cmd *cobra.Command, result string) {
+	if IsFormatJSON(cmd) {
+		data, _ := json.Marshal(map[string]interface{}{"Result": result, "Namespace": namespaceName})
+		color.Green(string(data))
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Result", "Namespace"})
+	t.AppendRow(table.Row{result, namespaceName})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -96,11 +97,12 @@ func createSubscriptionCommand() *cobra.Command {
 					Sink:           sink,
 					SinkCredential: credential,
 					Protocol:       p,
-					EventbusId:     mustGetEventbusID(namespace, eventbus).Uint64(),
+					EventbusId:     mustGetEventbusID(cmd, eventbus).Uint64(),
 					Transformer:    trans,
 					Name:           subscriptionName,
 					Description:    description,
 					Disable:        disableSubscription,
+					Namespace:      mustGetNamespace(cmd),
 				},
 			})
 			if err != nil {
@@ -116,10 +118,13 @@ func createSubscriptionCommand() *cobra.Command {
 	cmd.Flags().Int32Var(&rateLimit, "rate-limit", 0, "max event number pushing to sink per second, default is 0, means unlimited")
 	cmd.Flags().StringVar(&from, "from", "", "consume events from, latest,earliest or RFC3339 format time")
 	cmd.Flags().StringVar(&subProtocol, "protocol", "http",
-		"protocol,http or aws-lambda or gcloud-functions or grpc")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+		"protocol,http or aws-lambda or gcloud-functions or grpc or kafka")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws, gcloud, "+
+		"plain or http")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "",
-		"sink credential info, JSON format or @file")
+		"sink credential info, JSON format or @file, the http credential is like {\"auth_type\":\"basic|bearer|hmac\","+
+			"\"username\":\"\",\"password\":\"\",\"token\":\"\",\"hmac_secret\":\"\",\"client_cert\":\"PEM\","+
+			"\"client_key\":\"PEM\",\"ca_cert\":\"PEM\"}")
 	cmd.Flags().Int32Var(&deliveryTimeout, "delivery-timeout", 0,
 		"event delivery to sink timeout by millisecond, default is 0, means using server-side default value: 5s")
 	cmd.Flags().Int32Var(&maxRetryAttempts, "max-retry-attempts", -1,
@@ -130,7 +135,12 @@ func createSubscriptionCommand() *cobra.Command {
 		"subscription (just create if disable=true)")
 	cmd.Flags().BoolVar(&orderedPushEvent, "ordered-event", false, "whether push the "+
 		"event with ordered")
+	cmd.Flags().StringVar(&orderedKey, "ordered-key", "", "the attribute of event which ordered "+
+		"push is scoped to, such as subject, default is empty, means the whole subscription")
 	cmd.Flags().BoolVar(&disableDeadLetter, "disable-dead-letter", false, "whether disable the dead letter")
+	cmd.Flags().StringVar(&retryPolicy, "retry-policy", "", "retry policy of failed event, JSON format "+
+		"required, for example: {\"backoff_type\":\"exponential\",\"initial_delay\":1000,\"multiplier\":2,"+
+		"\"max_delay\":60000,\"jitter\":0.1,\"retriable_codes\":[429],\"non_retriable_codes\":[500]}")
 	return cmd
 }
 
@@ -154,6 +164,8 @@ func getProtocol(cmd *cobra.Command) meta.Protocol {
 		}
 	case "grpc":
 		p = meta.Protocol_GRPC
+	case "kafka":
+		p = meta.Protocol_KAFKA
 	default:
 		cmdFailedf(cmd, "protocol is invalid\n")
 	}
@@ -207,6 +219,59 @@ func getSinkCredential(cmd *cobra.Command) *meta.SinkCredential {
 				},
 			},
 		}
+	case PlainCredentialType:
+		var plain *meta.PlainCredential
+		err := json.Unmarshal([]byte(sinkCredential), &plain)
+		if err != nil {
+			cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+		}
+		if plain.Identifier == "" || plain.Secret == "" {
+			cmdFailedf(cmd, "credential-type is plain, identifier and secret must not be empty\n")
+		}
+		return &meta.SinkCredential{
+			CredentialType: meta.SinkCredential_PLAIN,
+			Credential: &meta.SinkCredential_Plain{
+				Plain: plain,
+			},
+		}
+	case HTTPCredentialType:
+		var c struct {
+			AuthType   string `json:"auth_type"`
+			Username   string `json:"username"`
+			Password   string `json:"password"`
+			Token      string `json:"token"`
+			HMACSecret string `json:"hmac_secret"`
+			ClientCert string `json:"client_cert"`
+			ClientKey  string `json:"client_key"`
+			CACert     string `json:"ca_cert"`
+		}
+		err := json.Unmarshal([]byte(sinkCredential), &c)
+		if err != nil {
+			cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+		}
+		authType := meta.HTTPCredential_NONE
+		if c.AuthType != "" {
+			v, ok := meta.HTTPCredential_AuthType_value[strings.ToUpper(c.AuthType)]
+			if !ok {
+				cmdFailedf(cmd, "credential-type is http, auth_type must be one of basic, bearer and hmac\n")
+			}
+			authType = meta.HTTPCredential_AuthType(v)
+		}
+		return &meta.SinkCredential{
+			CredentialType: meta.SinkCredential_HTTP,
+			Credential: &meta.SinkCredential_Http{
+				Http: &meta.HTTPCredential{
+					AuthType:   authType,
+					Username:   c.Username,
+					Password:   c.Password,
+					Token:      c.Token,
+					HmacSecret: c.HMACSecret,
+					ClientCert: c.ClientCert,
+					ClientKey:  c.ClientKey,
+					CaCert:     c.CACert,
+				},
+			},
+		}
 	default:
 		cmdFailedf(cmd, "credential-type is invalid\n")
 	}
@@ -271,6 +336,20 @@ func getSubscriptionConfig(cmd *cobra.Command, config *meta.SubscriptionConfig)
 		}
 		config.OrderedEvent = v
 	}
+	if orderedKey != "" {
+		config.OrderedKey = orderedKey
+	}
+	if retryPolicy != "" {
+		var policy *primitive.RetryPolicy
+		if err := json.Unmarshal([]byte(retryPolicy), &policy); err != nil {
+			cmdFailedf(cmd, "the retry policy invalid: %s", err)
+		}
+		if policy != nil && !policy.BackoffType.IsValid() {
+			cmdFailedf(cmd, "the retry policy invalid: unknown backoff_type %q, "+
+				"must be one of fixed, linear and exponential", policy.BackoffType)
+		}
+		config.RetryPolicy = convert.ToPbRetryPolicy(policy)
+	}
 	if disableDeadLetterStr != "" {
 		v, err := strconv.ParseBool(disableDeadLetterStr)
 		if err != nil {
@@ -348,6 +427,10 @@ func updateSubscriptionCommand() *cobra.Command {
 			if !change {
 				cmdFailedf(cmd, "update subscription no change")
 			}
+			eventbusID := sub.EventbusId
+			if eventbus != "" {
+				eventbusID = mustGetEventbusID(cmd, eventbus).Uint64()
+			}
 			res, err := client.UpdateSubscription(context.Background(), &ctrlpb.UpdateSubscriptionRequest{
 				Id: id.Uint64(),
 				Subscription: &ctrlpb.SubscriptionRequest{
@@ -358,7 +441,7 @@ func updateSubscriptionCommand() *cobra.Command {
 					Sink:           sub.Sink,
 					SinkCredential: sub.SinkCredential,
 					Protocol:       sub.Protocol,
-					EventbusId:     mustGetEventbusID(namespace, eventbus).Uint64(),
+					EventbusId:     eventbusID,
 					Transformer:    sub.Transformer,
 					Name:           sub.Name,
 					Description:    sub.Description,
@@ -376,10 +459,13 @@ func updateSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&transformer, "transformer", "", "transformer, JSON format required")
 	cmd.Flags().Int32Var(&rateLimit, "rate-limit", -1, "max event number pushing to sink per second, 0 means unlimited")
 	cmd.Flags().StringVar(&subProtocol, "protocol", "",
-		"protocol,http or aws-lambda or gcloud-functions or grpc")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+		"protocol,http or aws-lambda or gcloud-functions or grpc or kafka")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws, gcloud, "+
+		"plain or http")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "",
-		"sink credential info, JSON format or @file")
+		"sink credential info, JSON format or @file, the http credential is like {\"auth_type\":\"basic|bearer|hmac\","+
+			"\"username\":\"\",\"password\":\"\",\"token\":\"\",\"hmac_secret\":\"\",\"client_cert\":\"PEM\","+
+			"\"client_key\":\"PEM\",\"ca_cert\":\"PEM\"}")
 	cmd.Flags().Int32Var(&deliveryTimeout, "delivery-timeout", -1,
 		"event delivery to sink timeout by millisecond, 0 means using server-side default value: 5s")
 	cmd.Flags().Int32Var(&maxRetryAttempts, "max-retry-attempts", -1,
@@ -388,8 +474,11 @@ func updateSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
 	cmd.Flags().StringVar(&orderedPushEventStr, "ordered-event", "",
 		"whether push the event with ordered, true of false")
+	cmd.Flags().StringVar(&orderedKey, "ordered-key", "", "the attribute of event which ordered "+
+		"push is scoped to, such as subject")
 	cmd.Flags().StringVar(&disableDeadLetterStr, "disable-dead-letter", "",
 		"whether disable the dead letter, true of false")
+	cmd.Flags().StringVar(&retryPolicy, "retry-policy", "", "retry policy of failed event, JSON format required")
 	return cmd
 }
 
@@ -574,9 +663,14 @@ func listSubscriptionCommand() *cobra.Command {
 		Use:   "list",
 		Short: "list the subscription ",
 		Run: func(cmd *cobra.Command, args []string) {
+			var eventbusID uint64
+			if eventbus != "" {
+				eventbusID = mustGetEventbusID(cmd, eventbus).Uint64()
+			}
 			res, err := client.ListSubscription(context.Background(), &ctrlpb.ListSubscriptionRequest{
-				EventbusId: mustGetEventbusID(namespace, eventbus).Uint64(),
+				EventbusId: eventbusID,
 				Name:       subscriptionName,
+				Namespace:  mustGetNamespace(cmd),
 			})
 			if err != nil {
 				cmdFailedf(cmd, "list subscription failed: %s", err)
@@ -630,7 +724,7 @@ func printSubscription(cmd *cobra.Command, showNo, showFilters, showTransformer
 
 var subscriptionHeaders = []interface{}{
 	"id", "name", "disable", "eventbusId", "eventbusName", "sink", "description", "protocol", "sinkCredential",
-	"config", "offsets", "filter", "transformer", "created_at", "updated_at",
+	"config", "offsets", "circuitBreaker", "filter", "transformer", "created_at", "updated_at",
 }
 
 func getSubscriptionHeader(showNo bool) table.Row {
@@ -662,6 +756,8 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 		protocol = "gcloud-functions"
 	case meta.Protocol_GRPC:
 		protocol = "grpc"
+	case meta.Protocol_KAFKA:
+		protocol = "kafka"
 	}
 	result = append(result, protocol)
 
@@ -673,6 +769,17 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 
 	offsets, _ := json.MarshalIndent(sub.Offsets, "", "  ")
 	result = append(result, string(offsets))
+
+	var circuitBreaker string
+	switch sub.CircuitBreakerState {
+	case meta.CircuitBreakerState_CLOSED:
+		circuitBreaker = "closed"
+	case meta.CircuitBreakerState_OPEN:
+		circuitBreaker = "open"
+	case meta.CircuitBreakerState_HALF_OPEN:
+		circuitBreaker = "half-open"
+	}
+	result = append(result, circuitBreaker)
 	return result
 }
 
@@ -51,6 +51,8 @@ func init() {
 		"is debug mode enable")
 	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", "table",
 		"the output format of vsctl, json or table")
+	rootCmd.PersistentFlags().StringVarP(&globalFlags.Namespace, "namespace", "n", "default",
+		"the namespace of eventbus and subscription")
 
 	if os.Getenv("VANUS_GATEWAY") != "" {
 		globalFlags.Endpoint = os.Getenv("VANUS_GATEWAY")
@@ -63,6 +65,7 @@ func init() {
 	rootCmd.AddCommand(
 		command.NewEventCommand(),
 		command.NewEventbusCommand(),
+		command.NewNamespaceCommand(),
 		command.NewSubscriptionCommand(),
 		command.NewClusterCommand(),
 		command.NewConnectorCommand(),